@@ -6,14 +6,29 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"time"
 
+	"github.com/lezzercringe/avito-test-assignment/internal/api/handlers/events"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/handlers/health"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/handlers/integrations"
+	metricshandler "github.com/lezzercringe/avito-test-assignment/internal/api/handlers/metrics"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/handlers/ooo"
 	"github.com/lezzercringe/avito-test-assignment/internal/api/handlers/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/handlers/stats"
 	"github.com/lezzercringe/avito-test-assignment/internal/api/handlers/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/handlers/tokens"
 	"github.com/lezzercringe/avito-test-assignment/internal/api/handlers/users"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/handlers/webhooks"
 	"github.com/lezzercringe/avito-test-assignment/internal/api/router"
 	"github.com/lezzercringe/avito-test-assignment/internal/config"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/metrics"
 	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/scheduler"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/tracing"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/webhooksender"
+	teamsdomain "github.com/lezzercringe/avito-test-assignment/internal/teams"
 	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/migrations"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -62,27 +77,90 @@ func main() {
 	logger := setupZap(cfg)
 	defer logger.Sync()
 
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		logger.Fatal("could not setup tracing", zap.Error(err))
+	}
+
 	pool, err := postgres.SetupPool(cfg.Postgres)
 	if err != nil {
 		logger.Fatal("could not setup postgres pool", zap.Error(err))
 	}
 
+	reg := metrics.NewRegistry()
+	reg.MustRegister(metrics.NewPoolCollector(pool))
+	assignmentMetrics := metrics.NewAssignments(reg)
+
 	userRepo := postgres.NewUserRepository(pool)
 	teamRepo := postgres.NewTeamRepository(pool)
 	prRepo := postgres.NewPRRepository(pool)
+	settingsRepo := postgres.NewTeamSettingsRepository(pool)
+	rotationRepo := postgres.NewRotationRepository(pool)
+	historyRepo := assignmentMetrics.HistoryRepository(postgres.NewHistoryRepository(pool))
+	statsRepo := postgres.NewStatsRepository(pool)
+	tokenRepo := postgres.NewTokenRepository(pool)
+	oooRepo := postgres.NewOutOfOfficeRepository(pool)
+	webhookRepo := postgres.NewWebhookRepository(pool)
+	outboxRepo := postgres.NewOutboxRepository(pool)
+	gitAccountRepo := postgres.NewGitAccountRepository(pool)
+	integrationDeliveryRepo := postgres.NewIntegrationDeliveryRepository(pool)
 	txManager := postgres.NewTxManager(pool)
 
-	rpicker := usecases.NewRandomReviewerPicker(userRepo)
+	pickers := map[teamsdomain.Strategy]usecases.ReviewerPicker{
+		teamsdomain.StrategyRandom:      assignmentMetrics.ReviewerPicker(usecases.NewRandomReviewerPicker(userRepo)),
+		teamsdomain.StrategyLeastLoaded: assignmentMetrics.ReviewerPicker(usecases.NewLeastLoadedReviewerPicker(userRepo, prRepo)),
+		teamsdomain.StrategyRoundRobin:  assignmentMetrics.ReviewerPicker(usecases.NewRoundRobinReviewerPicker(userRepo, rotationRepo)),
+	}
+
+	defaultStrategy := teamsdomain.StrategyRandom
+	if cfg.ReviewerStrategy != "" {
+		defaultStrategy = teamsdomain.Strategy(cfg.ReviewerStrategy)
+	}
+
+	defaultPicker, ok := pickers[defaultStrategy]
+	if !ok {
+		logger.Fatal("unknown reviewer strategy", zap.String("strategy", cfg.ReviewerStrategy))
+	}
 
-	prService := usecases.NewPullRequestService(rpicker, prRepo, teamRepo)
-	teamService := usecases.NewTeamService(txManager, teamRepo, userRepo)
-	userService := usecases.NewUserService(txManager, userRepo, teamRepo, prRepo, rpicker)
+	rpicker := usecases.NewStrategyReviewerPicker(settingsRepo, teamRepo, defaultPicker, pickers)
+
+	prService := tracing.PullRequestService(assignmentMetrics.PullRequestService(
+		usecases.NewPullRequestService(txManager, rpicker, prRepo, teamRepo, userRepo, settingsRepo, historyRepo, outboxRepo),
+	))
+	teamService := tracing.TeamService(usecases.NewTeamService(txManager, teamRepo, userRepo, settingsRepo, prRepo, rpicker, historyRepo, outboxRepo))
+	userService := tracing.UserService(usecases.NewUserService(txManager, userRepo, teamRepo, prRepo, rpicker, historyRepo, outboxRepo))
+	oooService := tracing.OutOfOfficeService(
+		usecases.NewOutOfOfficeService(txManager, oooRepo, userRepo, teamRepo, prRepo, rpicker, historyRepo, outboxRepo),
+	)
+	webhookService := tracing.WebhookService(
+		usecases.NewWebhookService(webhookRepo, outboxRepo, webhooksender.New(cfg.WebhookTimeout)),
+	)
+	integrationService := tracing.IntegrationService(
+		usecases.NewIntegrationService(txManager, gitAccountRepo, integrationDeliveryRepo, userRepo, teamRepo, prService),
+	)
+	eventStreamService := tracing.EventStreamService(
+		usecases.NewEventStreamService(postgres.NewEventListener(pool), userRepo, teamRepo),
+	)
+	staleReviewService := tracing.StaleReviewService(usecases.NewStaleReviewService(prRepo, prService))
+	statsService := tracing.StatsService(usecases.NewStatsService(statsRepo))
+	authService := tracing.AuthService(usecases.NewAuthService(tokenRepo, teamRepo, cfg.BootstrapToken))
+
+	healthHandler := health.NewHandler(postgres.NewHealthChecker(pool), migrations.LatestVersion())
+	eventsHandler := events.NewHandler(eventStreamService)
 
 	r := router.New(
-		logger, cfg,
+		logger, cfg, reg, authService,
+		healthHandler,
+		metricshandler.NewHandler(reg),
 		prs.NewHandler(prService),
 		teams.NewHandler(teamService),
 		users.NewHandler(userService),
+		ooo.NewHandler(oooService),
+		webhooks.NewHandler(webhookService),
+		eventsHandler,
+		integrations.NewHandler(integrationService, cfg.GitHubWebhookSecret, cfg.GitLabWebhookToken),
+		stats.NewHandler(statsService),
+		tokens.NewHandler(authService),
 	)
 
 	srv := http.Server{
@@ -101,9 +179,32 @@ func main() {
 		cancel()
 	}()
 
+	locker := postgres.NewAdvisoryLocker(pool)
+	jobs := scheduler.New(logger)
+	jobs.Every("ooo reassignment", cfg.OutOfOfficeCheckInterval, oooService.ReassignStarted)
+	jobs.Every("stale review reassignment", cfg.StaleReviewCheckInterval,
+		locker.WithLock("stale-review-reassignment", staleReviewService.ReassignStale))
+	jobs.Every("webhook delivery", cfg.WebhookDeliveryInterval, webhookService.DeliverDue)
+	jobs.Serve("event stream listener", time.Second, eventStreamService.Listen)
+
 	<-ctx.Done()
 
-	shutdownCtx, cancel := context.WithTimeout(ctx, cfg.ShutdownTimeout)
+	// readiness probe fails from now on, give the orchestrator time to notice it before closing listeners
+	healthHandler.Drain()
+	time.Sleep(cfg.DrainDelay)
+
+	// streams never finish on their own, end them so that server shutdown does not wait for them
+	eventsHandler.Close()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 	defer cancel()
 	srv.Shutdown(shutdownCtx)
+
+	if err := jobs.Shutdown(shutdownCtx); err != nil {
+		logger.Error("could not finish background jobs", zap.Error(err))
+	}
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logger.Error("could not flush traces", zap.Error(err))
+	}
 }
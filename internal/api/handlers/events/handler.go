@@ -0,0 +1,103 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/api"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+)
+
+// heartbeatInterval keeps idle streams from being closed by proxies.
+const heartbeatInterval = 15 * time.Second
+
+type Handler struct {
+	svc       usecases.EventStreamService
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+func (h *Handler) InjectRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/events/stream", h.stream)
+}
+
+// Close ends all the streams, so the server can shut down while clients reconnect to other replicas.
+func (h *Handler) Close() {
+	h.closeOnce.Do(func() {
+		close(h.closing)
+	})
+}
+
+// stream writes events as Server-Sent Events named after lowercase event types, e.g. reviewer_assigned.
+// Data of each event is the same JSON as the body of webhook delivery.
+func (h *Handler) stream(w http.ResponseWriter, r *http.Request) {
+	events, err := h.svc.Subscribe(r.Context(), usecases.EventStreamFilter{
+		UserID:   r.URL.Query().Get("user_id"),
+		TeamName: r.URL.Query().Get("team_name"),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-h.closing:
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case e, ok := <-events:
+			if !ok {
+				// the subscription was dropped, client reconnects and refreshes its state
+				return
+			}
+
+			payload, err := e.Payload()
+			if err != nil {
+				return
+			}
+
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", strings.ToLower(string(e.Type)), payload); err != nil {
+				return
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func NewHandler(svc usecases.EventStreamService) *Handler {
+	return &Handler{
+		svc:     svc,
+		closing: make(chan struct{}),
+	}
+}
@@ -0,0 +1,90 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/api"
+	"go.uber.org/zap"
+)
+
+type databaseProbe interface {
+	Ping(ctx context.Context) error
+	MigrationVersion(ctx context.Context) (int64, error)
+}
+
+type Handler struct {
+	db              databaseProbe
+	expectedVersion int64
+	draining        atomic.Bool
+}
+
+func (h *Handler) InjectRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/healthz", h.healthz)
+	mux.HandleFunc("/readyz", h.readyz)
+}
+
+// Drain makes readiness probe fail, so no new traffic is routed to the server while it shuts down.
+func (h *Handler) Drain() {
+	h.draining.Store(true)
+}
+
+func (h *Handler) healthz(w http.ResponseWriter, r *http.Request) {
+	type responseDTO struct {
+		Status string `json:"status"`
+	}
+
+	api.RespondJSON(w, responseDTO{Status: "ok"})
+}
+
+func (h *Handler) readyz(w http.ResponseWriter, r *http.Request) {
+	type migrationsDTO struct {
+		Current  int64 `json:"current"`
+		Expected int64 `json:"expected"`
+	}
+	type responseDTO struct {
+		Status     string        `json:"status"`
+		Draining   bool          `json:"draining"`
+		Database   string        `json:"database"`
+		Migrations migrationsDTO `json:"migrations"`
+	}
+
+	resp := responseDTO{
+		Status:     "ok",
+		Draining:   h.draining.Load(),
+		Database:   "ok",
+		Migrations: migrationsDTO{Expected: h.expectedVersion},
+	}
+	ready := !resp.Draining
+
+	if err := h.db.Ping(r.Context()); err != nil {
+		zap.L().Warn("readiness: database ping failed", zap.Error(err))
+		resp.Database = "unavailable"
+		ready = false
+	} else if version, err := h.db.MigrationVersion(r.Context()); err != nil {
+		zap.L().Warn("readiness: retrieving migration version failed", zap.Error(err))
+		resp.Database = "unavailable"
+		ready = false
+	} else {
+		resp.Migrations.Current = version
+		// schema may be ahead of the service during rolling updates, older schema is not supported though
+		if version < h.expectedVersion {
+			ready = false
+		}
+	}
+
+	if !ready {
+		resp.Status = "unavailable"
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	api.RespondJSON(w, resp)
+}
+
+func NewHandler(db databaseProbe, expectedMigrationVersion int64) *Handler {
+	return &Handler{
+		db:              db,
+		expectedVersion: expectedMigrationVersion,
+	}
+}
@@ -0,0 +1,220 @@
+package integrations
+
+import (
+	"encoding/json/v2"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/api"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/middleware"
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/integrations"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+)
+
+// maxPayloadSize limits webhook bodies, pull request payloads of Git hosts are far below it.
+const maxPayloadSize = 5 << 20
+
+type Handler struct {
+	svc          usecases.IntegrationService
+	githubSecret string
+	gitlabToken  string
+}
+
+// InjectRoutes registers webhook endpoints only for hosts having their secrets configured.
+func (h *Handler) InjectRoutes(mux *http.ServeMux) {
+	writers := middleware.RequireRole(auth.RoleAdmin, auth.RoleTeamLead)
+
+	mux.HandleFunc("/integrations/accounts/link", writers(h.link))
+	mux.HandleFunc("/integrations/accounts/unlink", writers(h.unlink))
+
+	if h.githubSecret != "" {
+		mux.HandleFunc("/integrations/github/webhook", h.github)
+	}
+	if h.gitlabToken != "" {
+		mux.HandleFunc("/integrations/gitlab/webhook", h.gitlab)
+	}
+}
+
+type accountDTO struct {
+	Host   string `json:"host"`
+	Login  string `json:"login"`
+	UserID string `json:"user_id"`
+}
+
+func (h *Handler) link(w http.ResponseWriter, r *http.Request) {
+	type responseDTO struct {
+		Account accountDTO `json:"account"`
+	}
+
+	var dto accountDTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.LinkAccount(r.Context(), usecases.LinkGitAccountRequest{
+		Host:   dto.Host,
+		Login:  dto.Login,
+		UserID: dto.UserID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrGitHost),
+			errors.Is(err, errorsx.ErrGitLogin),
+			errors.Is(err, errorsx.ErrUserID):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{Account: accountDTO{
+		Host:   res.Host,
+		Login:  res.Login,
+		UserID: res.UserID,
+	}})
+}
+
+func (h *Handler) unlink(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		Host  string `json:"host"`
+		Login string `json:"login"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	if err := h.svc.UnlinkAccount(r.Context(), dto.Host, dto.Login); err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	api.RespondJSON(w, dto)
+}
+
+func (h *Handler) github(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+	if err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	if !integrations.VerifyGitHubSignature(h.githubSecret, body, r.Header.Get("X-Hub-Signature-256")) {
+		api.Error(w, http.StatusUnauthorized, api.CodeUnauthorized, errorsx.ErrWebhookSignature.Error())
+		return
+	}
+
+	// other subscribed events, including the initial ping, are acknowledged and ignored
+	if r.Header.Get("X-GitHub-Event") != integrations.GitHubPullRequestEvent {
+		respondEventStatus(w, statusIgnored)
+		return
+	}
+
+	event, err := integrations.ParseGitHubEvent(r.Header.Get("X-GitHub-Delivery"), body)
+	h.handleEvent(w, r, event, err)
+}
+
+func (h *Handler) gitlab(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+	if err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	if !integrations.VerifyGitLabToken(h.gitlabToken, r.Header.Get("X-Gitlab-Token")) {
+		api.Error(w, http.StatusUnauthorized, api.CodeUnauthorized, errorsx.ErrWebhookSignature.Error())
+		return
+	}
+
+	if r.Header.Get("X-Gitlab-Event") != integrations.GitLabMergeRequestEvent {
+		respondEventStatus(w, statusIgnored)
+		return
+	}
+
+	event, err := integrations.ParseGitLabEvent(r.Header.Get("X-Gitlab-Event-UUID"), body)
+	h.handleEvent(w, r, event, err)
+}
+
+const (
+	statusApplied   = "applied"
+	statusDuplicate = "duplicate"
+	statusIgnored   = "ignored"
+)
+
+func respondEventStatus(w http.ResponseWriter, status string) {
+	type responseDTO struct {
+		Status string `json:"status"`
+	}
+	api.RespondJSON(w, responseDTO{Status: status})
+}
+
+func (h *Handler) handleEvent(w http.ResponseWriter, r *http.Request, event *integrations.PullRequestEvent, parseErr error) {
+	if parseErr != nil {
+		api.Error(w, http.StatusBadRequest, api.CodeBadRequest, parseErr.Error())
+		return
+	}
+	if event == nil {
+		respondEventStatus(w, statusIgnored)
+		return
+	}
+
+	applied, err := h.svc.HandlePullRequestEvent(r.Context(), event)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrGitAccountNotLinked):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, err.Error())
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrAlreadyExists):
+			api.Error(w, http.StatusConflict, api.CodePRExists, "PR id already exists")
+		case errors.Is(err, errorsx.ErrDraftPR):
+			api.Error(w, http.StatusConflict, api.CodePRDraft, err.Error())
+		case errors.Is(err, errorsx.ErrModifyClosedPR):
+			api.Error(w, http.StatusConflict, api.CodePRClosed, err.Error())
+		case errors.Is(err, errorsx.ErrModifyMergedPR):
+			api.Error(w, http.StatusConflict, api.CodePRMerged, err.Error())
+		case errors.Is(err, errorsx.ErrNotClosedPR):
+			api.Error(w, http.StatusConflict, api.CodePRNotClosed, err.Error())
+		case errors.Is(err, errorsx.ErrNotEnoughApprovals):
+			api.Error(w, http.StatusConflict, api.CodeNotEnoughApprovals, err.Error())
+		case errors.Is(err, errorsx.ErrAmbiguousTeam):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	if !applied {
+		respondEventStatus(w, statusDuplicate)
+		return
+	}
+
+	respondEventStatus(w, statusApplied)
+}
+
+func NewHandler(svc usecases.IntegrationService, githubSecret, gitlabToken string) *Handler {
+	return &Handler{
+		svc:          svc,
+		githubSecret: githubSecret,
+		gitlabToken:  gitlabToken,
+	}
+}
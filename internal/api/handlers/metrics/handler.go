@@ -0,0 +1,20 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+type Handler struct {
+	gatherer prometheus.Gatherer
+}
+
+func (h *Handler) InjectRoutes(mux *http.ServeMux) {
+	mux.Handle("/metrics", promhttp.HandlerFor(h.gatherer, promhttp.HandlerOpts{}))
+}
+
+func NewHandler(gatherer prometheus.Gatherer) *Handler {
+	return &Handler{gatherer: gatherer}
+}
@@ -0,0 +1,152 @@
+package ooo
+
+import (
+	"encoding/json/v2"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/api"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/middleware"
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+)
+
+type Handler struct {
+	svc usecases.OutOfOfficeService
+}
+
+func (h *Handler) InjectRoutes(mux *http.ServeMux) {
+	writers := middleware.RequireRole(auth.RoleAdmin, auth.RoleTeamLead)
+
+	mux.HandleFunc("/users/ooo/add", writers(h.add))
+	mux.HandleFunc("/users/ooo/list", h.list)
+	mux.HandleFunc("/users/ooo/delete", writers(h.delete))
+}
+
+type periodDTO struct {
+	ID              int64     `json:"ooo_id"`
+	UserID          string    `json:"user_id"`
+	StartsAt        time.Time `json:"starts_at"`
+	EndsAt          time.Time `json:"ends_at"`
+	Reason          string    `json:"reason,omitempty"`
+	ReassignReviews bool      `json:"reassign_reviews"`
+}
+
+func periodDTOFromView(v *usecases.OutOfOfficeView) periodDTO {
+	return periodDTO{
+		ID:              v.ID,
+		UserID:          v.UserID,
+		StartsAt:        v.StartsAt,
+		EndsAt:          v.EndsAt,
+		Reason:          v.Reason,
+		ReassignReviews: v.ReassignReviews,
+	}
+}
+
+func (h *Handler) add(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		UserID          string    `json:"user_id"`
+		StartsAt        time.Time `json:"starts_at"`
+		EndsAt          time.Time `json:"ends_at"`
+		Reason          string    `json:"reason"`
+		ReassignReviews bool      `json:"reassign_reviews"`
+	}
+	type responseDTO struct {
+		Period periodDTO `json:"ooo"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.Add(r.Context(), usecases.AddOutOfOfficeRequest{
+		UserID:          dto.UserID,
+		StartsAt:        dto.StartsAt,
+		EndsAt:          dto.EndsAt,
+		Reason:          dto.Reason,
+		ReassignReviews: dto.ReassignReviews,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrPeriod), errors.Is(err, errorsx.ErrUserID):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{Period: periodDTOFromView(res)})
+}
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	userID := r.URL.Query().Get("user_id")
+	if userID == "" {
+		api.BadRequest(w)
+		return
+	}
+
+	type responseDTO struct {
+		UserID  string      `json:"user_id"`
+		Periods []periodDTO `json:"ooo"`
+	}
+
+	res, err := h.svc.List(r.Context(), userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	periods := make([]periodDTO, len(res))
+	for i, v := range res {
+		periods[i] = periodDTOFromView(v)
+	}
+
+	api.RespondJSON(w, responseDTO{UserID: userID, Periods: periods})
+}
+
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		ID int64 `json:"ooo_id"`
+	}
+	type responseDTO struct {
+		ID int64 `json:"ooo_id"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	if err := h.svc.Delete(r.Context(), dto.ID); err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{ID: dto.ID})
+}
+
+func NewHandler(svc usecases.OutOfOfficeService) *Handler {
+	return &Handler{svc: svc}
+}
@@ -0,0 +1,41 @@
+package prs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+)
+
+// setETag exposes PR's version as a strong entity tag.
+func setETag(w http.ResponseWriter, version int64) {
+	w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// ifMatchContext requires the modified PR to be of any version listed in If-Match headers.
+// Missing header and "*" allow any version, while weak and unknown tags never match.
+func ifMatchContext(r *http.Request) context.Context {
+	// the list may be split across several header lines
+	raw := strings.TrimSpace(strings.Join(r.Header.Values("If-Match"), ","))
+	if raw == "" || raw == "*" {
+		return r.Context()
+	}
+
+	var versions []int64
+	for tag := range strings.SplitSeq(raw, ",") {
+		tag = strings.TrimSpace(tag)
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			continue
+		}
+
+		version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, version)
+	}
+
+	return usecases.WithExpectedVersion(r.Context(), versions...)
+}
@@ -4,9 +4,12 @@ import (
 	"encoding/json/v2"
 	"errors"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/lezzercringe/avito-test-assignment/internal/api"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/middleware"
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
 	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
 	"github.com/lezzercringe/avito-test-assignment/internal/prs"
 	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
@@ -17,29 +20,132 @@ type Handler struct {
 }
 
 func (h *Handler) InjectRoutes(mux *http.ServeMux) {
-	mux.HandleFunc("/pullRequest/create", h.create)
-	mux.HandleFunc("/pullRequest/merge", h.merge)
-	mux.HandleFunc("/pullRequest/reassign", h.reassign)
+	writers := middleware.RequireRole(auth.RoleAdmin, auth.RoleTeamLead)
+
+	mux.HandleFunc("/pullRequest/get", h.get)
+	mux.HandleFunc("/pullRequest/create", writers(h.create))
+	mux.HandleFunc("/pullRequest/merge", writers(h.merge))
+	mux.HandleFunc("/pullRequest/reassign", writers(h.reassign))
+	mux.HandleFunc("/pullRequest/ready", writers(h.ready))
+	mux.HandleFunc("/pullRequest/close", writers(h.close))
+	mux.HandleFunc("/pullRequest/reopen", writers(h.reopen))
+	mux.HandleFunc("/pullRequest/review", writers(h.review))
+	mux.HandleFunc("/pullRequest/history", h.history)
+	mux.HandleFunc("/pullRequest/list", h.list)
 }
 
 type pullRequestDTO struct {
-	ID        string    `json:"pull_request_id"`
-	Name      string    `json:"pull_request_name"`
-	AuthorID  string    `json:"author_id"`
-	Status    string    `json:"status"`
-	Reviewers []string  `json:"assigned_reviewers"`
-	MergedAt  time.Time `json:"mergedAt,omitzero"`
+	ID             string      `json:"pull_request_id"`
+	Name           string      `json:"pull_request_name"`
+	AuthorID       string      `json:"author_id"`
+	Status         string      `json:"status"`
+	Reviewers      []string    `json:"assigned_reviewers"`
+	ReviewersCount int         `json:"reviewers_count"`
+	Reviews        []reviewDTO `json:"reviews"`
+	MergedAt       time.Time   `json:"mergedAt,omitzero"`
+	CreatedAt      time.Time   `json:"createdAt,omitzero"`
+	UpdatedAt      time.Time   `json:"updatedAt,omitzero"`
+	// FallbackReviewers are present only in responses of operations which assign reviewers.
+	FallbackReviewers []string `json:"fallback_reviewers,omitempty"`
+}
+
+type reviewDTO struct {
+	ReviewerID string    `json:"reviewer_id"`
+	State      string    `json:"state"`
+	AssignedAt time.Time `json:"assignedAt,omitzero"`
+	UpdatedAt  time.Time `json:"updatedAt,omitzero"`
 }
 
 func dtoFromView(dto *prs.PullRequestView) pullRequestDTO {
+	reviews := make([]reviewDTO, len(dto.Reviews))
+	for i, review := range dto.Reviews {
+		reviews[i] = reviewDTO{
+			ReviewerID: review.ReviewerID,
+			State:      review.State,
+			AssignedAt: review.AssignedAt,
+			UpdatedAt:  review.UpdatedAt,
+		}
+	}
+
 	return pullRequestDTO{
-		ID:        dto.ID,
-		Name:      dto.Name,
-		AuthorID:  dto.AuthorID,
-		Status:    dto.Status,
-		Reviewers: dto.ReviewerIDs,
-		MergedAt:  dto.MergedAt,
+		ID:             dto.ID,
+		Name:           dto.Name,
+		AuthorID:       dto.AuthorID,
+		Status:         dto.Status,
+		Reviewers:      dto.ReviewerIDs,
+		ReviewersCount: dto.ReviewersCount,
+		Reviews:        reviews,
+		MergedAt:       dto.MergedAt,
+		CreatedAt:      dto.CreatedAt,
+		UpdatedAt:      dto.UpdatedAt,
+
+		FallbackReviewers: dto.FallbackReviewerIDs,
+	}
+}
+
+type prUserDTO struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+	TeamName string `json:"team_name,omitempty"`
+}
+
+func prUserDTOFromView(v usecases.PullRequestUserView) prUserDTO {
+	return prUserDTO{
+		UserID:   v.ID,
+		Username: v.Name,
+		IsActive: v.IsActive,
+		TeamName: v.TeamName,
+	}
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request) {
+	prID := r.URL.Query().Get("pull_request_id")
+	if prID == "" {
+		api.BadRequest(w)
+		return
+	}
+
+	type reviewerDTO struct {
+		prUserDTO
+		AssignedAt time.Time `json:"assignedAt,omitzero"`
+	}
+	type detailsDTO struct {
+		pullRequestDTO
+		Author          prUserDTO     `json:"author"`
+		ReviewerDetails []reviewerDTO `json:"reviewer_details"`
+	}
+	type responseDTO struct {
+		PR detailsDTO `json:"pr"`
+	}
+
+	res, err := h.svc.Get(r.Context(), prID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	reviewers := make([]reviewerDTO, len(res.Reviewers))
+	for i, reviewer := range res.Reviewers {
+		reviewers[i] = reviewerDTO{
+			prUserDTO:  prUserDTOFromView(reviewer.PullRequestUserView),
+			AssignedAt: reviewer.AssignedAt,
+		}
 	}
+
+	setETag(w, res.PR.Version)
+	api.RespondJSON(w, responseDTO{
+		PR: detailsDTO{
+			pullRequestDTO:  dtoFromView(res.PR),
+			Author:          prUserDTOFromView(res.Author),
+			ReviewerDetails: reviewers,
+		},
+	})
 }
 
 func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
@@ -47,6 +153,11 @@ func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
 		ID       string `json:"pull_request_id"`
 		Name     string `json:"pull_request_name"`
 		AuthorID string `json:"author_id"`
+		// TeamName is optional, author's only or primary team is used when omitted.
+		TeamName string `json:"team_name,omitempty"`
+		// ReviewersCount is optional, team's default is used when omitted.
+		ReviewersCount *int `json:"reviewers_count,omitempty"`
+		IsDraft        bool `json:"is_draft"`
 	}
 	type responseDTO struct {
 		PR pullRequestDTO `json:"pr"`
@@ -59,22 +170,33 @@ func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	res, err := h.svc.Create(r.Context(), usecases.CreateRequest{
-		AuthorID: dto.AuthorID,
-		Name:     dto.Name,
-		ID:       dto.ID,
+		AuthorID:       dto.AuthorID,
+		Name:           dto.Name,
+		ID:             dto.ID,
+		TeamName:       dto.TeamName,
+		ReviewersCount: dto.ReviewersCount,
+		Draft:          dto.IsDraft,
 	})
 	if err != nil {
 		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
 		case errors.Is(err, errorsx.ErrNotFound):
 			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrNotTeamMember):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, err.Error())
 		case errors.Is(err, errorsx.ErrAlreadyExists):
 			api.Error(w, http.StatusConflict, api.CodePRExists, "PR id already exists")
+		case errors.Is(err, errorsx.ErrReviewersCount),
+			errors.Is(err, errorsx.ErrAmbiguousTeam):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
 		default:
 			api.InternalServerError(w)
 		}
 		return
 	}
 
+	setETag(w, res.Version)
 	api.RespondJSON(w, responseDTO{
 		PR: dtoFromView(res),
 	})
@@ -94,17 +216,32 @@ func (h *Handler) merge(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.svc.Merge(r.Context(), dto.ID)
+	ctx := ifMatchContext(r)
+
+	res, err := h.svc.Merge(ctx, dto.ID)
 	if err != nil {
 		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
 		case errors.Is(err, errorsx.ErrNotFound):
 			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrDraftPR):
+			api.Error(w, http.StatusConflict, api.CodePRDraft, "cannot merge draft PR")
+		case errors.Is(err, errorsx.ErrModifyClosedPR):
+			api.Error(w, http.StatusConflict, api.CodePRClosed, "cannot merge closed PR")
+		case errors.Is(err, errorsx.ErrNotEnoughApprovals):
+			api.Error(w, http.StatusConflict, api.CodeNotEnoughApprovals, "not enough approvals to merge PR")
+		case errors.Is(err, errorsx.ErrVersionMismatch):
+			api.Error(w, http.StatusPreconditionFailed, api.CodePreconditionFailed, err.Error())
+		case errors.Is(err, errorsx.ErrConcurrentModification):
+			api.Error(w, http.StatusConflict, api.CodeConcurrentModification, err.Error())
 		default:
 			api.InternalServerError(w)
 		}
 		return
 	}
 
+	setETag(w, res.Version)
 	api.RespondJSON(w, responseDTO{
 		PR: dtoFromView(res),
 	})
@@ -126,28 +263,346 @@ func (h *Handler) reassign(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.svc.ReassignReviewer(r.Context(), usecases.ReassignReviewerRequest{
+	ctx := ifMatchContext(r)
+
+	res, err := h.svc.ReassignReviewer(ctx, usecases.ReassignReviewerRequest{
 		PullRequestID:    dto.ID,
 		UserIDToReassign: dto.OldReviewerID,
 	})
 	if err != nil {
 		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
 		case errors.Is(err, errorsx.ErrNotFound):
 			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
 		case errors.Is(err, errorsx.ErrModifyMergedPR):
 			api.Error(w, http.StatusNotFound, api.CodePRMerged, "cannot reassign on merged PR")
+		case errors.Is(err, errorsx.ErrModifyClosedPR):
+			api.Error(w, http.StatusConflict, api.CodePRClosed, "cannot reassign on closed PR")
+		case errors.Is(err, errorsx.ErrVersionMismatch):
+			api.Error(w, http.StatusPreconditionFailed, api.CodePreconditionFailed, err.Error())
+		case errors.Is(err, errorsx.ErrConcurrentModification):
+			api.Error(w, http.StatusConflict, api.CodeConcurrentModification, err.Error())
 		default:
 			api.InternalServerError(w)
 		}
 		return
 	}
 
+	setETag(w, res.PR.Version)
 	api.RespondJSON(w, responseDTO{
 		PR:         dtoFromView(res.PR),
 		ReplacedBy: res.ReplacedByID,
 	})
 }
 
+func (h *Handler) ready(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		ID string `json:"pull_request_id"`
+	}
+	type responseDTO struct {
+		PR pullRequestDTO `json:"pr"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	ctx := ifMatchContext(r)
+
+	res, err := h.svc.MarkReady(ctx, dto.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrNotDraftPR):
+			api.Error(w, http.StatusConflict, api.CodePRNotDraft, "PR is not a draft")
+		case errors.Is(err, errorsx.ErrModifyMergedPR):
+			api.Error(w, http.StatusConflict, api.CodePRMerged, "PR is already merged")
+		case errors.Is(err, errorsx.ErrModifyClosedPR):
+			api.Error(w, http.StatusConflict, api.CodePRClosed, "PR is closed")
+		case errors.Is(err, errorsx.ErrVersionMismatch):
+			api.Error(w, http.StatusPreconditionFailed, api.CodePreconditionFailed, err.Error())
+		case errors.Is(err, errorsx.ErrConcurrentModification):
+			api.Error(w, http.StatusConflict, api.CodeConcurrentModification, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	setETag(w, res.Version)
+	api.RespondJSON(w, responseDTO{
+		PR: dtoFromView(res),
+	})
+}
+
+func (h *Handler) close(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		ID string `json:"pull_request_id"`
+	}
+	type responseDTO struct {
+		PR pullRequestDTO `json:"pr"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	ctx := ifMatchContext(r)
+
+	res, err := h.svc.Close(ctx, dto.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrModifyMergedPR):
+			api.Error(w, http.StatusConflict, api.CodePRMerged, "cannot close merged PR")
+		case errors.Is(err, errorsx.ErrVersionMismatch):
+			api.Error(w, http.StatusPreconditionFailed, api.CodePreconditionFailed, err.Error())
+		case errors.Is(err, errorsx.ErrConcurrentModification):
+			api.Error(w, http.StatusConflict, api.CodeConcurrentModification, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	setETag(w, res.Version)
+	api.RespondJSON(w, responseDTO{
+		PR: dtoFromView(res),
+	})
+}
+
+func (h *Handler) reopen(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		ID string `json:"pull_request_id"`
+	}
+	type responseDTO struct {
+		PR pullRequestDTO `json:"pr"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	ctx := ifMatchContext(r)
+
+	res, err := h.svc.Reopen(ctx, dto.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrNotClosedPR):
+			api.Error(w, http.StatusConflict, api.CodePRNotClosed, "PR is not closed")
+		case errors.Is(err, errorsx.ErrModifyMergedPR):
+			api.Error(w, http.StatusConflict, api.CodePRMerged, "cannot reopen merged PR")
+		case errors.Is(err, errorsx.ErrVersionMismatch):
+			api.Error(w, http.StatusPreconditionFailed, api.CodePreconditionFailed, err.Error())
+		case errors.Is(err, errorsx.ErrConcurrentModification):
+			api.Error(w, http.StatusConflict, api.CodeConcurrentModification, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	setETag(w, res.Version)
+	api.RespondJSON(w, responseDTO{
+		PR: dtoFromView(res),
+	})
+}
+
+func (h *Handler) review(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		ID         string `json:"pull_request_id"`
+		ReviewerID string `json:"reviewer_id"`
+		State      string `json:"state"`
+	}
+	type responseDTO struct {
+		PR pullRequestDTO `json:"pr"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	ctx := ifMatchContext(r)
+
+	res, err := h.svc.SubmitReview(ctx, usecases.SubmitReviewRequest{
+		PullRequestID: dto.ID,
+		ReviewerID:    dto.ReviewerID,
+		State:         dto.State,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrReviewState):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		case errors.Is(err, errorsx.ErrNotPreviouslyAssigned):
+			api.Error(w, http.StatusConflict, api.CodeNotAssigned, "reviewer is not assigned to this PR")
+		case errors.Is(err, errorsx.ErrModifyMergedPR):
+			api.Error(w, http.StatusConflict, api.CodePRMerged, "cannot review merged PR")
+		case errors.Is(err, errorsx.ErrModifyClosedPR):
+			api.Error(w, http.StatusConflict, api.CodePRClosed, "cannot review closed PR")
+		case errors.Is(err, errorsx.ErrDraftPR):
+			api.Error(w, http.StatusConflict, api.CodePRDraft, "cannot review draft PR")
+		case errors.Is(err, errorsx.ErrVersionMismatch):
+			api.Error(w, http.StatusPreconditionFailed, api.CodePreconditionFailed, err.Error())
+		case errors.Is(err, errorsx.ErrConcurrentModification):
+			api.Error(w, http.StatusConflict, api.CodeConcurrentModification, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	setETag(w, res.Version)
+	api.RespondJSON(w, responseDTO{
+		PR: dtoFromView(res),
+	})
+}
+
+// parseListFilter reads filters of PR listing, time bounds are RFC 3339.
+func parseListFilter(query url.Values) (usecases.PullRequestFilter, error) {
+	filter := usecases.PullRequestFilter{
+		Status:     query.Get("status"),
+		AuthorID:   query.Get("author_id"),
+		ReviewerID: query.Get("reviewer_id"),
+		TeamName:   query.Get("team_name"),
+	}
+
+	for param, dst := range map[string]*time.Time{
+		"created_from": &filter.CreatedFrom,
+		"created_to":   &filter.CreatedTo,
+		"merged_from":  &filter.MergedFrom,
+		"merged_to":    &filter.MergedTo,
+	} {
+		raw := query.Get(param)
+		if raw == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return usecases.PullRequestFilter{}, err
+		}
+		*dst = t
+	}
+
+	return filter, nil
+}
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	type responseDTO struct {
+		PullRequests []pullRequestDTO `json:"pull_requests"`
+		NextCursor   string           `json:"next_cursor,omitempty"`
+	}
+
+	filter, err := parseListFilter(r.URL.Query())
+	if err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	page, err := api.ParsePage(r.URL.Query())
+	if err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.List(r.Context(), usecases.ListPullRequestsRequest{
+		Filter: filter,
+		Page:   page,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrPRStatus),
+			errors.Is(err, errorsx.ErrPeriod),
+			errors.Is(err, errorsx.ErrPageCursor),
+			errors.Is(err, errorsx.ErrPageLimit):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	pullRequests := make([]pullRequestDTO, len(res.Items))
+	for i, pr := range res.Items {
+		pullRequests[i] = dtoFromView(pr)
+	}
+
+	api.RespondJSON(w, responseDTO{
+		PullRequests: pullRequests,
+		NextCursor:   res.NextCursor,
+	})
+}
+
+func (h *Handler) history(w http.ResponseWriter, r *http.Request) {
+	prID := r.URL.Query().Get("pull_request_id")
+	if prID == "" {
+		api.BadRequest(w)
+		return
+	}
+
+	type eventDTO struct {
+		ReviewerID string    `json:"reviewer_id"`
+		Action     string    `json:"action"`
+		Reason     string    `json:"reason"`
+		Actor      string    `json:"actor"`
+		At         time.Time `json:"at"`
+	}
+	type responseDTO struct {
+		PullRequestID string     `json:"pull_request_id"`
+		Events        []eventDTO `json:"events"`
+	}
+
+	events, err := h.svc.GetHistory(r.Context(), prID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	eventDTOs := make([]eventDTO, len(events))
+	for i, e := range events {
+		eventDTOs[i] = eventDTO{
+			ReviewerID: e.ReviewerID,
+			Action:     e.Action,
+			Reason:     e.Reason,
+			Actor:      e.Actor,
+			At:         e.At,
+		}
+	}
+
+	api.RespondJSON(w, responseDTO{
+		PullRequestID: prID,
+		Events:        eventDTOs,
+	})
+}
+
 func NewHandler(svc usecases.PullRequestService) *Handler {
 	return &Handler{svc: svc}
 }
@@ -0,0 +1,208 @@
+package stats
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/api"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+)
+
+type Handler struct {
+	svc usecases.StatsService
+}
+
+func (h *Handler) InjectRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/stats/reviewers", h.reviewers)
+	mux.HandleFunc("/stats/teams", h.teams)
+	mux.HandleFunc("/stats/leadTime", h.leadTime)
+}
+
+// parsePeriod reads optional RFC 3339 from and to query parameters.
+func parsePeriod(query url.Values) (usecases.StatsPeriod, error) {
+	var period usecases.StatsPeriod
+
+	for param, dst := range map[string]*time.Time{"from": &period.From, "to": &period.To} {
+		raw := query.Get(param)
+		if raw == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return usecases.StatsPeriod{}, err
+		}
+		*dst = t
+	}
+
+	return period, nil
+}
+
+func (h *Handler) reviewers(w http.ResponseWriter, r *http.Request) {
+	type reviewerDTO struct {
+		UserID         string `json:"user_id"`
+		Username       string `json:"username"`
+		Assigned       int    `json:"assigned"`
+		Open           int    `json:"open"`
+		Merged         int    `json:"merged"`
+		ReassignedAway int    `json:"reassigned_away"`
+	}
+	type responseDTO struct {
+		Reviewers []reviewerDTO `json:"reviewers"`
+	}
+
+	period, err := parsePeriod(r.URL.Query())
+	if err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	stats, err := h.svc.GetReviewerStats(r.Context(), period)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrPeriod):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	reviewers := make([]reviewerDTO, len(stats))
+	for i, s := range stats {
+		reviewers[i] = reviewerDTO{
+			UserID:         s.UserID,
+			Username:       s.Username,
+			Assigned:       s.Assigned,
+			Open:           s.Open,
+			Merged:         s.Merged,
+			ReassignedAway: s.ReassignedAway,
+		}
+	}
+
+	api.RespondJSON(w, responseDTO{
+		Reviewers: reviewers,
+	})
+}
+
+func (h *Handler) teams(w http.ResponseWriter, r *http.Request) {
+	type teamDTO struct {
+		TeamName       string `json:"team_name"`
+		PullRequests   int    `json:"pull_requests"`
+		Assigned       int    `json:"assigned"`
+		Open           int    `json:"open"`
+		Merged         int    `json:"merged"`
+		ReassignedAway int    `json:"reassigned_away"`
+	}
+	type responseDTO struct {
+		Teams []teamDTO `json:"teams"`
+	}
+
+	period, err := parsePeriod(r.URL.Query())
+	if err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	stats, err := h.svc.GetTeamStats(r.Context(), period)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrPeriod):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	teams := make([]teamDTO, len(stats))
+	for i, s := range stats {
+		teams[i] = teamDTO{
+			TeamName:       s.TeamName,
+			PullRequests:   s.PullRequests,
+			Assigned:       s.Assigned,
+			Open:           s.Open,
+			Merged:         s.Merged,
+			ReassignedAway: s.ReassignedAway,
+		}
+	}
+
+	api.RespondJSON(w, responseDTO{
+		Teams: teams,
+	})
+}
+
+func (h *Handler) leadTime(w http.ResponseWriter, r *http.Request) {
+	type leadTimeDTO struct {
+		Merged     int     `json:"merged"`
+		P50Seconds float64 `json:"p50_seconds"`
+		P90Seconds float64 `json:"p90_seconds"`
+	}
+	type teamDTO struct {
+		TeamName string `json:"team_name"`
+		leadTimeDTO
+	}
+	type reviewerDTO struct {
+		UserID   string `json:"user_id"`
+		Username string `json:"username"`
+		leadTimeDTO
+	}
+	type responseDTO struct {
+		Teams     []teamDTO     `json:"teams"`
+		Reviewers []reviewerDTO `json:"reviewers"`
+	}
+
+	period, err := parsePeriod(r.URL.Query())
+	if err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.GetLeadTimes(r.Context(), period)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrPeriod):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	leadTimeDTOFrom := func(lt usecases.LeadTime) leadTimeDTO {
+		return leadTimeDTO{
+			Merged:     lt.Merged,
+			P50Seconds: lt.P50.Seconds(),
+			P90Seconds: lt.P90.Seconds(),
+		}
+	}
+
+	teams := make([]teamDTO, len(res.Teams))
+	for i, t := range res.Teams {
+		teams[i] = teamDTO{
+			TeamName:    t.TeamName,
+			leadTimeDTO: leadTimeDTOFrom(t.LeadTime),
+		}
+	}
+
+	reviewers := make([]reviewerDTO, len(res.Reviewers))
+	for i, rv := range res.Reviewers {
+		reviewers[i] = reviewerDTO{
+			UserID:      rv.UserID,
+			Username:    rv.Username,
+			leadTimeDTO: leadTimeDTOFrom(rv.LeadTime),
+		}
+	}
+
+	api.RespondJSON(w, responseDTO{
+		Teams:     teams,
+		Reviewers: reviewers,
+	})
+}
+
+func NewHandler(svc usecases.StatsService) *Handler {
+	return &Handler{svc: svc}
+}
@@ -4,8 +4,11 @@ import (
 	"encoding/json/v2"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/lezzercringe/avito-test-assignment/internal/api"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/middleware"
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
 	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
 	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
 )
@@ -15,8 +18,259 @@ type Handler struct {
 }
 
 func (h *Handler) InjectRoutes(mux *http.ServeMux) {
-	mux.HandleFunc("/team/add", h.add)
+	writers := middleware.RequireRole(auth.RoleAdmin, auth.RoleTeamLead)
+	admins := middleware.RequireRole(auth.RoleAdmin)
+
+	mux.HandleFunc("/team/add", writers(h.add))
 	mux.HandleFunc("/team/get", h.get)
+	mux.HandleFunc("/team/list", h.list)
+	mux.HandleFunc("/team/addMember", writers(h.addMember))
+	mux.HandleFunc("/team/removeMember", writers(h.removeMember))
+	mux.HandleFunc("/team/moveMember", writers(h.moveMember))
+	mux.HandleFunc("/team/rename", writers(h.rename))
+	mux.HandleFunc("/team/delete", admins(h.delete))
+	mux.HandleFunc("/team/settings/get", h.getSettings)
+	mux.HandleFunc("/team/settings/set", writers(h.setSettings))
+}
+
+type settingsDTO struct {
+	TeamName          string `json:"team_name"`
+	Strategy          string `json:"strategy"`
+	ReviewersCount    int    `json:"reviewers_count"`
+	MinReviewersCount int    `json:"min_reviewers_count"`
+	MaxReviewersCount int    `json:"max_reviewers_count"`
+	RequiredApprovals int    `json:"required_approvals"`
+	AllowOtherTeams   bool   `json:"allow_other_teams"`
+	// FallbackTeamNames is the ordered chain of teams to pick reviewers from when team lacks candidates.
+	FallbackTeamNames []string `json:"fallback_team_names"`
+	// ReviewSLAHours is how long a review may stay pending before it is reassigned, 0 disables reassignment.
+	ReviewSLAHours int `json:"review_sla_hours"`
+}
+
+func settingsDTOFromView(v *usecases.TeamSettingsView) settingsDTO {
+	return settingsDTO{
+		TeamName:          v.TeamName,
+		Strategy:          v.Strategy,
+		ReviewersCount:    v.ReviewersCount,
+		MinReviewersCount: v.MinReviewersCount,
+		MaxReviewersCount: v.MaxReviewersCount,
+		RequiredApprovals: v.RequiredApprovals,
+		AllowOtherTeams:   v.AllowOtherTeams,
+		FallbackTeamNames: append([]string{}, v.FallbackTeamNames...),
+		ReviewSLAHours:    int(v.ReviewSLA / time.Hour),
+	}
+}
+
+type teamMemberDTO struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+type teamDTO struct {
+	TeamName string          `json:"team_name"`
+	Members  []teamMemberDTO `json:"members"`
+}
+
+func teamDTOFromView(v *usecases.TeamView) teamDTO {
+	members := make([]teamMemberDTO, len(v.Members))
+	for i, m := range v.Members {
+		members[i] = teamMemberDTO{
+			UserID:   m.ID,
+			Username: m.Name,
+			IsActive: m.Active,
+		}
+	}
+
+	return teamDTO{
+		TeamName: v.Name,
+		Members:  members,
+	}
+}
+
+// respondMembershipError maps errors of membership changes.
+func respondMembershipError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errorsx.ErrForbidden):
+		api.Forbidden(w)
+	case errors.Is(err, errorsx.ErrNotFound):
+		api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+	case errors.Is(err, errorsx.ErrNotTeamMember):
+		api.Error(w, http.StatusNotFound, api.CodeNotFound, err.Error())
+	case errors.Is(err, errorsx.ErrDuplicateMember):
+		api.Error(w, http.StatusConflict, api.CodeMemberExists, err.Error())
+	case errors.Is(err, errorsx.ErrSameTeam),
+		errors.Is(err, errorsx.ErrUserID),
+		errors.Is(err, errorsx.ErrUserName):
+		api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+	case errors.Is(err, errorsx.ErrConcurrentModification):
+		api.Error(w, http.StatusConflict, api.CodeConcurrentModification, err.Error())
+	default:
+		api.InternalServerError(w)
+	}
+}
+
+func (h *Handler) addMember(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		TeamName string `json:"team_name"`
+		teamMemberDTO
+	}
+	type responseDTO struct {
+		Team teamDTO `json:"team"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.AddMember(r.Context(), usecases.AddMemberRequest{
+		TeamName: dto.TeamName,
+		Member: usecases.TeamMemberView{
+			ID:     dto.UserID,
+			Name:   dto.Username,
+			Active: dto.IsActive,
+		},
+	})
+	if err != nil {
+		respondMembershipError(w, err)
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{Team: teamDTOFromView(res)})
+}
+
+func (h *Handler) removeMember(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		TeamName string `json:"team_name"`
+		UserID   string `json:"user_id"`
+	}
+	type responseDTO struct {
+		Team teamDTO `json:"team"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.RemoveMember(r.Context(), usecases.RemoveMemberRequest{
+		TeamName: dto.TeamName,
+		UserID:   dto.UserID,
+	})
+	if err != nil {
+		respondMembershipError(w, err)
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{Team: teamDTOFromView(res)})
+}
+
+func (h *Handler) moveMember(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		UserID       string `json:"user_id"`
+		FromTeamName string `json:"from_team_name"`
+		ToTeamName   string `json:"to_team_name"`
+	}
+	type responseDTO struct {
+		Team teamDTO `json:"team"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.MoveMember(r.Context(), usecases.MoveMemberRequest{
+		UserID:       dto.UserID,
+		FromTeamName: dto.FromTeamName,
+		ToTeamName:   dto.ToTeamName,
+	})
+	if err != nil {
+		respondMembershipError(w, err)
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{Team: teamDTOFromView(res)})
+}
+
+func (h *Handler) rename(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		TeamName    string `json:"team_name"`
+		NewTeamName string `json:"new_team_name"`
+	}
+	type responseDTO struct {
+		Team teamDTO `json:"team"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.RenameTeam(r.Context(), usecases.RenameTeamRequest{
+		Name:    dto.TeamName,
+		NewName: dto.NewTeamName,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrAlreadyExists):
+			api.Error(w, http.StatusConflict, api.CodeTeamExists, "team_name already exists")
+		case errors.Is(err, errorsx.ErrTeamName):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{Team: teamDTOFromView(res)})
+}
+
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		TeamName       string `json:"team_name"`
+		TargetTeamName string `json:"target_team_name,omitempty"`
+	}
+	type responseDTO struct {
+		TeamName string `json:"team_name"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	err := h.svc.DeleteTeam(r.Context(), usecases.DeleteTeamRequest{
+		Name:           dto.TeamName,
+		TargetTeamName: dto.TargetTeamName,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrTeamNotEmpty):
+			api.Error(w, http.StatusConflict, api.CodeTeamNotEmpty, err.Error())
+		case errors.Is(err, errorsx.ErrSameTeam):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{TeamName: dto.TeamName})
 }
 
 func (h *Handler) add(w http.ResponseWriter, r *http.Request) {
@@ -61,6 +315,8 @@ func (h *Handler) add(w http.ResponseWriter, r *http.Request) {
 	res, err := h.svc.AddTeam(r.Context(), req)
 	if err != nil {
 		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
 		case errors.Is(err, errorsx.ErrAlreadyExists):
 			api.Error(w, http.StatusConflict, api.CodeTeamExists, "team_name already exists")
 		default:
@@ -102,6 +358,44 @@ func (h *Handler) add(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	type responseDTO struct {
+		Teams      []teamDTO `json:"teams"`
+		NextCursor string    `json:"next_cursor,omitempty"`
+	}
+
+	page, err := api.ParsePage(r.URL.Query())
+	if err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.ListTeams(r.Context(), usecases.ListTeamsRequest{
+		MemberID: r.URL.Query().Get("member_id"),
+		Page:     page,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrPageCursor),
+			errors.Is(err, errorsx.ErrPageLimit):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	teams := make([]teamDTO, len(res.Items))
+	for i, team := range res.Items {
+		teams[i] = teamDTOFromView(team)
+	}
+
+	api.RespondJSON(w, responseDTO{
+		Teams:      teams,
+		NextCursor: res.NextCursor,
+	})
+}
+
 func (h *Handler) get(w http.ResponseWriter, r *http.Request) {
 	teamName := r.URL.Query().Get("team_name")
 	if teamName == "" {
@@ -153,6 +447,78 @@ func (h *Handler) get(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func (h *Handler) getSettings(w http.ResponseWriter, r *http.Request) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		api.BadRequest(w)
+		return
+	}
+
+	type responseDTO struct {
+		Settings settingsDTO `json:"settings"`
+	}
+
+	res, err := h.svc.GetSettings(r.Context(), teamName)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{
+		Settings: settingsDTOFromView(res),
+	})
+}
+
+func (h *Handler) setSettings(w http.ResponseWriter, r *http.Request) {
+	type responseDTO struct {
+		Settings settingsDTO `json:"settings"`
+	}
+
+	var dto settingsDTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.SetSettings(r.Context(), usecases.TeamSettingsView{
+		TeamName:          dto.TeamName,
+		Strategy:          dto.Strategy,
+		ReviewersCount:    dto.ReviewersCount,
+		MinReviewersCount: dto.MinReviewersCount,
+		MaxReviewersCount: dto.MaxReviewersCount,
+		RequiredApprovals: dto.RequiredApprovals,
+		AllowOtherTeams:   dto.AllowOtherTeams,
+		FallbackTeamNames: dto.FallbackTeamNames,
+		ReviewSLA:         time.Duration(dto.ReviewSLAHours) * time.Hour,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrUnknownStrategy),
+			errors.Is(err, errorsx.ErrReviewersCount),
+			errors.Is(err, errorsx.ErrRequiredApprovals),
+			errors.Is(err, errorsx.ErrFallbackTeams),
+			errors.Is(err, errorsx.ErrReviewSLA):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{
+		Settings: settingsDTOFromView(res),
+	})
+}
+
 func NewHandler(svc usecases.TeamService) *Handler {
 	return &Handler{svc: svc}
 }
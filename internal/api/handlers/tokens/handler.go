@@ -0,0 +1,107 @@
+package tokens
+
+import (
+	"encoding/json/v2"
+	"errors"
+	"net/http"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/api"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/middleware"
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+)
+
+type Handler struct {
+	svc usecases.AuthService
+}
+
+func (h *Handler) InjectRoutes(mux *http.ServeMux) {
+	admins := middleware.RequireRole(auth.RoleAdmin)
+
+	mux.HandleFunc("/auth/token/create", admins(h.create))
+	mux.HandleFunc("/auth/token/revoke", admins(h.revoke))
+}
+
+func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		Name     string `json:"name"`
+		Role     string `json:"role"`
+		TeamName string `json:"team_name,omitempty"`
+	}
+	type responseDTO struct {
+		Name     string `json:"name"`
+		Role     string `json:"role"`
+		TeamName string `json:"team_name,omitempty"`
+		Token    string `json:"token"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.CreateToken(r.Context(), usecases.CreateTokenRequest{
+		Name:     dto.Name,
+		Role:     dto.Role,
+		TeamName: dto.TeamName,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrAlreadyExists):
+			api.Error(w, http.StatusConflict, api.CodeTokenExists, "token with this name already exists")
+		case errors.Is(err, errorsx.ErrTokenName),
+			errors.Is(err, errorsx.ErrUnknownRole),
+			errors.Is(err, errorsx.ErrTeamName):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{
+		Name:     res.Name,
+		Role:     res.Role,
+		TeamName: res.TeamName,
+		Token:    res.Token,
+	})
+}
+
+func (h *Handler) revoke(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		Name string `json:"name"`
+	}
+	type responseDTO struct {
+		Name string `json:"name"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	if err := h.svc.RevokeToken(r.Context(), dto.Name); err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{Name: dto.Name})
+}
+
+func NewHandler(svc usecases.AuthService) *Handler {
+	return &Handler{svc: svc}
+}
@@ -4,8 +4,11 @@ import (
 	"encoding/json/v2"
 	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/lezzercringe/avito-test-assignment/internal/api"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/middleware"
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
 	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
 	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
 )
@@ -15,9 +18,31 @@ type Handler struct {
 }
 
 func (h *Handler) InjectRoutes(mux *http.ServeMux) {
-	mux.HandleFunc("/users/setIsActive", h.setIsActive)
+	writers := middleware.RequireRole(auth.RoleAdmin, auth.RoleTeamLead)
+
+	mux.HandleFunc("/users/setIsActive", writers(h.setIsActive))
+	mux.HandleFunc("/users/setPrimaryTeam", writers(h.setPrimaryTeam))
 	mux.HandleFunc("/users/getReview", h.getReview)
-	mux.HandleFunc("/users/deactivate", h.deactivate)
+	mux.HandleFunc("/users/list", h.list)
+	mux.HandleFunc("/users/deactivate", writers(h.deactivate))
+}
+
+type userWithTeamsDTO struct {
+	ID              string   `json:"user_id"`
+	Name            string   `json:"username"`
+	TeamNames       []string `json:"team_names"`
+	PrimaryTeamName string   `json:"primary_team_name,omitempty"`
+	IsActive        bool     `json:"is_active"`
+}
+
+func userWithTeamsDTOFromView(v *usecases.UserWithTeamsView) userWithTeamsDTO {
+	return userWithTeamsDTO{
+		ID:              v.ID,
+		Name:            v.Name,
+		TeamNames:       v.TeamNames,
+		PrimaryTeamName: v.PrimaryTeamName,
+		IsActive:        v.IsActive,
+	}
 }
 
 func (h *Handler) setIsActive(w http.ResponseWriter, r *http.Request) {
@@ -26,12 +51,7 @@ func (h *Handler) setIsActive(w http.ResponseWriter, r *http.Request) {
 		IsActive bool   `json:"is_active"`
 	}
 	type responseDTO struct {
-		User struct {
-			ID       string `json:"user_id"`
-			Name     string `json:"username"`
-			TeamName string `json:"team_name"`
-			IsActive bool   `json:"is_active"`
-		} `json:"user"`
+		User userWithTeamsDTO `json:"user"`
 	}
 
 	var dto DTO
@@ -43,6 +63,8 @@ func (h *Handler) setIsActive(w http.ResponseWriter, r *http.Request) {
 	res, err := h.svc.SetIsActive(r.Context(), dto.UserID, dto.IsActive)
 	if err != nil {
 		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
 		case errors.Is(err, errorsx.ErrNotFound):
 			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
 		default:
@@ -51,20 +73,40 @@ func (h *Handler) setIsActive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	api.RespondJSON(w, responseDTO{
-		User: struct {
-			ID       string `json:"user_id"`
-			Name     string `json:"username"`
-			TeamName string `json:"team_name"`
-			IsActive bool   `json:"is_active"`
-		}{
-			ID:       res.ID,
-			Name:     res.Name,
-			TeamName: res.TeamName,
-			IsActive: res.IsActive,
-		},
-	})
+	api.RespondJSON(w, responseDTO{User: userWithTeamsDTOFromView(res)})
+}
+
+func (h *Handler) setPrimaryTeam(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		UserID   string `json:"user_id"`
+		TeamName string `json:"team_name"`
+	}
+	type responseDTO struct {
+		User userWithTeamsDTO `json:"user"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.SetPrimaryTeam(r.Context(), dto.UserID, dto.TeamName)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrNotTeamMember):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
 
+	api.RespondJSON(w, responseDTO{User: userWithTeamsDTOFromView(res)})
 }
 
 func (h *Handler) getReview(w http.ResponseWriter, r *http.Request) {
@@ -148,8 +190,12 @@ func (h *Handler) deactivate(w http.ResponseWriter, r *http.Request) {
 	users, err := h.svc.Deactivate(r.Context(), dto.UserIDs...)
 	if err != nil {
 		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
 		case errors.Is(err, errorsx.ErrNotFound):
 			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		case errors.Is(err, errorsx.ErrConcurrentModification):
+			api.Error(w, http.StatusConflict, api.CodeConcurrentModification, err.Error())
 		default:
 			api.InternalServerError(w)
 		}
@@ -179,6 +225,65 @@ func (h *Handler) deactivate(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	type userDTO struct {
+		ID       string `json:"user_id"`
+		Username string `json:"username"`
+		IsActive bool   `json:"is_active"`
+	}
+	type responseDTO struct {
+		Users      []userDTO `json:"users"`
+		NextCursor string    `json:"next_cursor,omitempty"`
+	}
+
+	query := r.URL.Query()
+
+	page, err := api.ParsePage(query)
+	if err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	filter := usecases.UserFilter{TeamName: query.Get("team_name")}
+	if raw := query.Get("is_active"); raw != "" {
+		active, err := strconv.ParseBool(raw)
+		if err != nil {
+			api.BadRequest(w)
+			return
+		}
+		filter.Active = &active
+	}
+
+	res, err := h.svc.ListUsers(r.Context(), usecases.ListUsersRequest{
+		Filter: filter,
+		Page:   page,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrPageCursor),
+			errors.Is(err, errorsx.ErrPageLimit):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	users := make([]userDTO, len(res.Items))
+	for i, user := range res.Items {
+		users[i] = userDTO{
+			ID:       user.ID,
+			Username: user.Name,
+			IsActive: user.IsActive,
+		}
+	}
+
+	api.RespondJSON(w, responseDTO{
+		Users:      users,
+		NextCursor: res.NextCursor,
+	})
+}
+
 func NewHandler(svc usecases.UserService) *Handler {
 	return &Handler{svc: svc}
 }
@@ -0,0 +1,134 @@
+package webhooks
+
+import (
+	"encoding/json/v2"
+	"errors"
+	"net/http"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/api"
+	"github.com/lezzercringe/avito-test-assignment/internal/api/middleware"
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+)
+
+type Handler struct {
+	svc usecases.WebhookService
+}
+
+func (h *Handler) InjectRoutes(mux *http.ServeMux) {
+	admins := middleware.RequireRole(auth.RoleAdmin)
+
+	mux.HandleFunc("/webhooks/create", admins(h.create))
+	mux.HandleFunc("/webhooks/list", admins(h.list))
+	mux.HandleFunc("/webhooks/delete", admins(h.delete))
+}
+
+type webhookDTO struct {
+	ID         int64    `json:"webhook_id"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+}
+
+func webhookDTOFromView(v *usecases.WebhookView) webhookDTO {
+	return webhookDTO{
+		ID:         v.ID,
+		URL:        v.URL,
+		EventTypes: v.EventTypes,
+	}
+}
+
+func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		URL        string   `json:"url"`
+		Secret     string   `json:"secret"`
+		EventTypes []string `json:"event_types"`
+	}
+	type responseDTO struct {
+		Webhook webhookDTO `json:"webhook"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	res, err := h.svc.CreateSubscription(r.Context(), usecases.CreateWebhookRequest{
+		URL:        dto.URL,
+		Secret:     dto.Secret,
+		EventTypes: dto.EventTypes,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrWebhookURL),
+			errors.Is(err, errorsx.ErrWebhookSecret),
+			errors.Is(err, errorsx.ErrWebhookEventTypes):
+			api.Error(w, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{Webhook: webhookDTOFromView(res)})
+}
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	type responseDTO struct {
+		Webhooks []webhookDTO `json:"webhooks"`
+	}
+
+	res, err := h.svc.ListSubscriptions(r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	webhooks := make([]webhookDTO, len(res))
+	for i, v := range res {
+		webhooks[i] = webhookDTOFromView(v)
+	}
+
+	api.RespondJSON(w, responseDTO{Webhooks: webhooks})
+}
+
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request) {
+	type DTO struct {
+		ID int64 `json:"webhook_id"`
+	}
+	type responseDTO struct {
+		ID int64 `json:"webhook_id"`
+	}
+
+	var dto DTO
+	if err := json.UnmarshalRead(r.Body, &dto); err != nil {
+		api.BadRequest(w)
+		return
+	}
+
+	if err := h.svc.DeleteSubscription(r.Context(), dto.ID); err != nil {
+		switch {
+		case errors.Is(err, errorsx.ErrForbidden):
+			api.Forbidden(w)
+		case errors.Is(err, errorsx.ErrNotFound):
+			api.Error(w, http.StatusNotFound, api.CodeNotFound, "resource not found")
+		default:
+			api.InternalServerError(w)
+		}
+		return
+	}
+
+	api.RespondJSON(w, responseDTO{ID: dto.ID})
+}
+
+func NewHandler(svc usecases.WebhookService) *Handler {
+	return &Handler{svc: svc}
+}
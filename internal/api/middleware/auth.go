@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/api"
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"go.uber.org/zap"
+)
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*auth.Principal, error)
+}
+
+// Authentication requires a bearer token on every path except the public ones, e.g. probes.
+// Authenticated principal is stored in the request context and recorded as an actor of changes.
+func Authentication(authn Authenticator, publicPaths ...string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(publicPaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok {
+				api.Unauthorized(w)
+				return
+			}
+
+			principal, err := authn.Authenticate(r.Context(), strings.TrimSpace(token))
+			if errors.Is(err, errorsx.ErrUnauthenticated) {
+				api.Unauthorized(w)
+				return
+			}
+			if err != nil {
+				zap.L().Error("could not authenticate request", zap.Error(err))
+				api.InternalServerError(w)
+				return
+			}
+
+			ctx := auth.WithPrincipal(r.Context(), principal)
+			ctx = usecases.WithActor(ctx, principal.Name)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireRole allows the route only to principals having any of the roles.
+// Scope of team leads is checked by usecases, as it depends on the affected entities.
+func RequireRole(roles ...auth.Role) func(next http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := auth.PrincipalFromContext(r.Context())
+			if !ok || !principal.HasRole(roles...) {
+				api.Forbidden(w)
+				return
+			}
+
+			next(w, r)
+		}
+	}
+}
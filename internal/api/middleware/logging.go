@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -20,12 +21,17 @@ func Logging(logger *zap.Logger) func(next http.Handler) http.Handler {
 
 			duration := time.Since(start)
 
-			logger.Info("HTTP request",
+			fields := []zap.Field{
 				zap.String("method", r.Method),
 				zap.String("path", r.URL.Path),
 				zap.Int("status", aw.statusCode),
 				zap.Duration("duration", duration),
-			)
+			}
+			if sc := trace.SpanContextFromContext(r.Context()); sc.HasTraceID() {
+				fields = append(fields, zap.String("trace_id", sc.TraceID().String()))
+			}
+
+			logger.Info("HTTP request", fields...)
 		})
 	}
 }
@@ -39,3 +45,8 @@ func (aw *augmentedResponseWriter) WriteHeader(code int) {
 	aw.statusCode = code
 	aw.ResponseWriter.WriteHeader(code)
 }
+
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to flush streamed responses.
+func (aw *augmentedResponseWriter) Unwrap() http.ResponseWriter {
+	return aw.ResponseWriter
+}
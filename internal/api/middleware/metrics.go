@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics records request count and latency labelled by route pattern and status code.
+// Route pattern is known only if the mux is wrapped by Route.
+func Metrics(reg prometheus.Registerer) func(next http.Handler) http.Handler {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Number of handled HTTP requests.",
+	}, []string{"method", "route", "status"})
+
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Latency of handled HTTP requests.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	reg.MustRegister(requests, duration)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			r, rt := withRoute(r)
+			aw := &augmentedResponseWriter{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+			}
+
+			next.ServeHTTP(aw, r)
+
+			route := rt.pattern
+			if route == "" {
+				// keeps label cardinality bounded for arbitrary unknown paths
+				route = "unmatched"
+			}
+
+			status := strconv.Itoa(aw.statusCode)
+			requests.WithLabelValues(r.Method, route, status).Inc()
+			duration.WithLabelValues(r.Method, route, status).Observe(time.Since(start).Seconds())
+		})
+	}
+}
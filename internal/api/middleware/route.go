@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+)
+
+type routeKey struct{}
+
+// route holds the pattern matched by http.ServeMux, it is filled by Route once the request is handled.
+type route struct {
+	pattern string
+}
+
+// withRoute returns the request carrying route holder shared by all the middlewares down to the mux.
+func withRoute(r *http.Request) (*http.Request, *route) {
+	if rt, ok := r.Context().Value(routeKey{}).(*route); ok {
+		return r, rt
+	}
+
+	rt := &route{}
+	return r.WithContext(context.WithValue(r.Context(), routeKey{}, rt)), rt
+}
+
+// Route exposes the pattern matched by http.ServeMux to outer middlewares, it must wrap the mux directly.
+// Pattern is set by the mux on the request it receives, which is not seen by middlewares replacing the request.
+func Route() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r)
+
+			if rt, ok := r.Context().Value(routeKey{}).(*route); ok {
+				rt.pattern = r.Pattern
+			}
+		})
+	}
+}
@@ -3,13 +3,15 @@ package middleware
 import (
 	"context"
 	"net/http"
+	"slices"
 	"time"
 )
 
-func Timeout(t time.Duration) func(http.Handler) http.Handler {
+// Timeout limits handling time of every request except the exempt paths, e.g. long-lived streams.
+func Timeout(t time.Duration, exemptPaths ...string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if t != 0 {
+			if t != 0 && !slices.Contains(exemptPaths, r.URL.Path) {
 				ctx, cancel := context.WithTimeout(r.Context(), t)
 				defer cancel()
 				next.ServeHTTP(w, r.WithContext(ctx))
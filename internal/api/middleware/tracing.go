@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/lezzercringe/avito-test-assignment/internal/api"
+
+// Tracing starts a server span for each request, continuing the trace propagated by the client.
+// Span is renamed after the route pattern if the mux is wrapped by Route.
+func Tracing() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+			ctx, span := otel.Tracer(tracerName).Start(ctx, r.Method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.request.method", r.Method),
+					attribute.String("url.path", r.URL.Path),
+				),
+			)
+			defer span.End()
+
+			aw := &augmentedResponseWriter{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+			}
+
+			r, rt := withRoute(r.WithContext(ctx))
+			next.ServeHTTP(aw, r)
+
+			if rt.pattern != "" {
+				span.SetName(r.Method + " " + rt.pattern)
+				span.SetAttributes(attribute.String("http.route", rt.pattern))
+			}
+
+			span.SetAttributes(attribute.Int("http.response.status_code", aw.statusCode))
+			if aw.statusCode >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(aw.statusCode))
+			}
+		})
+	}
+}
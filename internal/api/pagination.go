@@ -0,0 +1,23 @@
+package api
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+)
+
+// ParsePage reads optional cursor and limit query parameters of listing endpoints.
+func ParsePage(query url.Values) (usecases.PageRequest, error) {
+	page := usecases.PageRequest{Cursor: query.Get("cursor")}
+
+	if raw := query.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil {
+			return usecases.PageRequest{}, err
+		}
+		page.Limit = limit
+	}
+
+	return page, nil
+}
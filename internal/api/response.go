@@ -10,14 +10,26 @@ import (
 type ErrorCode string
 
 const (
-	CodeNotFound            ErrorCode = "NOT_FOUND"
-	CodeTeamExists          ErrorCode = "TEAM_EXISTS"
-	CodePRExists            ErrorCode = "PR_EXISTS"
-	CodePRMerged            ErrorCode = "PR_MERGED"
-	CodeNotAssigned         ErrorCode = "NOT_ASSIGNED"
-	CodeNoCandidate         ErrorCode = "NO_CANDIDATE"
-	CodeBadRequest          ErrorCode = "BAD_REQUEST"
-	CodeInternalServerError ErrorCode = "INTERNAL_SERVER_ERROR"
+	CodeNotFound               ErrorCode = "NOT_FOUND"
+	CodeTeamExists             ErrorCode = "TEAM_EXISTS"
+	CodeMemberExists           ErrorCode = "MEMBER_EXISTS"
+	CodeTeamNotEmpty           ErrorCode = "TEAM_NOT_EMPTY"
+	CodePRExists               ErrorCode = "PR_EXISTS"
+	CodeTokenExists            ErrorCode = "TOKEN_EXISTS"
+	CodePRMerged               ErrorCode = "PR_MERGED"
+	CodePRClosed               ErrorCode = "PR_CLOSED"
+	CodePRDraft                ErrorCode = "PR_DRAFT"
+	CodePRNotDraft             ErrorCode = "PR_NOT_DRAFT"
+	CodePRNotClosed            ErrorCode = "PR_NOT_CLOSED"
+	CodeNotEnoughApprovals     ErrorCode = "NOT_ENOUGH_APPROVALS"
+	CodeNotAssigned            ErrorCode = "NOT_ASSIGNED"
+	CodeNoCandidate            ErrorCode = "NO_CANDIDATE"
+	CodeConcurrentModification ErrorCode = "CONCURRENT_MODIFICATION"
+	CodePreconditionFailed     ErrorCode = "PRECONDITION_FAILED"
+	CodeBadRequest             ErrorCode = "BAD_REQUEST"
+	CodeUnauthorized           ErrorCode = "UNAUTHORIZED"
+	CodeForbidden              ErrorCode = "FORBIDDEN"
+	CodeInternalServerError    ErrorCode = "INTERNAL_SERVER_ERROR"
 )
 
 func RespondJSON(w http.ResponseWriter, v any) {
@@ -61,6 +73,15 @@ func BadRequest(w http.ResponseWriter) {
 	Error(w, http.StatusBadRequest, CodeBadRequest, "bad request")
 }
 
+func Unauthorized(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	Error(w, http.StatusUnauthorized, CodeUnauthorized, "missing or invalid api token")
+}
+
+func Forbidden(w http.ResponseWriter) {
+	Error(w, http.StatusForbidden, CodeForbidden, "operation is not permitted")
+}
+
 func InternalServerError(w http.ResponseWriter) {
 	Error(w, http.StatusInternalServerError, CodeInternalServerError, "internal server error")
 }
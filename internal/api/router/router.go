@@ -6,6 +6,7 @@ import (
 	gh "github.com/gorilla/handlers"
 	"github.com/lezzercringe/avito-test-assignment/internal/api/middleware"
 	"github.com/lezzercringe/avito-test-assignment/internal/config"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
 
@@ -20,7 +21,26 @@ func stack(handler http.Handler, middlewares ...func(http.Handler) http.Handler)
 	return handler
 }
 
-func New(log *zap.Logger, cfg config.Config, handlers ...handler) http.Handler {
+// publicPaths are available without authentication.
+// Git host webhooks are authenticated by their signatures instead.
+var publicPaths = []string{
+	"/healthz",
+	"/readyz",
+	"/metrics",
+	"/integrations/github/webhook",
+	"/integrations/gitlab/webhook",
+}
+
+// streamingPaths hold connections open for as long as clients listen, so request timeout is not applied to them.
+var streamingPaths = []string{"/events/stream"}
+
+func New(
+	log *zap.Logger,
+	cfg config.Config,
+	reg prometheus.Registerer,
+	authn middleware.Authenticator,
+	handlers ...handler,
+) http.Handler {
 	mux := http.NewServeMux()
 	for _, h := range handlers {
 		h.InjectRoutes(mux)
@@ -29,7 +49,12 @@ func New(log *zap.Logger, cfg config.Config, handlers ...handler) http.Handler {
 	return stack(
 		mux,
 		gh.RecoveryHandler(),
+		middleware.Timeout(cfg.RequestTimeout, streamingPaths...),
+		middleware.Tracing(),
 		middleware.Logging(log),
-		middleware.Timeout(cfg.RequestTimeout),
+		middleware.Metrics(reg),
+		middleware.Authentication(authn, publicPaths...),
+		// exposes the matched route to tracing and metrics
+		middleware.Route(),
 	)
 }
@@ -0,0 +1,78 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+)
+
+func TestNewPrincipal(t *testing.T) {
+	t.Run("valid team lead", func(t *testing.T) {
+		p, err := auth.NewPrincipal("alice", auth.RoleTeamLead, "backend")
+
+		require.NoError(t, err)
+		assert.Equal(t, "alice", p.Name)
+		assert.Equal(t, auth.RoleTeamLead, p.Role)
+		assert.Equal(t, "backend", p.TeamName)
+	})
+
+	t.Run("empty name validation", func(t *testing.T) {
+		_, err := auth.NewPrincipal("  ", auth.RoleAdmin, "")
+
+		assert.Equal(t, errorsx.ErrTokenName, err)
+	})
+
+	t.Run("unknown role validation", func(t *testing.T) {
+		_, err := auth.NewPrincipal("alice", auth.Role("owner"), "")
+
+		assert.Equal(t, errorsx.ErrUnknownRole, err)
+	})
+
+	t.Run("team lead without team", func(t *testing.T) {
+		_, err := auth.NewPrincipal("alice", auth.RoleTeamLead, "")
+
+		assert.Equal(t, errorsx.ErrTeamName, err)
+	})
+
+	t.Run("team is only allowed for team leads", func(t *testing.T) {
+		_, err := auth.NewPrincipal("alice", auth.RoleReadOnly, "backend")
+
+		assert.Equal(t, errorsx.ErrTeamName, err)
+	})
+}
+
+func TestPrincipal_CanManageTeam(t *testing.T) {
+	admin := &auth.Principal{Name: "root", Role: auth.RoleAdmin}
+	lead := &auth.Principal{Name: "alice", Role: auth.RoleTeamLead, TeamName: "backend"}
+	reader := &auth.Principal{Name: "grafana", Role: auth.RoleReadOnly}
+
+	assert.True(t, admin.CanManageTeam("backend"))
+	assert.True(t, admin.CanManageTeam("frontend"))
+	assert.True(t, lead.CanManageTeam("backend"))
+	assert.False(t, lead.CanManageTeam("frontend"))
+	assert.False(t, reader.CanManageTeam("backend"))
+}
+
+func TestPrincipalFromContext(t *testing.T) {
+	_, ok := auth.PrincipalFromContext(context.Background())
+	assert.False(t, ok)
+
+	p := &auth.Principal{Name: "root", Role: auth.RoleAdmin}
+	got, ok := auth.PrincipalFromContext(auth.WithPrincipal(context.Background(), p))
+	assert.True(t, ok)
+	assert.Same(t, p, got)
+}
+
+func TestHashToken(t *testing.T) {
+	token := auth.GenerateToken()
+
+	assert.NotEqual(t, token, auth.GenerateToken())
+	assert.Equal(t, auth.HashToken(token), auth.HashToken(token))
+	assert.NotEqual(t, token, auth.HashToken(token))
+	assert.Len(t, auth.HashToken(token), 64)
+}
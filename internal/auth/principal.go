@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+)
+
+// Role defines what API token holder is allowed to do.
+type Role string
+
+const (
+	RoleAdmin Role = "admin"
+	// RoleTeamLead may modify only the team it is scoped to and its pull requests.
+	RoleTeamLead Role = "team_lead"
+	RoleReadOnly Role = "read_only"
+)
+
+var roles = []Role{
+	RoleAdmin,
+	RoleTeamLead,
+	RoleReadOnly,
+}
+
+// Principal is an authenticated holder of API token.
+type Principal struct {
+	Name     string
+	Role     Role
+	TeamName string // set for team leads only
+}
+
+func NewPrincipal(name string, role Role, teamName string) (*Principal, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, errorsx.ErrTokenName
+	}
+	if !slices.Contains(roles, role) {
+		return nil, errorsx.ErrUnknownRole
+	}
+	if (role == RoleTeamLead) != (strings.TrimSpace(teamName) != "") {
+		return nil, errorsx.ErrTeamName
+	}
+
+	return &Principal{
+		Name:     name,
+		Role:     role,
+		TeamName: teamName,
+	}, nil
+}
+
+// HasRole reports whether principal has any of the roles.
+func (p *Principal) HasRole(roles ...Role) bool {
+	return slices.Contains(roles, p.Role)
+}
+
+// CanManageTeam reports whether principal may modify the team, its members and pull requests.
+func (p *Principal) CanManageTeam(teamName string) bool {
+	switch p.Role {
+	case RoleAdmin:
+		return true
+	case RoleTeamLead:
+		return p.TeamName == teamName
+	default:
+		return false
+	}
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns principal stored by WithPrincipal.
+// Absence of principal means the operation is performed by the service itself, e.g. by a background job.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
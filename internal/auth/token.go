@@ -0,0 +1,19 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// GenerateToken returns new random token with 128 bits of entropy, only its hash is meant to be stored.
+func GenerateToken() string {
+	return rand.Text()
+}
+
+// HashToken returns hex-encoded SHA-256 hash of the token.
+// Tokens are random and long enough, so no salt or slow hash is needed.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
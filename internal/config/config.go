@@ -5,15 +5,32 @@ import (
 	"time"
 
 	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/tracing"
 	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	Postgres        postgres.Config `yaml:"postgres"`
-	ShutdownTimeout time.Duration   `yaml:"shutdown_timeout"`
-	RequestTimeout  time.Duration   `yaml:"req_timeout"`
-	LogLevel        string          `yaml:"log_level"`
-	ServeAddr       string          `yaml:"serve_addr"`
+	Postgres         postgres.Config `yaml:"postgres"`
+	Tracing          tracing.Config  `yaml:"tracing"`
+	ShutdownTimeout  time.Duration   `yaml:"shutdown_timeout"`
+	DrainDelay       time.Duration   `yaml:"drain_delay"` // how long /readyz reports draining before shutdown
+	RequestTimeout   time.Duration   `yaml:"req_timeout"`
+	LogLevel         string          `yaml:"log_level"`
+	ServeAddr        string          `yaml:"serve_addr"`
+	ReviewerStrategy string          `yaml:"reviewer_strategy"`
+	// OutOfOfficeCheckInterval is how often reviews of users whose out-of-office period has started are reassigned.
+	OutOfOfficeCheckInterval time.Duration `yaml:"ooo_check_interval"`
+	// StaleReviewCheckInterval is how often reviews exceeding their team's review SLA are reassigned.
+	StaleReviewCheckInterval time.Duration `yaml:"stale_review_check_interval"`
+	// WebhookDeliveryInterval is how often pending webhook deliveries are attempted.
+	WebhookDeliveryInterval time.Duration `yaml:"webhook_delivery_interval"`
+	WebhookTimeout          time.Duration `yaml:"webhook_timeout"`
+	// GitHubWebhookSecret verifies signatures of GitHub webhooks, empty value disables the endpoint.
+	GitHubWebhookSecret string `yaml:"github_webhook_secret"`
+	// GitLabWebhookToken is compared with the secret token of GitLab webhooks, empty value disables the endpoint.
+	GitLabWebhookToken string `yaml:"gitlab_webhook_token"`
+	// BootstrapToken authenticates as admin without being stored in the database, empty value disables it.
+	BootstrapToken string `yaml:"bootstrap_token"`
 }
 
 func Load(path string, cfg *Config) error {
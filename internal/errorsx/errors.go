@@ -6,21 +6,42 @@ import "errors"
 var (
 	ErrAlreadyExists = errors.New("entity already exists")
 	ErrNotFound      = errors.New("required entity was not found")
+	ErrPeriod        = errors.New("invalid time period")
+	ErrPageCursor    = errors.New("invalid page cursor")
+	ErrPageLimit     = errors.New("invalid page limit")
 )
 
 // pr-specific errors
 var (
 	ErrModifyMergedPR             = errors.New("cannot modify merged pull request")
+	ErrModifyClosedPR             = errors.New("cannot modify closed pull request")
+	ErrDraftPR                    = errors.New("pull request is a draft")
+	ErrNotDraftPR                 = errors.New("pull request is not a draft")
+	ErrNotClosedPR                = errors.New("pull request is not closed")
 	ErrNotPreviouslyAssigned      = errors.New("reviewer is not assigned to this PR")
 	ErrNoCandidate                = errors.New("no active replacement candidate in team")
 	ErrToManyReviewers            = errors.New("too many reviewers per pr")
 	ErrCandidateIsAlreadyReviewer = errors.New("candidate is already a reviewer")
+	ErrReviewersCount             = errors.New("invalid reviewers count")
+	ErrReviewState                = errors.New("invalid review state")
+	ErrNotEnoughApprovals         = errors.New("not enough approvals to merge pull request")
+	ErrPRStatus                   = errors.New("unknown pull request status")
+	ErrConcurrentModification     = errors.New("pull request was modified concurrently")
+	ErrVersionMismatch            = errors.New("pull request version does not match the expected one")
 )
 
 // team-specific errors
 var (
-	ErrTeamName        = errors.New("invalid team name")
-	ErrDuplicateMember = errors.New("duplicate team member")
+	ErrTeamName          = errors.New("invalid team name")
+	ErrDuplicateMember   = errors.New("duplicate team member")
+	ErrNotTeamMember     = errors.New("user is not a member of the team")
+	ErrAmbiguousTeam     = errors.New("user is a member of several teams and has no primary team")
+	ErrSameTeam          = errors.New("source and destination teams are the same")
+	ErrTeamNotEmpty      = errors.New("team has members or unmerged pull requests")
+	ErrUnknownStrategy   = errors.New("unknown reviewer selection strategy")
+	ErrRequiredApprovals = errors.New("required approvals must not exceed minimal reviewers count")
+	ErrFallbackTeams     = errors.New("fallback teams must be distinct teams other than the team itself")
+	ErrReviewSLA         = errors.New("review SLA must not be negative")
 )
 
 // user-specific errors
@@ -28,3 +49,27 @@ var (
 	ErrUserName = errors.New("invalid user name")
 	ErrUserID   = errors.New("invalid user id")
 )
+
+// auth-specific errors
+var (
+	ErrUnauthenticated = errors.New("missing or invalid api token")
+	ErrForbidden       = errors.New("operation is not permitted")
+	ErrUnknownRole     = errors.New("unknown role")
+	ErrTokenName       = errors.New("invalid token name")
+)
+
+// webhook-specific errors
+var (
+	ErrWebhookURL        = errors.New("webhook url must be an absolute http(s) url")
+	ErrWebhookSecret     = errors.New("webhook secret must not be empty")
+	ErrWebhookEventTypes = errors.New("webhook event types must be distinct known event types")
+)
+
+// integration-specific errors
+var (
+	ErrGitHost             = errors.New("unknown git host")
+	ErrGitLogin            = errors.New("invalid git host login")
+	ErrGitAccountNotLinked = errors.New("git host account is not linked to a user")
+	ErrWebhookSignature    = errors.New("invalid webhook signature")
+	ErrWebhookPayload      = errors.New("malformed webhook payload")
+)
@@ -0,0 +1,32 @@
+package integrations
+
+import (
+	"strings"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+)
+
+// Account links a login on the Git host to a user of the service.
+type Account struct {
+	Host   Host
+	Login  string
+	UserID string
+}
+
+func NewAccount(host Host, login, userID string) (*Account, error) {
+	if !host.Valid() {
+		return nil, errorsx.ErrGitHost
+	}
+	if strings.TrimSpace(login) == "" {
+		return nil, errorsx.ErrGitLogin
+	}
+	if strings.TrimSpace(userID) == "" {
+		return nil, errorsx.ErrUserID
+	}
+
+	return &Account{
+		Host:   host,
+		Login:  login,
+		UserID: userID,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package integrations_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/integrations"
+)
+
+func TestNewAccount(t *testing.T) {
+	t.Run("valid account", func(t *testing.T) {
+		a, err := integrations.NewAccount(integrations.HostGitHub, "octocat", "u1")
+
+		require.NoError(t, err)
+		assert.Equal(t, &integrations.Account{Host: integrations.HostGitHub, Login: "octocat", UserID: "u1"}, a)
+	})
+
+	t.Run("unknown host", func(t *testing.T) {
+		_, err := integrations.NewAccount("bitbucket", "octocat", "u1")
+
+		assert.Equal(t, errorsx.ErrGitHost, err)
+	})
+
+	t.Run("empty login", func(t *testing.T) {
+		_, err := integrations.NewAccount(integrations.HostGitLab, " ", "u1")
+
+		assert.Equal(t, errorsx.ErrGitLogin, err)
+	})
+
+	t.Run("empty user id", func(t *testing.T) {
+		_, err := integrations.NewAccount(integrations.HostGitLab, "tanuki", "")
+
+		assert.Equal(t, errorsx.ErrUserID, err)
+	})
+}
@@ -0,0 +1,72 @@
+package integrations
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+)
+
+// Host is a Git hosting which sends pull request events to the service.
+type Host string
+
+const (
+	HostGitHub Host = "github"
+	HostGitLab Host = "gitlab"
+)
+
+var hosts = []Host{
+	HostGitHub,
+	HostGitLab,
+}
+
+func (h Host) Valid() bool {
+	return slices.Contains(hosts, h)
+}
+
+// Action is a change of pull request state on the host.
+type Action string
+
+const (
+	ActionOpened   Action = "OPENED"
+	ActionClosed   Action = "CLOSED"
+	ActionMerged   Action = "MERGED"
+	ActionReopened Action = "REOPENED"
+)
+
+// PullRequestEvent is a pull request (merge request on GitLab) event received from the host.
+type PullRequestEvent struct {
+	Host Host
+	// DeliveryID is unique per delivery and repeated by the host on redelivery.
+	DeliveryID  string
+	Action      Action
+	Repository  string // full path including owner or namespace
+	Number      int
+	Title       string
+	AuthorLogin string
+}
+
+// PullRequestID is an ID of the service PR mirroring the host's one, unique across hosts and repositories.
+func (e *PullRequestEvent) PullRequestID() string {
+	return fmt.Sprintf("%s:%s#%d", e.Host, e.Repository, e.Number)
+}
+
+func newEvent(host Host, deliveryID string, action Action, repository string, number int, title, authorLogin string) (*PullRequestEvent, error) {
+	if strings.TrimSpace(deliveryID) == "" || repository == "" || number <= 0 {
+		return nil, errorsx.ErrWebhookPayload
+	}
+	if action == ActionOpened && authorLogin == "" {
+		return nil, errorsx.ErrWebhookPayload
+	}
+
+	return &PullRequestEvent{
+		Host:        host,
+		DeliveryID:  deliveryID,
+		Action:      action,
+		Repository:  repository,
+		Number:      number,
+		Title:       title,
+		AuthorLogin: authorLogin,
+	}, nil
+}
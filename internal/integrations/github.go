@@ -0,0 +1,59 @@
+package integrations
+
+import (
+	"crypto/hmac"
+	"encoding/json/v2"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+)
+
+// GitHubPullRequestEvent is a value of X-GitHub-Event header for pull request events.
+const GitHubPullRequestEvent = "pull_request"
+
+// VerifyGitHubSignature checks X-Hub-Signature-256 header, which GitHub computes the same way as outbound webhooks.
+func VerifyGitHubSignature(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(webhooks.Sign(secret, body)), []byte(signature))
+}
+
+type gitHubPayload struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// ParseGitHubEvent parses a body of pull_request event.
+// Nil event is returned for actions which do not change PR state, e.g. edits or pushes.
+func ParseGitHubEvent(deliveryID string, body []byte) (*PullRequestEvent, error) {
+	var payload gitHubPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, errorsx.ErrWebhookPayload
+	}
+
+	var action Action
+	switch payload.Action {
+	case "opened":
+		action = ActionOpened
+	case "closed":
+		action = ActionClosed
+		if payload.PullRequest.Merged {
+			action = ActionMerged
+		}
+	case "reopened":
+		action = ActionReopened
+	default:
+		return nil, nil
+	}
+
+	return newEvent(HostGitHub, deliveryID, action, payload.Repository.FullName,
+		payload.PullRequest.Number, payload.PullRequest.Title, payload.PullRequest.User.Login)
+}
@@ -0,0 +1,84 @@
+package integrations_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/integrations"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+)
+
+func gitHubPayload(action string, merged bool) []byte {
+	m := "false"
+	if merged {
+		m = "true"
+	}
+	return []byte(`{
+		"action": "` + action + `",
+		"number": 12,
+		"pull_request": {"number": 12, "title": "Add search", "merged": ` + m + `, "user": {"login": "octocat"}},
+		"repository": {"full_name": "acme/api"}
+	}`)
+}
+
+func TestVerifyGitHubSignature(t *testing.T) {
+	body := gitHubPayload("opened", false)
+
+	assert.True(t, integrations.VerifyGitHubSignature("s3cret", body, webhooks.Sign("s3cret", body)))
+	assert.False(t, integrations.VerifyGitHubSignature("s3cret", body, webhooks.Sign("other", body)))
+	assert.False(t, integrations.VerifyGitHubSignature("s3cret", body, ""))
+}
+
+func TestParseGitHubEvent(t *testing.T) {
+	t.Run("actions", func(t *testing.T) {
+		cases := []struct {
+			action string
+			merged bool
+			want   integrations.Action
+		}{
+			{"opened", false, integrations.ActionOpened},
+			{"closed", false, integrations.ActionClosed},
+			{"closed", true, integrations.ActionMerged},
+			{"reopened", false, integrations.ActionReopened},
+		}
+		for _, c := range cases {
+			e, err := integrations.ParseGitHubEvent("d-1", gitHubPayload(c.action, c.merged))
+
+			require.NoError(t, err)
+			assert.Equal(t, &integrations.PullRequestEvent{
+				Host:        integrations.HostGitHub,
+				DeliveryID:  "d-1",
+				Action:      c.want,
+				Repository:  "acme/api",
+				Number:      12,
+				Title:       "Add search",
+				AuthorLogin: "octocat",
+			}, e)
+			assert.Equal(t, "github:acme/api#12", e.PullRequestID())
+		}
+	})
+
+	t.Run("irrelevant action", func(t *testing.T) {
+		e, err := integrations.ParseGitHubEvent("d-1", gitHubPayload("synchronize", false))
+
+		require.NoError(t, err)
+		assert.Nil(t, e)
+	})
+
+	t.Run("missing delivery id", func(t *testing.T) {
+		_, err := integrations.ParseGitHubEvent("", gitHubPayload("opened", false))
+
+		assert.Equal(t, errorsx.ErrWebhookPayload, err)
+	})
+
+	t.Run("malformed payload", func(t *testing.T) {
+		for _, body := range []string{`not json`, `{"action": "opened", "pull_request": {"number": 12}}`} {
+			_, err := integrations.ParseGitHubEvent("d-1", []byte(body))
+
+			assert.Equal(t, errorsx.ErrWebhookPayload, err, body)
+		}
+	})
+}
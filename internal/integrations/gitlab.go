@@ -0,0 +1,58 @@
+package integrations
+
+import (
+	"crypto/subtle"
+	"encoding/json/v2"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+)
+
+// GitLabMergeRequestEvent is a value of X-Gitlab-Event header for merge request events.
+const GitLabMergeRequestEvent = "Merge Request Hook"
+
+// VerifyGitLabToken checks X-Gitlab-Token header, GitLab sends the configured secret token as is.
+func VerifyGitLabToken(token, header string) bool {
+	return subtle.ConstantTimeCompare([]byte(token), []byte(header)) == 1
+}
+
+type gitLabPayload struct {
+	ObjectKind string `json:"object_kind"`
+	// User triggered the event, which is the author for opened merge requests.
+	User struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID    int    `json:"iid"`
+		Title  string `json:"title"`
+		Action string `json:"action"`
+	} `json:"object_attributes"`
+}
+
+// ParseGitLabEvent parses a body of merge request event.
+// Nil event is returned for actions which do not change MR state, e.g. updates or approvals.
+func ParseGitLabEvent(deliveryID string, body []byte) (*PullRequestEvent, error) {
+	var payload gitLabPayload
+	if err := json.Unmarshal(body, &payload); err != nil || payload.ObjectKind != "merge_request" {
+		return nil, errorsx.ErrWebhookPayload
+	}
+
+	var action Action
+	switch payload.ObjectAttributes.Action {
+	case "open":
+		action = ActionOpened
+	case "close":
+		action = ActionClosed
+	case "merge":
+		action = ActionMerged
+	case "reopen":
+		action = ActionReopened
+	default:
+		return nil, nil
+	}
+
+	return newEvent(HostGitLab, deliveryID, action, payload.Project.PathWithNamespace,
+		payload.ObjectAttributes.IID, payload.ObjectAttributes.Title, payload.User.Username)
+}
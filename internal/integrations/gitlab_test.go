@@ -0,0 +1,65 @@
+package integrations_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/integrations"
+)
+
+func gitLabPayload(action string) []byte {
+	return []byte(`{
+		"object_kind": "merge_request",
+		"user": {"username": "tanuki"},
+		"project": {"path_with_namespace": "acme/platform/api"},
+		"object_attributes": {"iid": 7, "title": "Add search", "action": "` + action + `"}
+	}`)
+}
+
+func TestVerifyGitLabToken(t *testing.T) {
+	assert.True(t, integrations.VerifyGitLabToken("s3cret", "s3cret"))
+	assert.False(t, integrations.VerifyGitLabToken("s3cret", "other"))
+	assert.False(t, integrations.VerifyGitLabToken("s3cret", ""))
+}
+
+func TestParseGitLabEvent(t *testing.T) {
+	t.Run("actions", func(t *testing.T) {
+		cases := map[string]integrations.Action{
+			"open":   integrations.ActionOpened,
+			"close":  integrations.ActionClosed,
+			"merge":  integrations.ActionMerged,
+			"reopen": integrations.ActionReopened,
+		}
+		for action, want := range cases {
+			e, err := integrations.ParseGitLabEvent("d-1", gitLabPayload(action))
+
+			require.NoError(t, err)
+			assert.Equal(t, &integrations.PullRequestEvent{
+				Host:        integrations.HostGitLab,
+				DeliveryID:  "d-1",
+				Action:      want,
+				Repository:  "acme/platform/api",
+				Number:      7,
+				Title:       "Add search",
+				AuthorLogin: "tanuki",
+			}, e)
+			assert.Equal(t, "gitlab:acme/platform/api#7", e.PullRequestID())
+		}
+	})
+
+	t.Run("irrelevant action", func(t *testing.T) {
+		e, err := integrations.ParseGitLabEvent("d-1", gitLabPayload("approved"))
+
+		require.NoError(t, err)
+		assert.Nil(t, e)
+	})
+
+	t.Run("other object kind", func(t *testing.T) {
+		_, err := integrations.ParseGitLabEvent("d-1", []byte(`{"object_kind": "push"}`))
+
+		assert.Equal(t, errorsx.ErrWebhookPayload, err)
+	})
+}
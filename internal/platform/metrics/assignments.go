@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Assignments counts domain events of reviewer assignment.
+// Counters are updated by decorators around services, pickers and repositories, so usecases stay unaware of metrics.
+type Assignments struct {
+	prsCreated                prometheus.Counter
+	reviewersAssigned         *prometheus.CounterVec
+	noCandidate               *prometheus.CounterVec
+	deactivationReassignments prometheus.Counter
+}
+
+func NewAssignments(reg prometheus.Registerer) *Assignments {
+	m := &Assignments{
+		prsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "pull_requests_created_total",
+			Help: "Number of created pull requests.",
+		}),
+		reviewersAssigned: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "reviewers_assigned_total",
+			Help: "Number of reviewer assignments by reason.",
+		}, []string{"reason"}),
+		noCandidate: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "reviewer_no_candidate_total",
+			Help: "Number of times no reviewer candidate was found in a team.",
+		}, []string{"team"}),
+		deactivationReassignments: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "deactivation_reassignments_total",
+			Help: "Number of reviewers removed from open pull requests because of deactivation.",
+		}),
+	}
+
+	reg.MustRegister(m.prsCreated, m.reviewersAssigned, m.noCandidate, m.deactivationReassignments)
+	return m
+}
+
+// PullRequestService instruments creation of pull requests.
+func (m *Assignments) PullRequestService(next usecases.PullRequestService) usecases.PullRequestService {
+	return &pullRequestService{PullRequestService: next, m: m}
+}
+
+// ReviewerPicker counts ErrNoCandidate returned by the picker, labelled with the team candidates were picked from.
+// It wraps pickers of every strategy, so teams running out of candidates are counted
+// even if their fallback teams fill the gap.
+func (m *Assignments) ReviewerPicker(next usecases.ReviewerPicker) usecases.ReviewerPicker {
+	return &reviewerPicker{next: next, m: m}
+}
+
+// HistoryRepository counts assignments by the appended history events once their transaction commits.
+func (m *Assignments) HistoryRepository(next HistoryRepository) HistoryRepository {
+	return &historyRepository{next: next, m: m}
+}
+
+type pullRequestService struct {
+	usecases.PullRequestService
+	m *Assignments
+}
+
+func (s *pullRequestService) Create(ctx context.Context, req usecases.CreateRequest) (*prs.PullRequestView, error) {
+	view, err := s.PullRequestService.Create(ctx, req)
+	if err == nil {
+		// creation may be a part of the caller's transaction, e.g. of Git host event
+		usecases.AfterCommit(ctx, s.m.prsCreated.Inc)
+	}
+	return view, err
+}
+
+type reviewerPicker struct {
+	next usecases.ReviewerPicker
+	m    *Assignments
+}
+
+func (p *reviewerPicker) PickReviewersFromTeam(ctx context.Context, req usecases.PickReviewersRequest) ([]string, error) {
+	picked, err := p.next.PickReviewersFromTeam(ctx, req)
+	if errors.Is(err, errorsx.ErrNoCandidate) {
+		p.m.noCandidate.WithLabelValues(req.Team.Name).Inc()
+	}
+	return picked, err
+}
+
+// HistoryRepository mirrors the history repository accepted by usecases.
+type HistoryRepository interface {
+	Append(ctx context.Context, events ...prs.AssignmentEvent) error
+	GetByPullRequestID(ctx context.Context, pullRequestID string) ([]prs.AssignmentEvent, error)
+}
+
+type historyRepository struct {
+	next HistoryRepository
+	m    *Assignments
+}
+
+func (r *historyRepository) Append(ctx context.Context, events ...prs.AssignmentEvent) error {
+	if err := r.next.Append(ctx, events...); err != nil {
+		return err
+	}
+
+	usecases.AfterCommit(ctx, func() {
+		for _, e := range events {
+			switch {
+			case e.Action == prs.ActionAssigned:
+				r.m.reviewersAssigned.WithLabelValues(string(e.Reason)).Inc()
+			case e.Action == prs.ActionUnassigned && e.Reason == prs.ReasonDeactivation:
+				r.m.deactivationReassignments.Inc()
+			}
+		}
+	})
+
+	return nil
+}
+
+func (r *historyRepository) GetByPullRequestID(ctx context.Context, pullRequestID string) ([]prs.AssignmentEvent, error) {
+	return r.next.GetByPullRequestID(ctx, pullRequestID)
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
+)
+
+// AdvisoryLocker runs jobs under session-level advisory locks, so a job runs on a single replica at a time.
+type AdvisoryLocker struct {
+	pool *pgxpool.Pool
+}
+
+func NewAdvisoryLocker(pool *pgxpool.Pool) *AdvisoryLocker {
+	return &AdvisoryLocker{pool: pool}
+}
+
+// WithLock wraps the job, so it is skipped unless the lock with the given name is acquired.
+// The lock is held by a dedicated connection until the job returns.
+func (l *AdvisoryLocker) WithLock(name string, job func(context.Context) error) func(context.Context) error {
+	return func(ctx context.Context) (err error) {
+		conn, err := l.pool.Acquire(ctx)
+		if err != nil {
+			return err
+		}
+		defer conn.Release()
+
+		queries := generated.New(conn)
+
+		acquired, err := queries.TryAdvisoryLock(ctx, name)
+		if err != nil || !acquired {
+			return err
+		}
+
+		defer func() {
+			// unlock even if the job was cancelled, otherwise the lock stays with the pooled connection
+			if unlockErr := queries.AdvisoryUnlock(context.WithoutCancel(ctx), name); unlockErr != nil {
+				conn.Conn().Close(context.WithoutCancel(ctx))
+			}
+		}()
+
+		return job(ctx)
+	}
+}
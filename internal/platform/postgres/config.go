@@ -15,5 +15,12 @@ type Config struct {
 func SetupPool(config Config) (*pgxpool.Pool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), config.InitTimeout)
 	defer cancel()
-	return pgxpool.New(ctx, config.ConnString)
+
+	poolConfig, err := pgxpool.ParseConfig(config.ConnString)
+	if err != nil {
+		return nil, err
+	}
+	poolConfig.ConnConfig.Tracer = queryTracer{}
+
+	return pgxpool.NewWithConfig(ctx, poolConfig)
 }
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json/v2"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+)
+
+// eventsChannel carries events enqueued by OutboxRepository, payloads are JSON encoded webhooks.Event.
+const eventsChannel = "assignment_events"
+
+// EventListener receives events committed by any replica via LISTEN/NOTIFY.
+type EventListener struct {
+	pool *pgxpool.Pool
+}
+
+func NewEventListener(pool *pgxpool.Pool) *EventListener {
+	return &EventListener{pool: pool}
+}
+
+// Listen holds a dedicated connection and calls handle for every received event until ctx is done
+// or the connection fails. Events committed while not listening are not received.
+func (l *EventListener) Listen(ctx context.Context, handle func(webhooks.Event)) error {
+	pooled, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// the connection is taken out of the pool and closed afterwards, so it does not stay subscribed
+	conn := pooled.Hijack()
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "LISTEN "+eventsChannel); err != nil {
+		return err
+	}
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var event webhooks.Event
+		if err := json.Unmarshal([]byte(n.Payload), &event); err != nil {
+			continue
+		}
+
+		handle(event)
+	}
+}
@@ -5,12 +5,45 @@
 package generated
 
 import (
+	"time"
+
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiToken struct {
+	Name      string
+	TokenHash string
+	Role      string
+	TeamName  pgtype.Text
+	CreatedAt time.Time
+}
+
+type GitAccount struct {
+	Host   string
+	Login  string
+	UserID string
+}
+
+type IntegrationDelivery struct {
+	Host       string
+	DeliveryID string
+	ReceivedAt time.Time
+}
+
 type Membership struct {
-	TeamName string
-	UserID   string
+	TeamName  string
+	UserID    string
+	IsPrimary bool
+}
+
+type OutOfOfficePeriod struct {
+	ID                int64
+	UserID            string
+	StartsAt          time.Time
+	EndsAt            time.Time
+	Reason            string
+	ReassignReviews   bool
+	ReviewsReassigned bool
 }
 
 type PullRequest struct {
@@ -20,19 +53,75 @@ type PullRequest struct {
 	AuthorID         string
 	Status           string
 	MergedAt         pgtype.Timestamptz
+	ReviewersCount   int32
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Version          int64
 }
 
-type Reviewer struct {
+type ReviewAssignmentsHistory struct {
+	ID            int64
 	PullRequestID string
 	UserID        string
+	Action        string
+	Reason        string
+	Actor         string
+	CreatedAt     time.Time
+}
+
+type ReviewRotationCursor struct {
+	TeamName   string
+	LastUserID string
+}
+
+type Reviewer struct {
+	PullRequestID  string
+	UserID         string
+	State          string
+	StateUpdatedAt time.Time
+	CreatedAt      time.Time
 }
 
 type Team struct {
 	Name string
 }
 
+type TeamSetting struct {
+	TeamName          string
+	Strategy          string
+	ReviewersCount    int32
+	AllowOtherTeams   bool
+	MinReviewersCount int32
+	MaxReviewersCount int32
+	RequiredApprovals int32
+	FallbackTeamNames []string
+	ReviewSlaSeconds  int32
+}
+
 type User struct {
-	ID     string
-	Name   string
-	Active bool
+	ID        string
+	Name      string
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventType      string
+	Payload        []byte
+	Status         string
+	Attempts       int32
+	NextAttemptAt  time.Time
+	LastError      string
+	CreatedAt      time.Time
+}
+
+type WebhookSubscription struct {
+	ID         int64
+	Url        string
+	Secret     string
+	EventTypes []string
+	CreatedAt  time.Time
 }
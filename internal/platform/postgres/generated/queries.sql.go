@@ -12,6 +12,278 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const advisoryUnlock = `-- name: AdvisoryUnlock :exec
+SELECT pg_advisory_unlock(hashtext($1::text))
+`
+
+func (q *Queries) AdvisoryUnlock(ctx context.Context, name string) error {
+	_, err := q.db.Exec(ctx, advisoryUnlock, name)
+	return err
+}
+
+const appendAssignmentEvents = `-- name: AppendAssignmentEvents :exec
+
+INSERT INTO review_assignments_history (pull_request_id, user_id, action, reason, actor, created_at)
+SELECT UNNEST($1::varchar[]), UNNEST($2::varchar[]), UNNEST($3::varchar[]), UNNEST($4::varchar[]), UNNEST($5::varchar[]), UNNEST($6::timestamptz[])
+`
+
+type AppendAssignmentEventsParams struct {
+	Column1 []string
+	Column2 []string
+	Column3 []string
+	Column4 []string
+	Column5 []string
+	Column6 []time.Time
+}
+
+// REVIEW ASSIGNMENTS HISTORY
+func (q *Queries) AppendAssignmentEvents(ctx context.Context, arg AppendAssignmentEventsParams) error {
+	_, err := q.db.Exec(ctx, appendAssignmentEvents,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+		arg.Column5,
+		arg.Column6,
+	)
+	return err
+}
+
+const claimDueWebhookDeliveries = `-- name: ClaimDueWebhookDeliveries :many
+WITH due AS (
+    SELECT id
+    FROM webhook_deliveries
+    WHERE status = 'PENDING' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at, id
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE webhook_deliveries d
+SET next_attempt_at = now() + make_interval(secs => $1::float8)
+FROM due, webhook_subscriptions s
+WHERE d.id = due.id AND s.id = d.subscription_id
+RETURNING d.id, d.subscription_id, s.url, s.secret, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at, d.last_error
+`
+
+type ClaimDueWebhookDeliveriesParams struct {
+	LeaseSeconds float64
+	Limit        int32
+}
+
+type ClaimDueWebhookDeliveriesRow struct {
+	ID             int64
+	SubscriptionID int64
+	Url            string
+	Secret         string
+	EventType      string
+	Payload        []byte
+	Status         string
+	Attempts       int32
+	NextAttemptAt  time.Time
+	LastError      string
+}
+
+func (q *Queries) ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, claimDueWebhookDeliveries, arg.LeaseSeconds, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDueWebhookDeliveriesRow
+	for rows.Next() {
+		var i ClaimDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.Url,
+			&i.Secret,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimIntegrationDelivery = `-- name: ClaimIntegrationDelivery :execrows
+INSERT INTO integration_deliveries (host, delivery_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type ClaimIntegrationDeliveryParams struct {
+	Host       string
+	DeliveryID string
+}
+
+func (q *Queries) ClaimIntegrationDelivery(ctx context.Context, arg ClaimIntegrationDeliveryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimIntegrationDelivery, arg.Host, arg.DeliveryID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const clearPrimaryTeam = `-- name: ClearPrimaryTeam :exec
+UPDATE memberships SET is_primary = false
+WHERE user_id = $1 AND is_primary
+`
+
+func (q *Queries) ClearPrimaryTeam(ctx context.Context, userID string) error {
+	_, err := q.db.Exec(ctx, clearPrimaryTeam, userID)
+	return err
+}
+
+const countOpenReviewsByUserIDs = `-- name: CountOpenReviewsByUserIDs :many
+SELECT r.user_id, COUNT(*) AS open_reviews
+FROM reviewers r
+JOIN pull_requests pr ON pr.id = r.pull_request_id
+WHERE r.user_id = ANY($1::varchar[])
+  AND pr.status = 'OPEN'
+GROUP BY r.user_id
+`
+
+type CountOpenReviewsByUserIDsRow struct {
+	UserID      string
+	OpenReviews int64
+}
+
+func (q *Queries) CountOpenReviewsByUserIDs(ctx context.Context, dollar_1 []string) ([]CountOpenReviewsByUserIDsRow, error) {
+	rows, err := q.db.Query(ctx, countOpenReviewsByUserIDs, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountOpenReviewsByUserIDsRow
+	for rows.Next() {
+		var i CountOpenReviewsByUserIDsRow
+		if err := rows.Scan(&i.UserID, &i.OpenReviews); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countUnmergedPullRequestsByTeam = `-- name: CountUnmergedPullRequestsByTeam :one
+SELECT COUNT(*) FROM pull_requests
+WHERE original_team_name = $1
+  AND status <> 'MERGED'
+`
+
+func (q *Queries) CountUnmergedPullRequestsByTeam(ctx context.Context, originalTeamName string) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnmergedPullRequestsByTeam, originalTeamName)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAPIToken = `-- name: CreateAPIToken :exec
+INSERT INTO api_tokens (name, token_hash, role, team_name)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateAPITokenParams struct {
+	Name      string
+	TokenHash string
+	Role      string
+	TeamName  pgtype.Text
+}
+
+func (q *Queries) CreateAPIToken(ctx context.Context, arg CreateAPITokenParams) error {
+	_, err := q.db.Exec(ctx, createAPIToken,
+		arg.Name,
+		arg.TokenHash,
+		arg.Role,
+		arg.TeamName,
+	)
+	return err
+}
+
+const createOutOfOfficePeriod = `-- name: CreateOutOfOfficePeriod :one
+
+INSERT INTO out_of_office_periods (user_id, starts_at, ends_at, reason, reassign_reviews)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id
+`
+
+type CreateOutOfOfficePeriodParams struct {
+	UserID          string
+	StartsAt        time.Time
+	EndsAt          time.Time
+	Reason          string
+	ReassignReviews bool
+}
+
+// OUT OF OFFICE
+func (q *Queries) CreateOutOfOfficePeriod(ctx context.Context, arg CreateOutOfOfficePeriodParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createOutOfOfficePeriod,
+		arg.UserID,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.Reason,
+		arg.ReassignReviews,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTeam = `-- name: CreateTeam :exec
+INSERT INTO teams (name) VALUES ($1)
+`
+
+func (q *Queries) CreateTeam(ctx context.Context, name string) error {
+	_, err := q.db.Exec(ctx, createTeam, name)
+	return err
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+
+INSERT INTO webhook_subscriptions (url, secret, event_types)
+VALUES ($1, $2, $3)
+RETURNING id
+`
+
+type CreateWebhookSubscriptionParams struct {
+	Url        string
+	Secret     string
+	EventTypes []string
+}
+
+// WEBHOOKS
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createWebhookSubscription, arg.Url, arg.Secret, arg.EventTypes)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteAPIToken = `-- name: DeleteAPIToken :execrows
+DELETE FROM api_tokens
+WHERE name = $1
+`
+
+func (q *Queries) DeleteAPIToken(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAPIToken, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteAllReviewersForPRs = `-- name: DeleteAllReviewersForPRs :exec
 DELETE FROM reviewers WHERE pull_request_id = ANY($1::varchar[])
 `
@@ -21,42 +293,996 @@ func (q *Queries) DeleteAllReviewersForPRs(ctx context.Context, dollar_1 []strin
 	return err
 }
 
-const deleteReviewers = `-- name: DeleteReviewers :exec
-DELETE FROM reviewers WHERE pull_request_id = $1 AND user_id = ANY($2::varchar[])
+const deleteGitAccount = `-- name: DeleteGitAccount :execrows
+DELETE FROM git_accounts
+WHERE host = $1 AND login = $2
+`
+
+type DeleteGitAccountParams struct {
+	Host  string
+	Login string
+}
+
+func (q *Queries) DeleteGitAccount(ctx context.Context, arg DeleteGitAccountParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteGitAccount, arg.Host, arg.Login)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteMembershipsExcept = `-- name: DeleteMembershipsExcept :exec
+DELETE FROM memberships
+WHERE team_name = $1 AND NOT (user_id = ANY($2::varchar[]))
+`
+
+type DeleteMembershipsExceptParams struct {
+	TeamName    string
+	KeepUserIds []string
+}
+
+func (q *Queries) DeleteMembershipsExcept(ctx context.Context, arg DeleteMembershipsExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteMembershipsExcept, arg.TeamName, arg.KeepUserIds)
+	return err
+}
+
+const deleteOutOfOfficePeriod = `-- name: DeleteOutOfOfficePeriod :execrows
+DELETE FROM out_of_office_periods
+WHERE id = $1
+`
+
+func (q *Queries) DeleteOutOfOfficePeriod(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOutOfOfficePeriod, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteReviewers = `-- name: DeleteReviewers :exec
+DELETE FROM reviewers WHERE pull_request_id = $1 AND user_id = ANY($2::varchar[])
+`
+
+type DeleteReviewersParams struct {
+	PullRequestID string
+	Column2       []string
+}
+
+func (q *Queries) DeleteReviewers(ctx context.Context, arg DeleteReviewersParams) error {
+	_, err := q.db.Exec(ctx, deleteReviewers, arg.PullRequestID, arg.Column2)
+	return err
+}
+
+const deleteTeam = `-- name: DeleteTeam :execrows
+DELETE FROM teams WHERE name = $1
+`
+
+func (q *Queries) DeleteTeam(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTeam, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhookSubscription, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueueWebhookDeliveries = `-- name: EnqueueWebhookDeliveries :exec
+INSERT INTO webhook_deliveries (subscription_id, event_type, payload)
+SELECT s.id, e.event_type, e.payload
+FROM (
+    SELECT UNNEST($1::varchar[]) AS event_type, UNNEST($2::jsonb[]) AS payload
+) e
+JOIN webhook_subscriptions s ON e.event_type = ANY(s.event_types)
+`
+
+type EnqueueWebhookDeliveriesParams struct {
+	EventTypes []string
+	Payloads   [][]byte
+}
+
+func (q *Queries) EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) error {
+	_, err := q.db.Exec(ctx, enqueueWebhookDeliveries, arg.EventTypes, arg.Payloads)
+	return err
+}
+
+const ensureRotationCursor = `-- name: EnsureRotationCursor :exec
+
+INSERT INTO review_rotation_cursors (team_name, last_user_id) VALUES ($1, '')
+ON CONFLICT (team_name) DO NOTHING
+`
+
+// REVIEW ROTATION
+func (q *Queries) EnsureRotationCursor(ctx context.Context, teamName string) error {
+	_, err := q.db.Exec(ctx, ensureRotationCursor, teamName)
+	return err
+}
+
+const getAPITokenByHash = `-- name: GetAPITokenByHash :one
+SELECT name, role, team_name
+FROM api_tokens
+WHERE token_hash = $1
+`
+
+type GetAPITokenByHashRow struct {
+	Name     string
+	Role     string
+	TeamName pgtype.Text
+}
+
+func (q *Queries) GetAPITokenByHash(ctx context.Context, tokenHash string) (GetAPITokenByHashRow, error) {
+	row := q.db.QueryRow(ctx, getAPITokenByHash, tokenHash)
+	var i GetAPITokenByHashRow
+	err := row.Scan(&i.Name, &i.Role, &i.TeamName)
+	return i, err
+}
+
+const getAllMemberIDs = `-- name: GetAllMemberIDs :many
+SELECT DISTINCT user_id FROM memberships
+ORDER BY user_id
+`
+
+func (q *Queries) GetAllMemberIDs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, getAllMemberIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAssignmentHistory = `-- name: GetAssignmentHistory :many
+SELECT pull_request_id, user_id, action, reason, actor, created_at FROM review_assignments_history
+WHERE pull_request_id = $1
+ORDER BY created_at, id
+`
+
+type GetAssignmentHistoryRow struct {
+	PullRequestID string
+	UserID        string
+	Action        string
+	Reason        string
+	Actor         string
+	CreatedAt     time.Time
+}
+
+func (q *Queries) GetAssignmentHistory(ctx context.Context, pullRequestID string) ([]GetAssignmentHistoryRow, error) {
+	rows, err := q.db.Query(ctx, getAssignmentHistory, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAssignmentHistoryRow
+	for rows.Next() {
+		var i GetAssignmentHistoryRow
+		if err := rows.Scan(
+			&i.PullRequestID,
+			&i.UserID,
+			&i.Action,
+			&i.Reason,
+			&i.Actor,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGitAccount = `-- name: GetGitAccount :one
+SELECT host, login, user_id
+FROM git_accounts
+WHERE host = $1 AND login = $2
+`
+
+type GetGitAccountParams struct {
+	Host  string
+	Login string
+}
+
+func (q *Queries) GetGitAccount(ctx context.Context, arg GetGitAccountParams) (GitAccount, error) {
+	row := q.db.QueryRow(ctx, getGitAccount, arg.Host, arg.Login)
+	var i GitAccount
+	err := row.Scan(&i.Host, &i.Login, &i.UserID)
+	return i, err
+}
+
+const getManyPRReviewers = `-- name: GetManyPRReviewers :many
+SELECT pull_request_id, user_id, state, state_updated_at, created_at FROM reviewers
+WHERE pull_request_id = ANY($1::varchar[])
+`
+
+func (q *Queries) GetManyPRReviewers(ctx context.Context, dollar_1 []string) ([]Reviewer, error) {
+	rows, err := q.db.Query(ctx, getManyPRReviewers, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Reviewer
+	for rows.Next() {
+		var i Reviewer
+		if err := rows.Scan(
+			&i.PullRequestID,
+			&i.UserID,
+			&i.State,
+			&i.StateUpdatedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getManyPullRequestsByReviewerID = `-- name: GetManyPullRequestsByReviewerID :many
+SELECT pr.id, pr.name, pr.original_team_name, pr.author_id, pr.status, pr.merged_at, pr.reviewers_count, pr.created_at, pr.updated_at, pr.version FROM pull_requests pr
+JOIN reviewers r ON pr.id = r.pull_request_id
+WHERE r.user_id = $1
+`
+
+func (q *Queries) GetManyPullRequestsByReviewerID(ctx context.Context, userID string) ([]PullRequest, error) {
+	rows, err := q.db.Query(ctx, getManyPullRequestsByReviewerID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PullRequest
+	for rows.Next() {
+		var i PullRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.OriginalTeamName,
+			&i.AuthorID,
+			&i.Status,
+			&i.MergedAt,
+			&i.ReviewersCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getManyTeamsByNames = `-- name: GetManyTeamsByNames :many
+SELECT name FROM teams
+WHERE name = ANY($1::varchar[])
+`
+
+func (q *Queries) GetManyTeamsByNames(ctx context.Context, dollar_1 []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, getManyTeamsByNames, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getManyUsersByIDs = `-- name: GetManyUsersByIDs :many
+SELECT u.id, u.name, u.active, EXISTS (
+    SELECT 1 FROM out_of_office_periods p
+    WHERE p.user_id = u.id AND now() >= p.starts_at AND now() < p.ends_at
+) AS out_of_office
+FROM users u
+WHERE u.id = ANY($1::varchar[])
+`
+
+type GetManyUsersByIDsRow struct {
+	ID          string
+	Name        string
+	Active      bool
+	OutOfOffice bool
+}
+
+func (q *Queries) GetManyUsersByIDs(ctx context.Context, dollar_1 []string) ([]GetManyUsersByIDsRow, error) {
+	rows, err := q.db.Query(ctx, getManyUsersByIDs, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetManyUsersByIDsRow
+	for rows.Next() {
+		var i GetManyUsersByIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Active,
+			&i.OutOfOffice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOutOfOfficePeriod = `-- name: GetOutOfOfficePeriod :one
+SELECT id, user_id, starts_at, ends_at, reason, reassign_reviews
+FROM out_of_office_periods
+WHERE id = $1
+`
+
+type GetOutOfOfficePeriodRow struct {
+	ID              int64
+	UserID          string
+	StartsAt        time.Time
+	EndsAt          time.Time
+	Reason          string
+	ReassignReviews bool
+}
+
+func (q *Queries) GetOutOfOfficePeriod(ctx context.Context, id int64) (GetOutOfOfficePeriodRow, error) {
+	row := q.db.QueryRow(ctx, getOutOfOfficePeriod, id)
+	var i GetOutOfOfficePeriodRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Reason,
+		&i.ReassignReviews,
+	)
+	return i, err
+}
+
+const getOutOfOfficePeriodsByUserID = `-- name: GetOutOfOfficePeriodsByUserID :many
+SELECT id, user_id, starts_at, ends_at, reason, reassign_reviews
+FROM out_of_office_periods
+WHERE user_id = $1 AND ends_at > now()
+ORDER BY starts_at, id
+`
+
+type GetOutOfOfficePeriodsByUserIDRow struct {
+	ID              int64
+	UserID          string
+	StartsAt        time.Time
+	EndsAt          time.Time
+	Reason          string
+	ReassignReviews bool
+}
+
+func (q *Queries) GetOutOfOfficePeriodsByUserID(ctx context.Context, userID string) ([]GetOutOfOfficePeriodsByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, getOutOfOfficePeriodsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOutOfOfficePeriodsByUserIDRow
+	for rows.Next() {
+		var i GetOutOfOfficePeriodsByUserIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Reason,
+			&i.ReassignReviews,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPRReviewers = `-- name: GetPRReviewers :many
+SELECT user_id, state, state_updated_at, created_at FROM reviewers WHERE pull_request_id = $1
+`
+
+type GetPRReviewersRow struct {
+	UserID         string
+	State          string
+	StateUpdatedAt time.Time
+	CreatedAt      time.Time
+}
+
+func (q *Queries) GetPRReviewers(ctx context.Context, pullRequestID string) ([]GetPRReviewersRow, error) {
+	rows, err := q.db.Query(ctx, getPRReviewers, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPRReviewersRow
+	for rows.Next() {
+		var i GetPRReviewersRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.State,
+			&i.StateUpdatedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPRsWithAnyReviewers = `-- name: GetPRsWithAnyReviewers :many
+SELECT
+    pr.id,
+    pr.name,
+    pr.original_team_name,
+    pr.author_id,
+    pr.status,
+    pr.merged_at,
+    pr.reviewers_count,
+    pr.created_at,
+    pr.updated_at,
+    pr.version,
+    ARRAY_AGG(r.user_id)::varchar[] AS matched_reviewer_ids
+FROM pull_requests pr
+JOIN reviewers r
+  ON pr.id = r.pull_request_id
+WHERE r.user_id = ANY($1::varchar[])
+  AND pr.status = 'OPEN'
+GROUP BY pr.id, pr.name, pr.original_team_name, pr.author_id, pr.status, pr.merged_at, pr.reviewers_count, pr.created_at, pr.updated_at, pr.version
+ORDER BY pr.id
+`
+
+type GetPRsWithAnyReviewersRow struct {
+	ID                 string
+	Name               string
+	OriginalTeamName   string
+	AuthorID           string
+	Status             string
+	MergedAt           pgtype.Timestamptz
+	ReviewersCount     int32
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	Version            int64
+	MatchedReviewerIds []string
+}
+
+func (q *Queries) GetPRsWithAnyReviewers(ctx context.Context, dollar_1 []string) ([]GetPRsWithAnyReviewersRow, error) {
+	rows, err := q.db.Query(ctx, getPRsWithAnyReviewers, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPRsWithAnyReviewersRow
+	for rows.Next() {
+		var i GetPRsWithAnyReviewersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.OriginalTeamName,
+			&i.AuthorID,
+			&i.Status,
+			&i.MergedAt,
+			&i.ReviewersCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.MatchedReviewerIds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPrimaryTeamNameByMemberID = `-- name: GetPrimaryTeamNameByMemberID :one
+SELECT team_name FROM memberships
+WHERE user_id = $1 AND is_primary
+`
+
+func (q *Queries) GetPrimaryTeamNameByMemberID(ctx context.Context, userID string) (string, error) {
+	row := q.db.QueryRow(ctx, getPrimaryTeamNameByMemberID, userID)
+	var team_name string
+	err := row.Scan(&team_name)
+	return team_name, err
+}
+
+const getPullRequestByID = `-- name: GetPullRequestByID :one
+
+SELECT id, name, original_team_name, author_id, status, merged_at, reviewers_count, created_at, updated_at, version FROM pull_requests
+WHERE id = $1
+`
+
+// PRs
+func (q *Queries) GetPullRequestByID(ctx context.Context, id string) (PullRequest, error) {
+	row := q.db.QueryRow(ctx, getPullRequestByID, id)
+	var i PullRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.OriginalTeamName,
+		&i.AuthorID,
+		&i.Status,
+		&i.MergedAt,
+		&i.ReviewersCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getReviewerLeadTimes = `-- name: GetReviewerLeadTimes :many
+SELECT
+    r.user_id,
+    u.name AS username,
+    COUNT(*)::int AS merged,
+    (percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)))::float8 AS p50_seconds,
+    (percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)))::float8 AS p90_seconds
+FROM reviewers r
+JOIN pull_requests pr ON pr.id = r.pull_request_id
+JOIN users u ON u.id = r.user_id
+WHERE pr.status = 'MERGED'
+  AND ($1::timestamptz IS NULL OR pr.merged_at >= $1::timestamptz)
+  AND ($2::timestamptz IS NULL OR pr.merged_at < $2::timestamptz)
+GROUP BY r.user_id, u.name
+ORDER BY r.user_id
+`
+
+type GetReviewerLeadTimesParams struct {
+	From pgtype.Timestamptz
+	To   pgtype.Timestamptz
+}
+
+type GetReviewerLeadTimesRow struct {
+	UserID     string
+	Username   string
+	Merged     int32
+	P50Seconds float64
+	P90Seconds float64
+}
+
+func (q *Queries) GetReviewerLeadTimes(ctx context.Context, arg GetReviewerLeadTimesParams) ([]GetReviewerLeadTimesRow, error) {
+	rows, err := q.db.Query(ctx, getReviewerLeadTimes, arg.From, arg.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReviewerLeadTimesRow
+	for rows.Next() {
+		var i GetReviewerLeadTimesRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.Merged,
+			&i.P50Seconds,
+			&i.P90Seconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReviewerStats = `-- name: GetReviewerStats :many
+
+SELECT
+    h.user_id,
+    u.name AS username,
+    COUNT(*) FILTER (WHERE h.action = 'ASSIGNED')::int AS assigned,
+    COUNT(DISTINCT h.pull_request_id) FILTER (
+        WHERE h.action = 'ASSIGNED' AND pr.status = 'OPEN' AND r.user_id IS NOT NULL
+    )::int AS open,
+    COUNT(*) FILTER (WHERE h.action = 'UNASSIGNED' AND h.reason = 'MERGE')::int AS merged,
+    COUNT(*) FILTER (
+        WHERE h.action = 'UNASSIGNED' AND h.reason IN ('MANUAL_REASSIGN', 'DEACTIVATION', 'TEAM_CHANGE', 'OUT_OF_OFFICE')
+    )::int AS reassigned_away
+FROM review_assignments_history h
+JOIN users u ON u.id = h.user_id
+JOIN pull_requests pr ON pr.id = h.pull_request_id
+LEFT JOIN reviewers r ON r.pull_request_id = h.pull_request_id AND r.user_id = h.user_id
+WHERE ($1::timestamptz IS NULL OR h.created_at >= $1::timestamptz)
+  AND ($2::timestamptz IS NULL OR h.created_at < $2::timestamptz)
+GROUP BY h.user_id, u.name
+ORDER BY h.user_id
+`
+
+type GetReviewerStatsParams struct {
+	From pgtype.Timestamptz
+	To   pgtype.Timestamptz
+}
+
+type GetReviewerStatsRow struct {
+	UserID         string
+	Username       string
+	Assigned       int32
+	Open           int32
+	Merged         int32
+	ReassignedAway int32
+}
+
+// STATS
+func (q *Queries) GetReviewerStats(ctx context.Context, arg GetReviewerStatsParams) ([]GetReviewerStatsRow, error) {
+	rows, err := q.db.Query(ctx, getReviewerStats, arg.From, arg.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReviewerStatsRow
+	for rows.Next() {
+		var i GetReviewerStatsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.Assigned,
+			&i.Open,
+			&i.Merged,
+			&i.ReassignedAway,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStaleReviewAssignments = `-- name: GetStaleReviewAssignments :many
+SELECT r.pull_request_id, r.user_id
+FROM reviewers r
+JOIN pull_requests pr ON pr.id = r.pull_request_id
+JOIN team_settings ts ON ts.team_name = pr.original_team_name
+WHERE pr.status = 'OPEN' AND r.state = 'PENDING' AND ts.review_sla_seconds > 0
+  AND r.state_updated_at < now() - make_interval(secs => ts.review_sla_seconds)
+ORDER BY r.state_updated_at, r.pull_request_id, r.user_id
+`
+
+type GetStaleReviewAssignmentsRow struct {
+	PullRequestID string
+	UserID        string
+}
+
+func (q *Queries) GetStaleReviewAssignments(ctx context.Context) ([]GetStaleReviewAssignmentsRow, error) {
+	rows, err := q.db.Query(ctx, getStaleReviewAssignments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetStaleReviewAssignmentsRow
+	for rows.Next() {
+		var i GetStaleReviewAssignmentsRow
+		if err := rows.Scan(&i.PullRequestID, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamByName = `-- name: GetTeamByName :one
+
+SELECT name FROM teams
+WHERE name = $1
+`
+
+// TEAMS
+func (q *Queries) GetTeamByName(ctx context.Context, name string) (string, error) {
+	row := q.db.QueryRow(ctx, getTeamByName, name)
+	err := row.Scan(&name)
+	return name, err
+}
+
+const getTeamLeadTimes = `-- name: GetTeamLeadTimes :many
+SELECT
+    pr.original_team_name AS team_name,
+    COUNT(*)::int AS merged,
+    (percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)))::float8 AS p50_seconds,
+    (percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)))::float8 AS p90_seconds
+FROM pull_requests pr
+WHERE pr.status = 'MERGED'
+  AND ($1::timestamptz IS NULL OR pr.merged_at >= $1::timestamptz)
+  AND ($2::timestamptz IS NULL OR pr.merged_at < $2::timestamptz)
+GROUP BY pr.original_team_name
+ORDER BY pr.original_team_name
+`
+
+type GetTeamLeadTimesParams struct {
+	From pgtype.Timestamptz
+	To   pgtype.Timestamptz
+}
+
+type GetTeamLeadTimesRow struct {
+	TeamName   string
+	Merged     int32
+	P50Seconds float64
+	P90Seconds float64
+}
+
+func (q *Queries) GetTeamLeadTimes(ctx context.Context, arg GetTeamLeadTimesParams) ([]GetTeamLeadTimesRow, error) {
+	rows, err := q.db.Query(ctx, getTeamLeadTimes, arg.From, arg.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTeamLeadTimesRow
+	for rows.Next() {
+		var i GetTeamLeadTimesRow
+		if err := rows.Scan(
+			&i.TeamName,
+			&i.Merged,
+			&i.P50Seconds,
+			&i.P90Seconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamMembers = `-- name: GetTeamMembers :many
+SELECT user_id FROM memberships WHERE team_name = $1
+`
+
+func (q *Queries) GetTeamMembers(ctx context.Context, teamName string) ([]string, error) {
+	rows, err := q.db.Query(ctx, getTeamMembers, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamNamesByMemberID = `-- name: GetTeamNamesByMemberID :many
+SELECT team_name FROM memberships
+WHERE user_id = $1
+ORDER BY team_name
+`
+
+func (q *Queries) GetTeamNamesByMemberID(ctx context.Context, userID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, getTeamNamesByMemberID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var team_name string
+		if err := rows.Scan(&team_name); err != nil {
+			return nil, err
+		}
+		items = append(items, team_name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamSettings = `-- name: GetTeamSettings :one
+SELECT team_name, strategy, reviewers_count, min_reviewers_count, max_reviewers_count, required_approvals, allow_other_teams, fallback_team_names, review_sla_seconds FROM team_settings
+WHERE team_name = $1
+`
+
+type GetTeamSettingsRow struct {
+	TeamName          string
+	Strategy          string
+	ReviewersCount    int32
+	MinReviewersCount int32
+	MaxReviewersCount int32
+	RequiredApprovals int32
+	AllowOtherTeams   bool
+	FallbackTeamNames []string
+	ReviewSlaSeconds  int32
+}
+
+func (q *Queries) GetTeamSettings(ctx context.Context, teamName string) (GetTeamSettingsRow, error) {
+	row := q.db.QueryRow(ctx, getTeamSettings, teamName)
+	var i GetTeamSettingsRow
+	err := row.Scan(
+		&i.TeamName,
+		&i.Strategy,
+		&i.ReviewersCount,
+		&i.MinReviewersCount,
+		&i.MaxReviewersCount,
+		&i.RequiredApprovals,
+		&i.AllowOtherTeams,
+		&i.FallbackTeamNames,
+		&i.ReviewSlaSeconds,
+	)
+	return i, err
+}
+
+const getTeamStats = `-- name: GetTeamStats :many
+SELECT
+    pr.original_team_name AS team_name,
+    COUNT(DISTINCT h.pull_request_id)::int AS pull_requests,
+    COUNT(*) FILTER (WHERE h.action = 'ASSIGNED')::int AS assigned,
+    COUNT(DISTINCT (h.pull_request_id, h.user_id)) FILTER (
+        WHERE h.action = 'ASSIGNED' AND pr.status = 'OPEN' AND r.user_id IS NOT NULL
+    )::int AS open,
+    COUNT(*) FILTER (WHERE h.action = 'UNASSIGNED' AND h.reason = 'MERGE')::int AS merged,
+    COUNT(*) FILTER (
+        WHERE h.action = 'UNASSIGNED' AND h.reason IN ('MANUAL_REASSIGN', 'DEACTIVATION', 'TEAM_CHANGE', 'OUT_OF_OFFICE')
+    )::int AS reassigned_away
+FROM review_assignments_history h
+JOIN pull_requests pr ON pr.id = h.pull_request_id
+LEFT JOIN reviewers r ON r.pull_request_id = h.pull_request_id AND r.user_id = h.user_id
+WHERE ($1::timestamptz IS NULL OR h.created_at >= $1::timestamptz)
+  AND ($2::timestamptz IS NULL OR h.created_at < $2::timestamptz)
+GROUP BY pr.original_team_name
+ORDER BY pr.original_team_name
+`
+
+type GetTeamStatsParams struct {
+	From pgtype.Timestamptz
+	To   pgtype.Timestamptz
+}
+
+type GetTeamStatsRow struct {
+	TeamName       string
+	PullRequests   int32
+	Assigned       int32
+	Open           int32
+	Merged         int32
+	ReassignedAway int32
+}
+
+func (q *Queries) GetTeamStats(ctx context.Context, arg GetTeamStatsParams) ([]GetTeamStatsRow, error) {
+	rows, err := q.db.Query(ctx, getTeamStats, arg.From, arg.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTeamStatsRow
+	for rows.Next() {
+		var i GetTeamStatsRow
+		if err := rows.Scan(
+			&i.TeamName,
+			&i.PullRequests,
+			&i.Assigned,
+			&i.Open,
+			&i.Merged,
+			&i.ReassignedAway,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserByID = `-- name: GetUserByID :one
+
+SELECT u.id, u.name, u.active, EXISTS (
+    SELECT 1 FROM out_of_office_periods p
+    WHERE p.user_id = u.id AND now() >= p.starts_at AND now() < p.ends_at
+) AS out_of_office
+FROM users u
+WHERE u.id = $1
 `
 
-type DeleteReviewersParams struct {
-	PullRequestID string
-	Column2       []string
+type GetUserByIDRow struct {
+	ID          string
+	Name        string
+	Active      bool
+	OutOfOffice bool
 }
 
-func (q *Queries) DeleteReviewers(ctx context.Context, arg DeleteReviewersParams) error {
-	_, err := q.db.Exec(ctx, deleteReviewers, arg.PullRequestID, arg.Column2)
-	return err
+// USERS
+func (q *Queries) GetUserByID(ctx context.Context, id string) (GetUserByIDRow, error) {
+	row := q.db.QueryRow(ctx, getUserByID, id)
+	var i GetUserByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Active,
+		&i.OutOfOffice,
+	)
+	return i, err
 }
 
-const getManyPullRequestsByReviewerID = `-- name: GetManyPullRequestsByReviewerID :many
-SELECT id, name, original_team_name, author_id, status, merged_at FROM pull_requests pr
-JOIN reviewers r ON pr.id = r.pull_request_id
-WHERE r.user_id = $1
+const getWebhookSubscriptions = `-- name: GetWebhookSubscriptions :many
+SELECT id, url, secret, event_types
+FROM webhook_subscriptions
+ORDER BY id
 `
 
-func (q *Queries) GetManyPullRequestsByReviewerID(ctx context.Context, userID string) ([]PullRequest, error) {
-	rows, err := q.db.Query(ctx, getManyPullRequestsByReviewerID, userID)
+type GetWebhookSubscriptionsRow struct {
+	ID         int64
+	Url        string
+	Secret     string
+	EventTypes []string
+}
+
+func (q *Queries) GetWebhookSubscriptions(ctx context.Context) ([]GetWebhookSubscriptionsRow, error) {
+	rows, err := q.db.Query(ctx, getWebhookSubscriptions)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []PullRequest
+	var items []GetWebhookSubscriptionsRow
 	for rows.Next() {
-		var i PullRequest
+		var i GetWebhookSubscriptionsRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.OriginalTeamName,
-			&i.AuthorID,
-			&i.Status,
-			&i.MergedAt,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
 		); err != nil {
 			return nil, err
 		}
@@ -68,24 +1294,76 @@ func (q *Queries) GetManyPullRequestsByReviewerID(ctx context.Context, userID st
 	return items, nil
 }
 
-const getManyTeamsByNames = `-- name: GetManyTeamsByNames :many
-SELECT name FROM teams
-WHERE name = ANY($1::varchar[])
+const listPullRequests = `-- name: ListPullRequests :many
+SELECT id, name, original_team_name, author_id, status, merged_at, reviewers_count, created_at, updated_at, version FROM pull_requests pr
+WHERE ($1::varchar IS NULL OR pr.status = $1::varchar)
+  AND ($2::varchar IS NULL OR pr.author_id = $2::varchar)
+  AND ($3::varchar IS NULL OR pr.original_team_name = $3::varchar)
+  AND ($4::varchar IS NULL OR EXISTS (
+    SELECT 1 FROM reviewers r WHERE r.pull_request_id = pr.id AND r.user_id = $4::varchar
+  ))
+  AND ($5::timestamptz IS NULL OR pr.created_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR pr.created_at < $6::timestamptz)
+  AND ($7::timestamptz IS NULL
+    OR (pr.status = 'MERGED' AND pr.merged_at IS NOT NULL AND pr.merged_at >= $7::timestamptz))
+  AND ($8::timestamptz IS NULL
+    OR (pr.status = 'MERGED' AND pr.merged_at IS NOT NULL AND pr.merged_at < $8::timestamptz))
+  AND ($9::timestamptz IS NULL
+    OR (pr.created_at, pr.id) < ($9::timestamptz, $10::varchar))
+ORDER BY pr.created_at DESC, pr.id DESC
+LIMIT $11
 `
 
-func (q *Queries) GetManyTeamsByNames(ctx context.Context, dollar_1 []string) ([]string, error) {
-	rows, err := q.db.Query(ctx, getManyTeamsByNames, dollar_1)
+type ListPullRequestsParams struct {
+	Status         pgtype.Text
+	AuthorID       pgtype.Text
+	TeamName       pgtype.Text
+	ReviewerID     pgtype.Text
+	CreatedFrom    pgtype.Timestamptz
+	CreatedTo      pgtype.Timestamptz
+	MergedFrom     pgtype.Timestamptz
+	MergedTo       pgtype.Timestamptz
+	AfterCreatedAt pgtype.Timestamptz
+	AfterID        string
+	Limit          int32
+}
+
+func (q *Queries) ListPullRequests(ctx context.Context, arg ListPullRequestsParams) ([]PullRequest, error) {
+	rows, err := q.db.Query(ctx, listPullRequests,
+		arg.Status,
+		arg.AuthorID,
+		arg.TeamName,
+		arg.ReviewerID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.MergedFrom,
+		arg.MergedTo,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []PullRequest
 	for rows.Next() {
-		var name string
-		if err := rows.Scan(&name); err != nil {
+		var i PullRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.OriginalTeamName,
+			&i.AuthorID,
+			&i.Status,
+			&i.MergedAt,
+			&i.ReviewersCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, name)
+		items = append(items, i)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -93,21 +1371,42 @@ func (q *Queries) GetManyTeamsByNames(ctx context.Context, dollar_1 []string) ([
 	return items, nil
 }
 
-const getManyUsersByIDs = `-- name: GetManyUsersByIDs :many
-SELECT id, name, active FROM users
-WHERE id = ANY($1::varchar[])
+const listTeams = `-- name: ListTeams :many
+SELECT t.name, COALESCE(
+    ARRAY_AGG(m.user_id ORDER BY m.user_id) FILTER (WHERE m.user_id IS NOT NULL), '{}'
+)::varchar[] AS member_ids
+FROM teams t
+LEFT JOIN memberships m ON m.team_name = t.name
+WHERE t.name > $1::varchar
+  AND ($2::varchar IS NULL OR EXISTS (
+    SELECT 1 FROM memberships f WHERE f.team_name = t.name AND f.user_id = $2::varchar
+  ))
+GROUP BY t.name
+ORDER BY t.name
+LIMIT $3
 `
 
-func (q *Queries) GetManyUsersByIDs(ctx context.Context, dollar_1 []string) ([]User, error) {
-	rows, err := q.db.Query(ctx, getManyUsersByIDs, dollar_1)
+type ListTeamsParams struct {
+	AfterName string
+	MemberID  pgtype.Text
+	Limit     int32
+}
+
+type ListTeamsRow struct {
+	Name      string
+	MemberIds []string
+}
+
+func (q *Queries) ListTeams(ctx context.Context, arg ListTeamsParams) ([]ListTeamsRow, error) {
+	rows, err := q.db.Query(ctx, listTeams, arg.AfterName, arg.MemberID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []User
+	var items []ListTeamsRow
 	for rows.Next() {
-		var i User
-		if err := rows.Scan(&i.ID, &i.Name, &i.Active); err != nil {
+		var i ListTeamsRow
+		if err := rows.Scan(&i.Name, &i.MemberIds); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -118,23 +1417,58 @@ func (q *Queries) GetManyUsersByIDs(ctx context.Context, dollar_1 []string) ([]U
 	return items, nil
 }
 
-const getPRReviewers = `-- name: GetPRReviewers :many
-SELECT user_id FROM reviewers WHERE pull_request_id = $1
+const listUsers = `-- name: ListUsers :many
+SELECT u.id, u.name, u.active, EXISTS (
+    SELECT 1 FROM out_of_office_periods p
+    WHERE p.user_id = u.id AND now() >= p.starts_at AND now() < p.ends_at
+) AS out_of_office
+FROM users u
+WHERE u.id > $1::varchar
+  AND ($2::boolean IS NULL OR u.active = $2::boolean)
+  AND ($3::varchar IS NULL OR EXISTS (
+    SELECT 1 FROM memberships m WHERE m.user_id = u.id AND m.team_name = $3::varchar
+  ))
+ORDER BY u.id
+LIMIT $4
 `
 
-func (q *Queries) GetPRReviewers(ctx context.Context, pullRequestID string) ([]string, error) {
-	rows, err := q.db.Query(ctx, getPRReviewers, pullRequestID)
+type ListUsersParams struct {
+	AfterID  string
+	Active   pgtype.Bool
+	TeamName pgtype.Text
+	Limit    int32
+}
+
+type ListUsersRow struct {
+	ID          string
+	Name        string
+	Active      bool
+	OutOfOffice bool
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]ListUsersRow, error) {
+	rows, err := q.db.Query(ctx, listUsers,
+		arg.AfterID,
+		arg.Active,
+		arg.TeamName,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []ListUsersRow
 	for rows.Next() {
-		var user_id string
-		if err := rows.Scan(&user_id); err != nil {
+		var i ListUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Active,
+			&i.OutOfOffice,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, user_id)
+		items = append(items, i)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -142,51 +1476,53 @@ func (q *Queries) GetPRReviewers(ctx context.Context, pullRequestID string) ([]s
 	return items, nil
 }
 
-const getPRsWithAnyReviewers = `-- name: GetPRsWithAnyReviewers :many
-SELECT
-    pr.id,
-    pr.name,
-    pr.original_team_name,
-    pr.author_id,
-    pr.status,
-    pr.merged_at,
-    ARRAY_AGG(r.user_id)::varchar[] AS matched_reviewer_ids
-FROM pull_requests pr
-JOIN reviewers r
-  ON pr.id = r.pull_request_id
-WHERE r.user_id = ANY($1::varchar[])
-  AND pr.status = 'OPEN'
-GROUP BY pr.id, pr.name, pr.original_team_name, pr.author_id, pr.status, pr.merged_at
-ORDER BY pr.id
+const lockRotationCursor = `-- name: LockRotationCursor :one
+SELECT last_user_id FROM review_rotation_cursors
+WHERE team_name = $1
+FOR UPDATE
 `
 
-type GetPRsWithAnyReviewersRow struct {
-	ID                 string
-	Name               string
-	OriginalTeamName   string
-	AuthorID           string
-	Status             string
-	MergedAt           pgtype.Timestamptz
-	MatchedReviewerIds []string
+func (q *Queries) LockRotationCursor(ctx context.Context, teamName string) (string, error) {
+	row := q.db.QueryRow(ctx, lockRotationCursor, teamName)
+	var last_user_id string
+	err := row.Scan(&last_user_id)
+	return last_user_id, err
 }
 
-func (q *Queries) GetPRsWithAnyReviewers(ctx context.Context, dollar_1 []string) ([]GetPRsWithAnyReviewersRow, error) {
-	rows, err := q.db.Query(ctx, getPRsWithAnyReviewers, dollar_1)
+const lockStartedOutOfOfficePeriodsToReassign = `-- name: LockStartedOutOfOfficePeriodsToReassign :many
+SELECT id, user_id, starts_at, ends_at, reason, reassign_reviews
+FROM out_of_office_periods
+WHERE reassign_reviews AND NOT reviews_reassigned
+  AND starts_at <= now() AND ends_at > now()
+ORDER BY starts_at, id
+FOR UPDATE SKIP LOCKED
+`
+
+type LockStartedOutOfOfficePeriodsToReassignRow struct {
+	ID              int64
+	UserID          string
+	StartsAt        time.Time
+	EndsAt          time.Time
+	Reason          string
+	ReassignReviews bool
+}
+
+func (q *Queries) LockStartedOutOfOfficePeriodsToReassign(ctx context.Context) ([]LockStartedOutOfOfficePeriodsToReassignRow, error) {
+	rows, err := q.db.Query(ctx, lockStartedOutOfOfficePeriodsToReassign)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetPRsWithAnyReviewersRow
+	var items []LockStartedOutOfOfficePeriodsToReassignRow
 	for rows.Next() {
-		var i GetPRsWithAnyReviewersRow
+		var i LockStartedOutOfOfficePeriodsToReassignRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.OriginalTeamName,
-			&i.AuthorID,
-			&i.Status,
-			&i.MergedAt,
-			&i.MatchedReviewerIds,
+			&i.UserID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Reason,
+			&i.ReassignReviews,
 		); err != nil {
 			return nil, err
 		}
@@ -198,136 +1534,183 @@ func (q *Queries) GetPRsWithAnyReviewers(ctx context.Context, dollar_1 []string)
 	return items, nil
 }
 
-const getPullRequestByID = `-- name: GetPullRequestByID :one
+const markOutOfOfficeReviewsReassigned = `-- name: MarkOutOfOfficeReviewsReassigned :exec
+UPDATE out_of_office_periods
+SET reviews_reassigned = true
+WHERE id = ANY($1::bigint[])
+`
 
-SELECT id, name, original_team_name, author_id, status, merged_at FROM pull_requests
-WHERE id = $1
+func (q *Queries) MarkOutOfOfficeReviewsReassigned(ctx context.Context, dollar_1 []int64) error {
+	_, err := q.db.Exec(ctx, markOutOfOfficeReviewsReassigned, dollar_1)
+	return err
+}
+
+const moveUnmergedPullRequestsToTeam = `-- name: MoveUnmergedPullRequestsToTeam :exec
+UPDATE pull_requests SET original_team_name = $1, updated_at = now(), version = version + 1
+WHERE original_team_name = $2
+  AND status <> 'MERGED'
 `
 
-// PRs
-func (q *Queries) GetPullRequestByID(ctx context.Context, id string) (PullRequest, error) {
-	row := q.db.QueryRow(ctx, getPullRequestByID, id)
-	var i PullRequest
-	err := row.Scan(
-		&i.ID,
-		&i.Name,
-		&i.OriginalTeamName,
-		&i.AuthorID,
-		&i.Status,
-		&i.MergedAt,
-	)
-	return i, err
+type MoveUnmergedPullRequestsToTeamParams struct {
+	ToTeamName   string
+	FromTeamName string
+}
+
+func (q *Queries) MoveUnmergedPullRequestsToTeam(ctx context.Context, arg MoveUnmergedPullRequestsToTeamParams) error {
+	_, err := q.db.Exec(ctx, moveUnmergedPullRequestsToTeam, arg.ToTeamName, arg.FromTeamName)
+	return err
 }
 
-const getTeamByMemberID = `-- name: GetTeamByMemberID :one
-SELECT name FROM teams t
-JOIN memberships m ON m.team_name = t.name 
-WHERE m.user_id = $1
+const notifyEvents = `-- name: NotifyEvents :exec
+SELECT pg_notify($1::text, payload)
+FROM UNNEST($2::text[]) AS payload
 `
 
-func (q *Queries) GetTeamByMemberID(ctx context.Context, userID string) (string, error) {
-	row := q.db.QueryRow(ctx, getTeamByMemberID, userID)
-	var name string
-	err := row.Scan(&name)
-	return name, err
+type NotifyEventsParams struct {
+	Channel  string
+	Payloads []string
 }
 
-const getTeamByName = `-- name: GetTeamByName :one
+func (q *Queries) NotifyEvents(ctx context.Context, arg NotifyEventsParams) error {
+	_, err := q.db.Exec(ctx, notifyEvents, arg.Channel, arg.Payloads)
+	return err
+}
 
-SELECT name FROM teams
-WHERE name = $1
+const removeFallbackTeam = `-- name: RemoveFallbackTeam :exec
+UPDATE team_settings SET fallback_team_names = array_remove(fallback_team_names, $1::varchar)
+WHERE $1::varchar = ANY(fallback_team_names)
 `
 
-// TEAMS
-func (q *Queries) GetTeamByName(ctx context.Context, name string) (string, error) {
-	row := q.db.QueryRow(ctx, getTeamByName, name)
-	err := row.Scan(&name)
-	return name, err
+func (q *Queries) RemoveFallbackTeam(ctx context.Context, name string) error {
+	_, err := q.db.Exec(ctx, removeFallbackTeam, name)
+	return err
 }
 
-const getTeamMembers = `-- name: GetTeamMembers :many
-SELECT user_id FROM memberships WHERE team_name = $1
+const renameFallbackTeam = `-- name: RenameFallbackTeam :exec
+UPDATE team_settings SET fallback_team_names = array_replace(fallback_team_names, $1::varchar, $2::varchar)
+WHERE $1::varchar = ANY(fallback_team_names)
 `
 
-func (q *Queries) GetTeamMembers(ctx context.Context, teamName string) ([]string, error) {
-	rows, err := q.db.Query(ctx, getTeamMembers, teamName)
+type RenameFallbackTeamParams struct {
+	Name    string
+	NewName string
+}
+
+func (q *Queries) RenameFallbackTeam(ctx context.Context, arg RenameFallbackTeamParams) error {
+	_, err := q.db.Exec(ctx, renameFallbackTeam, arg.Name, arg.NewName)
+	return err
+}
+
+const renameTeam = `-- name: RenameTeam :execrows
+UPDATE teams SET name = $1
+WHERE name = $2
+`
+
+type RenameTeamParams struct {
+	NewName string
+	Name    string
+}
+
+func (q *Queries) RenameTeam(ctx context.Context, arg RenameTeamParams) (int64, error) {
+	result, err := q.db.Exec(ctx, renameTeam, arg.NewName, arg.Name)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []string
-	for rows.Next() {
-		var user_id string
-		if err := rows.Scan(&user_id); err != nil {
-			return nil, err
-		}
-		items = append(items, user_id)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const getUserByID = `-- name: GetUserByID :one
+const saveGitAccount = `-- name: SaveGitAccount :exec
 
-SELECT id, name, active FROM users
-WHERE id = $1
+INSERT INTO git_accounts (host, login, user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (host, login) DO UPDATE SET user_id = EXCLUDED.user_id
 `
 
-// USERS
-func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
-	row := q.db.QueryRow(ctx, getUserByID, id)
-	var i User
-	err := row.Scan(&i.ID, &i.Name, &i.Active)
-	return i, err
+type SaveGitAccountParams struct {
+	Host   string
+	Login  string
+	UserID string
 }
 
-const saveManyPullRequests = `-- name: SaveManyPullRequests :exec
-INSERT INTO pull_requests(id, name, original_team_name, author_id, status, merged_at)
-SELECT UNNEST($1::varchar[]), UNNEST($2::varchar[]), UNNEST($3::varchar[]), UNNEST($4::varchar[]), UNNEST($5::varchar[]), UNNEST($6::timestamptz[])
+// INTEGRATIONS
+func (q *Queries) SaveGitAccount(ctx context.Context, arg SaveGitAccountParams) error {
+	_, err := q.db.Exec(ctx, saveGitAccount, arg.Host, arg.Login, arg.UserID)
+	return err
+}
+
+const saveManyPullRequests = `-- name: SaveManyPullRequests :execrows
+INSERT INTO pull_requests(id, name, original_team_name, author_id, status, merged_at, reviewers_count, created_at, updated_at, version)
+SELECT UNNEST($1::varchar[]), UNNEST($2::varchar[]), UNNEST($3::varchar[]), UNNEST($4::varchar[]), UNNEST($5::varchar[]), NULLIF(UNNEST($6::timestamptz[]), '0001-01-01 00:00:00+00'::timestamptz), UNNEST($7::int[]), UNNEST($8::timestamptz[]), UNNEST($9::timestamptz[]), UNNEST($10::bigint[]) + 1
 ON CONFLICT (id) DO UPDATE SET
     name = EXCLUDED.name,
     original_team_name = EXCLUDED.original_team_name,
     author_id = EXCLUDED.author_id,
     status = EXCLUDED.status,
-    merged_at = EXCLUDED.merged_at
+    merged_at = EXCLUDED.merged_at,
+    reviewers_count = EXCLUDED.reviewers_count,
+    updated_at = EXCLUDED.updated_at,
+    version = EXCLUDED.version
+WHERE pull_requests.version = EXCLUDED.version - 1
 `
 
 type SaveManyPullRequestsParams struct {
-	Column1 []string
-	Column2 []string
-	Column3 []string
-	Column4 []string
-	Column5 []string
-	Column6 []time.Time
+	Column1  []string
+	Column2  []string
+	Column3  []string
+	Column4  []string
+	Column5  []string
+	Column6  []time.Time
+	Column7  []int32
+	Column8  []time.Time
+	Column9  []time.Time
+	Column10 []int64
 }
 
-func (q *Queries) SaveManyPullRequests(ctx context.Context, arg SaveManyPullRequestsParams) error {
-	_, err := q.db.Exec(ctx, saveManyPullRequests,
+// $10 are versions the pull requests were read at, outdated ones are left intact.
+// Zero time in $6 stands for pull requests which are not merged.
+func (q *Queries) SaveManyPullRequests(ctx context.Context, arg SaveManyPullRequestsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, saveManyPullRequests,
 		arg.Column1,
 		arg.Column2,
 		arg.Column3,
 		arg.Column4,
 		arg.Column5,
 		arg.Column6,
+		arg.Column7,
+		arg.Column8,
+		arg.Column9,
+		arg.Column10,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const saveManyReviewers = `-- name: SaveManyReviewers :exec
-INSERT INTO reviewers (pull_request_id, user_id)
-SELECT UNNEST($1::varchar[]), UNNEST($2::varchar[])
-ON CONFLICT (user_id, pull_request_id) DO NOTHING
+INSERT INTO reviewers (pull_request_id, user_id, state, state_updated_at, created_at)
+SELECT UNNEST($1::varchar[]), UNNEST($2::varchar[]), UNNEST($3::varchar[]), UNNEST($4::timestamptz[]), UNNEST($5::timestamptz[])
+ON CONFLICT (user_id, pull_request_id) DO UPDATE SET
+    state = EXCLUDED.state,
+    state_updated_at = EXCLUDED.state_updated_at
 `
 
 type SaveManyReviewersParams struct {
 	Column1 []string
 	Column2 []string
+	Column3 []string
+	Column4 []time.Time
+	Column5 []time.Time
 }
 
 func (q *Queries) SaveManyReviewers(ctx context.Context, arg SaveManyReviewersParams) error {
-	_, err := q.db.Exec(ctx, saveManyReviewers, arg.Column1, arg.Column2)
+	_, err := q.db.Exec(ctx, saveManyReviewers,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+		arg.Column5,
+	)
 	return err
 }
 
@@ -337,7 +1720,10 @@ SELECT UNNEST($1::varchar[]), UNNEST($2::varchar[]), UNNEST($3::boolean[])
 ON CONFLICT (id)
 DO UPDATE SET
     name = EXCLUDED.name,
-    active = EXCLUDED.active
+    active = EXCLUDED.active,
+    -- members are saved along with their teams, so only actual changes count as updates
+    updated_at = CASE WHEN (users.name, users.active) IS DISTINCT FROM (EXCLUDED.name, EXCLUDED.active)
+        THEN now() ELSE users.updated_at END
 `
 
 type SaveManyUsersParams struct {
@@ -353,6 +1739,7 @@ func (q *Queries) SaveManyUsers(ctx context.Context, arg SaveManyUsersParams) er
 
 const saveMembership = `-- name: SaveMembership :exec
 INSERT INTO memberships (team_name, user_id) VALUES ($1, $2)
+ON CONFLICT DO NOTHING
 `
 
 type SaveMembershipParams struct {
@@ -365,15 +1752,19 @@ func (q *Queries) SaveMembership(ctx context.Context, arg SaveMembershipParams)
 	return err
 }
 
-const savePullRequest = `-- name: SavePullRequest :exec
-INSERT INTO pull_requests(id, name, original_team_name, author_id, status, merged_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+const savePullRequest = `-- name: SavePullRequest :execrows
+INSERT INTO pull_requests(id, name, original_team_name, author_id, status, merged_at, reviewers_count, created_at, updated_at, version)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10::bigint + 1)
 ON CONFLICT (id) DO UPDATE SET
     name = EXCLUDED.name,
     original_team_name = EXCLUDED.original_team_name,
     author_id = EXCLUDED.author_id,
     status = EXCLUDED.status,
-    merged_at = EXCLUDED.merged_at
+    merged_at = EXCLUDED.merged_at,
+    reviewers_count = EXCLUDED.reviewers_count,
+    updated_at = EXCLUDED.updated_at,
+    version = EXCLUDED.version
+WHERE pull_requests.version = $10::bigint
 `
 
 type SavePullRequestParams struct {
@@ -383,18 +1774,30 @@ type SavePullRequestParams struct {
 	AuthorID         string
 	Status           string
 	MergedAt         pgtype.Timestamptz
+	ReviewersCount   int32
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Column10         int64
 }
 
-func (q *Queries) SavePullRequest(ctx context.Context, arg SavePullRequestParams) error {
-	_, err := q.db.Exec(ctx, savePullRequest,
+// Existing pull request is updated only if it is still of the version it was read at ($10).
+func (q *Queries) SavePullRequest(ctx context.Context, arg SavePullRequestParams) (int64, error) {
+	result, err := q.db.Exec(ctx, savePullRequest,
 		arg.ID,
 		arg.Name,
 		arg.OriginalTeamName,
 		arg.AuthorID,
 		arg.Status,
 		arg.MergedAt,
+		arg.ReviewersCount,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.Column10,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const saveReviewers = `-- name: SaveReviewers :exec
@@ -413,6 +1816,21 @@ func (q *Queries) SaveReviewers(ctx context.Context, arg SaveReviewersParams) er
 	return err
 }
 
+const saveRotationCursor = `-- name: SaveRotationCursor :exec
+UPDATE review_rotation_cursors SET last_user_id = $2
+WHERE team_name = $1
+`
+
+type SaveRotationCursorParams struct {
+	TeamName   string
+	LastUserID string
+}
+
+func (q *Queries) SaveRotationCursor(ctx context.Context, arg SaveRotationCursorParams) error {
+	_, err := q.db.Exec(ctx, saveRotationCursor, arg.TeamName, arg.LastUserID)
+	return err
+}
+
 const saveTeam = `-- name: SaveTeam :exec
 INSERT INTO teams (name) VALUES ($1)
 ON CONFLICT (name)
@@ -424,10 +1842,53 @@ func (q *Queries) SaveTeam(ctx context.Context, name string) error {
 	return err
 }
 
+const saveTeamSettings = `-- name: SaveTeamSettings :exec
+INSERT INTO team_settings (team_name, strategy, reviewers_count, min_reviewers_count, max_reviewers_count, required_approvals, allow_other_teams, fallback_team_names, review_sla_seconds)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (team_name) DO UPDATE SET
+    strategy = EXCLUDED.strategy,
+    reviewers_count = EXCLUDED.reviewers_count,
+    min_reviewers_count = EXCLUDED.min_reviewers_count,
+    max_reviewers_count = EXCLUDED.max_reviewers_count,
+    required_approvals = EXCLUDED.required_approvals,
+    allow_other_teams = EXCLUDED.allow_other_teams,
+    fallback_team_names = EXCLUDED.fallback_team_names,
+    review_sla_seconds = EXCLUDED.review_sla_seconds
+`
+
+type SaveTeamSettingsParams struct {
+	TeamName          string
+	Strategy          string
+	ReviewersCount    int32
+	MinReviewersCount int32
+	MaxReviewersCount int32
+	RequiredApprovals int32
+	AllowOtherTeams   bool
+	FallbackTeamNames []string
+	ReviewSlaSeconds  int32
+}
+
+func (q *Queries) SaveTeamSettings(ctx context.Context, arg SaveTeamSettingsParams) error {
+	_, err := q.db.Exec(ctx, saveTeamSettings,
+		arg.TeamName,
+		arg.Strategy,
+		arg.ReviewersCount,
+		arg.MinReviewersCount,
+		arg.MaxReviewersCount,
+		arg.RequiredApprovals,
+		arg.AllowOtherTeams,
+		arg.FallbackTeamNames,
+		arg.ReviewSlaSeconds,
+	)
+	return err
+}
+
 const saveUser = `-- name: SaveUser :exec
 INSERT INTO users (id, name, active) VALUES ($1, $2, $3)
-ON CONFLICT (id) 
-DO UPDATE SET name = excluded.name, active = excluded.active
+ON CONFLICT (id)
+DO UPDATE SET name = excluded.name, active = excluded.active,
+    updated_at = CASE WHEN (users.name, users.active) IS DISTINCT FROM (excluded.name, excluded.active)
+        THEN now() ELSE users.updated_at END
 `
 
 type SaveUserParams struct {
@@ -440,3 +1901,59 @@ func (q *Queries) SaveUser(ctx context.Context, arg SaveUserParams) error {
 	_, err := q.db.Exec(ctx, saveUser, arg.ID, arg.Name, arg.Active)
 	return err
 }
+
+const saveWebhookDelivery = `-- name: SaveWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5
+WHERE id = $1
+`
+
+type SaveWebhookDeliveryParams struct {
+	ID            int64
+	Status        string
+	Attempts      int32
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+func (q *Queries) SaveWebhookDelivery(ctx context.Context, arg SaveWebhookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, saveWebhookDelivery,
+		arg.ID,
+		arg.Status,
+		arg.Attempts,
+		arg.NextAttemptAt,
+		arg.LastError,
+	)
+	return err
+}
+
+const setPrimaryTeam = `-- name: SetPrimaryTeam :execrows
+UPDATE memberships SET is_primary = true
+WHERE user_id = $1 AND team_name = $2
+`
+
+type SetPrimaryTeamParams struct {
+	UserID   string
+	TeamName string
+}
+
+func (q *Queries) SetPrimaryTeam(ctx context.Context, arg SetPrimaryTeamParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setPrimaryTeam, arg.UserID, arg.TeamName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const tryAdvisoryLock = `-- name: TryAdvisoryLock :one
+
+SELECT pg_try_advisory_lock(hashtext($1::text))::boolean AS acquired
+`
+
+// LOCKS
+func (q *Queries) TryAdvisoryLock(ctx context.Context, name string) (bool, error) {
+	row := q.db.QueryRow(ctx, tryAdvisoryLock, name)
+	var acquired bool
+	err := row.Scan(&acquired)
+	return acquired, err
+}
@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/integrations"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
+)
+
+type GitAccountRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewGitAccountRepository(pool *pgxpool.Pool) *GitAccountRepository {
+	return &GitAccountRepository{pool: pool}
+}
+
+func (r *GitAccountRepository) getQueries(ctx context.Context) *generated.Queries {
+	if tx, ok := ctx.Value(txKey).(pgx.Tx); ok {
+		return generated.New(tx)
+	}
+	return generated.New(r.pool)
+}
+
+func (r *GitAccountRepository) Get(ctx context.Context, host integrations.Host, login string) (a *integrations.Account, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	row, err := r.getQueries(ctx).GetGitAccount(ctx, generated.GetGitAccountParams{
+		Host:  string(host),
+		Login: login,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &integrations.Account{
+		Host:   integrations.Host(row.Host),
+		Login:  row.Login,
+		UserID: row.UserID,
+	}, nil
+}
+
+func (r *GitAccountRepository) Save(ctx context.Context, a *integrations.Account) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	return r.getQueries(ctx).SaveGitAccount(ctx, generated.SaveGitAccountParams{
+		Host:   string(a.Host),
+		Login:  a.Login,
+		UserID: a.UserID,
+	})
+}
+
+func (r *GitAccountRepository) Delete(ctx context.Context, host integrations.Host, login string) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	affected, err := r.getQueries(ctx).DeleteGitAccount(ctx, generated.DeleteGitAccountParams{
+		Host:  string(host),
+		Login: login,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errorsx.ErrNotFound
+	}
+
+	return nil
+}
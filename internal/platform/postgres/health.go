@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// HealthChecker probes database availability and schema version.
+type HealthChecker struct {
+	pool *pgxpool.Pool
+}
+
+func NewHealthChecker(pool *pgxpool.Pool) *HealthChecker {
+	return &HealthChecker{pool: pool}
+}
+
+func (c *HealthChecker) Ping(ctx context.Context) error {
+	return c.pool.Ping(ctx)
+}
+
+// MigrationVersion returns the latest migration version applied by goose.
+func (c *HealthChecker) MigrationVersion(ctx context.Context) (version int64, err error) {
+	err = c.pool.QueryRow(ctx, "SELECT COALESCE(MAX(version_id), 0) FROM goose_db_version").Scan(&version)
+	return version, err
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+)
+
+type HistoryRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewHistoryRepository(pool *pgxpool.Pool) *HistoryRepository {
+	return &HistoryRepository{pool: pool}
+}
+
+func (r *HistoryRepository) getQueries(ctx context.Context) *generated.Queries {
+	if tx, ok := ctx.Value(txKey).(pgx.Tx); ok {
+		return generated.New(tx)
+	}
+	return generated.New(r.pool)
+}
+
+func (r *HistoryRepository) Append(ctx context.Context, events ...prs.AssignmentEvent) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	var params generated.AppendAssignmentEventsParams
+	for _, e := range events {
+		params.Column1 = append(params.Column1, e.PullRequestID)
+		params.Column2 = append(params.Column2, e.ReviewerID)
+		params.Column3 = append(params.Column3, string(e.Action))
+		params.Column4 = append(params.Column4, string(e.Reason))
+		params.Column5 = append(params.Column5, e.Actor)
+		params.Column6 = append(params.Column6, e.At)
+	}
+
+	return queries.AppendAssignmentEvents(ctx, params)
+}
+
+func (r *HistoryRepository) GetByPullRequestID(ctx context.Context, pullRequestID string) (events []prs.AssignmentEvent, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	rows, err := queries.GetAssignmentHistory(ctx, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	events = make([]prs.AssignmentEvent, len(rows))
+	for i, row := range rows {
+		events[i] = prs.AssignmentEvent{
+			PullRequestID: row.PullRequestID,
+			ReviewerID:    row.UserID,
+			Action:        prs.AssignmentAction(row.Action),
+			Reason:        prs.AssignmentReason(row.Reason),
+			Actor:         row.Actor,
+			At:            row.CreatedAt,
+		}
+	}
+
+	return events, nil
+}
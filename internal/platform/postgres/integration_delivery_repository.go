@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/integrations"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
+)
+
+type IntegrationDeliveryRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewIntegrationDeliveryRepository(pool *pgxpool.Pool) *IntegrationDeliveryRepository {
+	return &IntegrationDeliveryRepository{pool: pool}
+}
+
+func (r *IntegrationDeliveryRepository) getQueries(ctx context.Context) *generated.Queries {
+	if tx, ok := ctx.Value(txKey).(pgx.Tx); ok {
+		return generated.New(tx)
+	}
+	return generated.New(r.pool)
+}
+
+func (r *IntegrationDeliveryRepository) Claim(ctx context.Context, host integrations.Host, deliveryID string) (claimed bool, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	affected, err := r.getQueries(ctx).ClaimIntegrationDelivery(ctx, generated.ClaimIntegrationDeliveryParams{
+		Host:       string(host),
+		DeliveryID: deliveryID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
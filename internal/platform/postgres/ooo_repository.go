@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+)
+
+type OutOfOfficeRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewOutOfOfficeRepository(pool *pgxpool.Pool) *OutOfOfficeRepository {
+	return &OutOfOfficeRepository{pool: pool}
+}
+
+func (r *OutOfOfficeRepository) getQueries(ctx context.Context) *generated.Queries {
+	if tx, ok := ctx.Value(txKey).(pgx.Tx); ok {
+		return generated.New(tx)
+	}
+	return generated.New(r.pool)
+}
+
+func outOfOfficeFromRow(row generated.GetOutOfOfficePeriodRow) *users.OutOfOffice {
+	return &users.OutOfOffice{
+		ID:              row.ID,
+		UserID:          row.UserID,
+		StartsAt:        row.StartsAt,
+		EndsAt:          row.EndsAt,
+		Reason:          row.Reason,
+		ReassignReviews: row.ReassignReviews,
+	}
+}
+
+// Create inserts the period and sets its ID.
+func (r *OutOfOfficeRepository) Create(ctx context.Context, o *users.OutOfOffice) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	id, err := r.getQueries(ctx).CreateOutOfOfficePeriod(ctx, generated.CreateOutOfOfficePeriodParams{
+		UserID:          o.UserID,
+		StartsAt:        o.StartsAt,
+		EndsAt:          o.EndsAt,
+		Reason:          o.Reason,
+		ReassignReviews: o.ReassignReviews,
+	})
+	if err != nil {
+		return err
+	}
+
+	o.ID = id
+	return nil
+}
+
+func (r *OutOfOfficeRepository) Get(ctx context.Context, id int64) (o *users.OutOfOffice, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	row, err := r.getQueries(ctx).GetOutOfOfficePeriod(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return outOfOfficeFromRow(row), nil
+}
+
+func (r *OutOfOfficeRepository) GetManyByUserID(ctx context.Context, userID string) (result []*users.OutOfOffice, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	rows, err := r.getQueries(ctx).GetOutOfOfficePeriodsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]*users.OutOfOffice, len(rows))
+	for i, row := range rows {
+		result[i] = outOfOfficeFromRow(generated.GetOutOfOfficePeriodRow(row))
+	}
+
+	return result, nil
+}
+
+func (r *OutOfOfficeRepository) Delete(ctx context.Context, id int64) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	affected, err := r.getQueries(ctx).DeleteOutOfOfficePeriod(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errorsx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *OutOfOfficeRepository) LockStartedPendingReassignment(ctx context.Context) (result []*users.OutOfOffice, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	rows, err := r.getQueries(ctx).LockStartedOutOfOfficePeriodsToReassign(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]*users.OutOfOffice, len(rows))
+	for i, row := range rows {
+		result[i] = outOfOfficeFromRow(generated.GetOutOfOfficePeriodRow(row))
+	}
+
+	return result, nil
+}
+
+func (r *OutOfOfficeRepository) MarkReassigned(ctx context.Context, ids ...int64) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return r.getQueries(ctx).MarkOutOfOfficeReviewsReassigned(ctx, ids)
+}
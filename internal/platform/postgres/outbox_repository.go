@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+)
+
+// OutboxRepository stores webhook deliveries, it must share the transaction with changes producing the events.
+type OutboxRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewOutboxRepository(pool *pgxpool.Pool) *OutboxRepository {
+	return &OutboxRepository{pool: pool}
+}
+
+func (r *OutboxRepository) getQueries(ctx context.Context) *generated.Queries {
+	if tx, ok := ctx.Value(txKey).(pgx.Tx); ok {
+		return generated.New(tx)
+	}
+	return generated.New(r.pool)
+}
+
+// Enqueue creates a delivery for every subscriber of each event's type
+// and notifies event listeners of all replicas, notifications are sent once the transaction commits.
+func (r *OutboxRepository) Enqueue(ctx context.Context, events ...webhooks.Event) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	var params generated.EnqueueWebhookDeliveriesParams
+	for _, e := range events {
+		payload, err := e.Payload()
+		if err != nil {
+			return fmt.Errorf("encoding event: %w", err)
+		}
+
+		params.EventTypes = append(params.EventTypes, string(e.Type))
+		params.Payloads = append(params.Payloads, payload)
+	}
+
+	queries := r.getQueries(ctx)
+	if err := queries.EnqueueWebhookDeliveries(ctx, params); err != nil {
+		return err
+	}
+
+	notifications := generated.NotifyEventsParams{Channel: eventsChannel}
+	for _, payload := range params.Payloads {
+		notifications.Payloads = append(notifications.Payloads, string(payload))
+	}
+
+	return queries.NotifyEvents(ctx, notifications)
+}
+
+func (r *OutboxRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) (result []*webhooks.Delivery, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	rows, err := r.getQueries(ctx).ClaimDueWebhookDeliveries(ctx, generated.ClaimDueWebhookDeliveriesParams{
+		Limit:        int32(limit),
+		LeaseSeconds: lease.Seconds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]*webhooks.Delivery, len(rows))
+	for i, row := range rows {
+		result[i] = &webhooks.Delivery{
+			ID:             row.ID,
+			SubscriptionID: row.SubscriptionID,
+			URL:            row.Url,
+			Secret:         row.Secret,
+			EventType:      webhooks.EventType(row.EventType),
+			Payload:        row.Payload,
+			Status:         webhooks.DeliveryStatus(row.Status),
+			Attempts:       int(row.Attempts),
+			NextAttemptAt:  row.NextAttemptAt,
+			LastError:      row.LastError,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *OutboxRepository) Save(ctx context.Context, d *webhooks.Delivery) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	return r.getQueries(ctx).SaveWebhookDelivery(ctx, generated.SaveWebhookDeliveryParams{
+		ID:            d.ID,
+		Status:        string(d.Status),
+		Attempts:      int32(d.Attempts),
+		NextAttemptAt: d.NextAttemptAt,
+		LastError:     d.LastError,
+	})
+}
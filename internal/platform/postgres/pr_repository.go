@@ -7,6 +7,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
 	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
 	"github.com/lezzercringe/avito-test-assignment/internal/prs"
 	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
@@ -27,6 +28,44 @@ func (r *PRRepository) getQueries(ctx context.Context) *generated.Queries {
 	return generated.New(r.pool)
 }
 
+// reviewersFromRows splits reviewer rows into ordered reviewer ids and their reviews.
+func reviewersFromRows(rows []generated.GetPRReviewersRow) ([]string, map[string]prs.Review) {
+	ids := make([]string, len(rows))
+	reviews := make(map[string]prs.Review, len(rows))
+	for i, row := range rows {
+		ids[i] = row.UserID
+		reviews[row.UserID] = prs.Review{
+			State:      prs.ReviewState(row.State),
+			AssignedAt: row.CreatedAt,
+			UpdatedAt:  row.StateUpdatedAt,
+		}
+	}
+	return ids, reviews
+}
+
+// reviewersParams flattens reviewers of all the given pull requests into insert parameters.
+func reviewersParams(pullRequests ...*prs.PullRequest) generated.SaveManyReviewersParams {
+	var params generated.SaveManyReviewersParams
+	for _, pr := range pullRequests {
+		for _, reviewerID := range pr.ReviewerIDs {
+			review := pr.ReviewOf(reviewerID)
+			if review.UpdatedAt.IsZero() {
+				review.UpdatedAt = time.Now()
+			}
+			if review.AssignedAt.IsZero() {
+				review.AssignedAt = review.UpdatedAt
+			}
+
+			params.Column1 = append(params.Column1, pr.ID)
+			params.Column2 = append(params.Column2, reviewerID)
+			params.Column3 = append(params.Column3, string(review.State))
+			params.Column4 = append(params.Column4, review.UpdatedAt)
+			params.Column5 = append(params.Column5, review.AssignedAt)
+		}
+	}
+	return params
+}
+
 func (r *PRRepository) GetByID(ctx context.Context, id string) (pr *prs.PullRequest, err error) {
 	defer func() {
 		err = mapError(err)
@@ -39,10 +78,11 @@ func (r *PRRepository) GetByID(ctx context.Context, id string) (pr *prs.PullRequ
 		return nil, err
 	}
 
-	reviewerIDs, err := queries.GetPRReviewers(ctx, id)
+	reviewerRows, err := queries.GetPRReviewers(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	reviewerIDs, reviews := reviewersFromRows(reviewerRows)
 
 	var mergedAt time.Time
 	if generatedPR.MergedAt.Valid {
@@ -56,7 +96,12 @@ func (r *PRRepository) GetByID(ctx context.Context, id string) (pr *prs.PullRequ
 		OriginalTeamName: generatedPR.OriginalTeamName,
 		AuthorID:         generatedPR.AuthorID,
 		ReviewerIDs:      reviewerIDs,
+		ReviewersCount:   int(generatedPR.ReviewersCount),
+		Reviews:          reviews,
 		MergedAt:         mergedAt,
+		CreatedAt:        generatedPR.CreatedAt,
+		UpdatedAt:        generatedPR.UpdatedAt,
+		Version:          generatedPR.Version,
 	}
 
 	return pr, nil
@@ -76,10 +121,11 @@ func (r *PRRepository) GetManyByReviewerID(ctx context.Context, reviewerID strin
 
 	result = make([]*prs.PullRequest, len(generatedPRs))
 	for i, pr := range generatedPRs {
-		reviewerIDs, err := queries.GetPRReviewers(ctx, pr.ID)
+		reviewerRows, err := queries.GetPRReviewers(ctx, pr.ID)
 		if err != nil {
 			return nil, err
 		}
+		reviewerIDs, reviews := reviewersFromRows(reviewerRows)
 
 		var mergedAt time.Time
 		if pr.MergedAt.Valid {
@@ -93,7 +139,12 @@ func (r *PRRepository) GetManyByReviewerID(ctx context.Context, reviewerID strin
 			OriginalTeamName: pr.OriginalTeamName,
 			AuthorID:         pr.AuthorID,
 			ReviewerIDs:      reviewerIDs,
+			ReviewersCount:   int(pr.ReviewersCount),
+			Reviews:          reviews,
 			MergedAt:         mergedAt,
+			CreatedAt:        pr.CreatedAt,
+			UpdatedAt:        pr.UpdatedAt,
+			Version:          pr.Version,
 		}
 	}
 
@@ -112,17 +163,39 @@ func (r *PRRepository) Save(ctx context.Context, pr *prs.PullRequest) (err error
 		mergedAt = pgtype.Timestamptz{Time: pr.MergedAt, Valid: true}
 	}
 
-	err = queries.SavePullRequest(ctx, generated.SavePullRequestParams{
+	createdAt := pr.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	updatedAt := pr.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = createdAt
+	}
+
+	saved, err := queries.SavePullRequest(ctx, generated.SavePullRequestParams{
 		ID:               pr.ID,
 		Name:             pr.Name,
 		OriginalTeamName: pr.OriginalTeamName,
 		AuthorID:         pr.AuthorID,
 		Status:           string(pr.Status),
 		MergedAt:         mergedAt,
+		ReviewersCount:   int32(pr.ReviewersCount),
+		CreatedAt:        createdAt,
+		UpdatedAt:        updatedAt,
+		Column10:         pr.Version,
 	})
 	if err != nil {
 		return err
 	}
+	switch {
+	case saved == 1:
+		pr.Version++
+	case pr.Version == 0:
+		// PR which was never saved conflicted with an existing one
+		return errorsx.ErrAlreadyExists
+	default:
+		return errorsx.ErrConcurrentModification
+	}
 
 	err = queries.DeleteAllReviewersForPRs(ctx, []string{pr.ID})
 	if err != nil {
@@ -130,15 +203,7 @@ func (r *PRRepository) Save(ctx context.Context, pr *prs.PullRequest) (err error
 	}
 
 	if len(pr.ReviewerIDs) > 0 {
-		prIDs := make([]string, len(pr.ReviewerIDs))
-		for i := range pr.ReviewerIDs {
-			prIDs[i] = pr.ID
-		}
-
-		err = queries.SaveManyReviewers(ctx, generated.SaveManyReviewersParams{
-			Column1: prIDs,
-			Column2: pr.ReviewerIDs,
-		})
+		err = queries.SaveManyReviewers(ctx, reviewersParams(pr))
 		if err != nil {
 			return err
 		}
@@ -170,6 +235,13 @@ func (r *PRRepository) GetAllUnmergedWithAnyOfReviewers(ctx context.Context, rev
 			mergedAt = row.MergedAt.Time
 		}
 
+		// matched reviewers are only a part of PR's reviewers, the rest must be preserved on save
+		reviewerRows, err := queries.GetPRReviewers(ctx, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		reviewerIDs, reviews := reviewersFromRows(reviewerRows)
+
 		result[i] = &usecases.PRWithMatchedReviewers{
 			PR: &prs.PullRequest{
 				ID:               row.ID,
@@ -177,8 +249,13 @@ func (r *PRRepository) GetAllUnmergedWithAnyOfReviewers(ctx context.Context, rev
 				Status:           prs.Status(row.Status),
 				OriginalTeamName: row.OriginalTeamName,
 				AuthorID:         row.AuthorID,
-				ReviewerIDs:      row.MatchedReviewerIds,
+				ReviewerIDs:      reviewerIDs,
+				ReviewersCount:   int(row.ReviewersCount),
+				Reviews:          reviews,
 				MergedAt:         mergedAt,
+				CreatedAt:        row.CreatedAt,
+				UpdatedAt:        row.UpdatedAt,
+				Version:          row.Version,
 			},
 			MatchedReviewerIDs: row.MatchedReviewerIds,
 		}
@@ -204,6 +281,10 @@ func (r *PRRepository) SaveMany(ctx context.Context, prs ...*prs.PullRequest) (e
 	authorIDs := make([]string, len(prs))
 	statuses := make([]string, len(prs))
 	mergedAts := make([]time.Time, len(prs))
+	reviewersCounts := make([]int32, len(prs))
+	createdAts := make([]time.Time, len(prs))
+	updatedAts := make([]time.Time, len(prs))
+	versions := make([]int64, len(prs))
 
 	for i, pr := range prs {
 		ids[i] = pr.ID
@@ -211,25 +292,41 @@ func (r *PRRepository) SaveMany(ctx context.Context, prs ...*prs.PullRequest) (e
 		originalTeamNames[i] = pr.OriginalTeamName
 		authorIDs[i] = pr.AuthorID
 		statuses[i] = string(pr.Status)
-
-		if !pr.MergedAt.IsZero() {
-			mergedAts[i] = pr.MergedAt
-		} else {
-			mergedAts[i] = time.Time{}
+		reviewersCounts[i] = int32(pr.ReviewersCount)
+		createdAts[i] = pr.CreatedAt
+		if createdAts[i].IsZero() {
+			createdAts[i] = time.Now()
+		}
+		updatedAts[i] = pr.UpdatedAt
+		if updatedAts[i].IsZero() {
+			updatedAts[i] = createdAts[i]
 		}
+		versions[i] = pr.Version
+		// zero time is stored as NULL
+		mergedAts[i] = pr.MergedAt
 	}
 
-	err = queries.SaveManyPullRequests(ctx, generated.SaveManyPullRequestsParams{
-		Column1: ids,
-		Column2: names,
-		Column3: originalTeamNames,
-		Column4: authorIDs,
-		Column5: statuses,
-		Column6: mergedAts,
+	saved, err := queries.SaveManyPullRequests(ctx, generated.SaveManyPullRequestsParams{
+		Column1:  ids,
+		Column2:  names,
+		Column3:  originalTeamNames,
+		Column4:  authorIDs,
+		Column5:  statuses,
+		Column6:  mergedAts,
+		Column7:  reviewersCounts,
+		Column8:  createdAts,
+		Column9:  updatedAts,
+		Column10: versions,
 	})
 	if err != nil {
 		return err
 	}
+	if saved != int64(len(prs)) {
+		return errorsx.ErrConcurrentModification
+	}
+	for _, pr := range prs {
+		pr.Version++
+	}
 
 	prIDsToDelete := make([]string, len(prs))
 	for i, pr := range prs {
@@ -241,27 +338,157 @@ func (r *PRRepository) SaveMany(ctx context.Context, prs ...*prs.PullRequest) (e
 		return err
 	}
 
-	var allPRIDs []string
-	var allReviewerIDs []string
+	reviewers := reviewersParams(prs...)
+	if len(reviewers.Column1) > 0 {
+		err = queries.SaveManyReviewers(ctx, reviewers)
+		if err != nil {
+			return err
+		}
+	}
 
-	for _, pr := range prs {
-		if len(pr.ReviewerIDs) > 0 {
-			for _, reviewerID := range pr.ReviewerIDs {
-				allPRIDs = append(allPRIDs, pr.ID)
-				allReviewerIDs = append(allReviewerIDs, reviewerID)
-			}
+	return nil
+}
+
+func (r *PRRepository) CountOpenReviews(ctx context.Context, reviewerIDs ...string) (result map[string]int, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	if len(reviewerIDs) == 0 {
+		return map[string]int{}, nil
+	}
+
+	rows, err := queries.CountOpenReviewsByUserIDs(ctx, reviewerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result = make(map[string]int, len(rows))
+	for _, row := range rows {
+		result[row.UserID] = int(row.OpenReviews)
+	}
+
+	return result, nil
+}
+
+// MoveUnmergedToTeam changes original team of the team's pull requests which are not merged yet.
+// Merged pull requests keep the team they were reviewed in.
+func (r *PRRepository) MoveUnmergedToTeam(ctx context.Context, fromTeamName, toTeamName string) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	return r.getQueries(ctx).MoveUnmergedPullRequestsToTeam(ctx, generated.MoveUnmergedPullRequestsToTeamParams{
+		FromTeamName: fromTeamName,
+		ToTeamName:   toTeamName,
+	})
+}
+
+func (r *PRRepository) CountUnmergedByTeam(ctx context.Context, teamName string) (count int, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	n, err := r.getQueries(ctx).CountUnmergedPullRequestsByTeam(ctx, teamName)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(n), nil
+}
+
+func (r *PRRepository) GetStaleAssignments(ctx context.Context) (result []usecases.StaleAssignment, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	rows, err := r.getQueries(ctx).GetStaleReviewAssignments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]usecases.StaleAssignment, len(rows))
+	for i, row := range rows {
+		result[i] = usecases.StaleAssignment{
+			PullRequestID: row.PullRequestID,
+			ReviewerID:    row.UserID,
 		}
 	}
 
-	if len(allPRIDs) > 0 {
-		err = queries.SaveManyReviewers(ctx, generated.SaveManyReviewersParams{
-			Column1: allPRIDs,
-			Column2: allReviewerIDs,
+	return result, nil
+}
+
+func (r *PRRepository) List(ctx context.Context, filter usecases.PullRequestFilter, after usecases.PullRequestCursor, limit int) (result []*prs.PullRequest, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	rows, err := queries.ListPullRequests(ctx, generated.ListPullRequestsParams{
+		Status:         optionalText(filter.Status),
+		AuthorID:       optionalText(filter.AuthorID),
+		TeamName:       optionalText(filter.TeamName),
+		ReviewerID:     optionalText(filter.ReviewerID),
+		CreatedFrom:    optionalTimestamptz(filter.CreatedFrom),
+		CreatedTo:      optionalTimestamptz(filter.CreatedTo),
+		MergedFrom:     optionalTimestamptz(filter.MergedFrom),
+		MergedTo:       optionalTimestamptz(filter.MergedTo),
+		AfterCreatedAt: optionalTimestamptz(after.CreatedAt),
+		AfterID:        after.ID,
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	// reviewers of the whole page are retrieved at once
+	reviewerRows, err := queries.GetManyPRReviewers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewerRowsByPR := make(map[string][]generated.GetPRReviewersRow, len(rows))
+	for _, row := range reviewerRows {
+		reviewerRowsByPR[row.PullRequestID] = append(reviewerRowsByPR[row.PullRequestID], generated.GetPRReviewersRow{
+			UserID:         row.UserID,
+			State:          row.State,
+			StateUpdatedAt: row.StateUpdatedAt,
+			CreatedAt:      row.CreatedAt,
 		})
-		if err != nil {
-			return err
+	}
+
+	result = make([]*prs.PullRequest, len(rows))
+	for i, row := range rows {
+		reviewerIDs, reviews := reviewersFromRows(reviewerRowsByPR[row.ID])
+
+		var mergedAt time.Time
+		if row.MergedAt.Valid {
+			mergedAt = row.MergedAt.Time
+		}
+
+		result[i] = &prs.PullRequest{
+			ID:               row.ID,
+			Name:             row.Name,
+			Status:           prs.Status(row.Status),
+			OriginalTeamName: row.OriginalTeamName,
+			AuthorID:         row.AuthorID,
+			ReviewerIDs:      reviewerIDs,
+			ReviewersCount:   int(row.ReviewersCount),
+			Reviews:          reviews,
+			MergedAt:         mergedAt,
+			CreatedAt:        row.CreatedAt,
+			UpdatedAt:        row.UpdatedAt,
+			Version:          row.Version,
 		}
 	}
 
-	return nil
+	return result, nil
 }
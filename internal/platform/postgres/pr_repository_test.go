@@ -0,0 +1,106 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+	"github.com/lezzercringe/avito-test-assignment/migrations"
+)
+
+// setupPool migrates a fresh schema in the database from TEST_POSTGRES_CONN, tests are skipped without it.
+func setupPool(t *testing.T) *pgxpool.Pool {
+	connString := os.Getenv("TEST_POSTGRES_CONN")
+	if connString == "" {
+		t.Skip("TEST_POSTGRES_CONN is not set")
+	}
+
+	ctx := context.Background()
+	schema := fmt.Sprintf("test_%d", time.Now().UnixNano())
+
+	conn, err := pgx.Connect(ctx, connString)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = conn.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+		_ = conn.Close(context.Background())
+	})
+
+	_, err = conn.Exec(ctx, "CREATE SCHEMA "+schema)
+	require.NoError(t, err)
+
+	config, err := pgxpool.ParseConfig(connString)
+	require.NoError(t, err)
+	config.ConnConfig.RuntimeParams["search_path"] = schema
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	// migrations are applied in order of their versions, which is the order of their names
+	entries, err := fs.ReadDir(migrations.FS, ".")
+	require.NoError(t, err)
+	for _, e := range entries {
+		raw, err := fs.ReadFile(migrations.FS, e.Name())
+		require.NoError(t, err)
+
+		up, _, _ := strings.Cut(string(raw), "-- +goose Down")
+		_, err = pool.Exec(ctx, up)
+		require.NoError(t, err, e.Name())
+	}
+
+	return pool
+}
+
+func TestPRRepository_List(t *testing.T) {
+	pool := setupPool(t)
+	ctx := context.Background()
+
+	userRepo := postgres.NewUserRepository(pool)
+	teamRepo := postgres.NewTeamRepository(pool)
+	prRepo := postgres.NewPRRepository(pool)
+
+	require.NoError(t, userRepo.SaveMany(ctx,
+		&users.User{ID: "u1", Name: "Alice", Active: true},
+		&users.User{ID: "u2", Name: "Bob", Active: true},
+		&users.User{ID: "u3", Name: "Carol", Active: true},
+	))
+	require.NoError(t, teamRepo.Create(ctx, &teams.Team{Name: "backend", MemberIDs: []string{"u1", "u2", "u3"}}))
+
+	t.Run("merged_to skips open PRs saved by reassignment", func(t *testing.T) {
+		open := &prs.PullRequest{
+			ID: "pr-1", Name: "Add search", Status: prs.StatusOpen, OriginalTeamName: "backend",
+			AuthorID: "u1", ReviewerIDs: []string{"u2"}, ReviewersCount: 1,
+		}
+		require.NoError(t, prRepo.Save(ctx, open))
+
+		// reassignments save pull requests in bulk
+		open.ReviewerIDs = []string{"u3"}
+		require.NoError(t, prRepo.SaveMany(ctx, open))
+
+		merged := &prs.PullRequest{
+			ID: "pr-2", Name: "Fix search", Status: prs.StatusMerged, OriginalTeamName: "backend",
+			AuthorID: "u1", ReviewersCount: 1, MergedAt: time.Now().Add(-time.Hour),
+		}
+		require.NoError(t, prRepo.Save(ctx, merged))
+
+		result, err := prRepo.List(ctx, usecases.PullRequestFilter{MergedTo: time.Now()}, usecases.PullRequestCursor{}, 10)
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "pr-2", result[0].ID)
+	})
+}
@@ -4,33 +4,127 @@
 SELECT name FROM teams
 WHERE name = $1;
 
--- name: GetTeamByMemberID :one
-SELECT name FROM teams t
-JOIN memberships m ON m.team_name = t.name 
-WHERE m.user_id = $1;
+-- name: GetTeamNamesByMemberID :many
+SELECT team_name FROM memberships
+WHERE user_id = $1
+ORDER BY team_name;
+
+-- name: GetPrimaryTeamNameByMemberID :one
+SELECT team_name FROM memberships
+WHERE user_id = $1 AND is_primary;
+
+-- name: ClearPrimaryTeam :exec
+UPDATE memberships SET is_primary = false
+WHERE user_id = $1 AND is_primary;
+
+-- name: SetPrimaryTeam :execrows
+UPDATE memberships SET is_primary = true
+WHERE user_id = $1 AND team_name = $2;
 
 -- name: SaveTeam :exec
 INSERT INTO teams (name) VALUES ($1)
 ON CONFLICT (name)
 DO UPDATE SET name = EXCLUDED.name;
 
+-- name: CreateTeam :exec
+INSERT INTO teams (name) VALUES ($1);
+
 -- name: SaveMembership :exec
-INSERT INTO memberships (team_name, user_id) VALUES ($1, $2);
+INSERT INTO memberships (team_name, user_id) VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteMembershipsExcept :exec
+DELETE FROM memberships
+WHERE team_name = $1 AND NOT (user_id = ANY(sqlc.arg('keep_user_ids')::varchar[]));
+
+-- name: RenameTeam :execrows
+UPDATE teams SET name = sqlc.arg('new_name')
+WHERE name = sqlc.arg('name');
+
+-- name: DeleteTeam :execrows
+DELETE FROM teams WHERE name = $1;
+
+-- name: ListTeams :many
+SELECT t.name, COALESCE(
+    ARRAY_AGG(m.user_id ORDER BY m.user_id) FILTER (WHERE m.user_id IS NOT NULL), '{}'
+)::varchar[] AS member_ids
+FROM teams t
+LEFT JOIN memberships m ON m.team_name = t.name
+WHERE t.name > sqlc.arg('after_name')::varchar
+  AND (sqlc.narg('member_id')::varchar IS NULL OR EXISTS (
+    SELECT 1 FROM memberships f WHERE f.team_name = t.name AND f.user_id = sqlc.narg('member_id')::varchar
+  ))
+GROUP BY t.name
+ORDER BY t.name
+LIMIT sqlc.arg('limit');
+
+-- name: GetAllMemberIDs :many
+SELECT DISTINCT user_id FROM memberships
+ORDER BY user_id;
+
+-- name: GetTeamSettings :one
+SELECT team_name, strategy, reviewers_count, min_reviewers_count, max_reviewers_count, required_approvals, allow_other_teams, fallback_team_names, review_sla_seconds FROM team_settings
+WHERE team_name = $1;
+
+-- name: SaveTeamSettings :exec
+INSERT INTO team_settings (team_name, strategy, reviewers_count, min_reviewers_count, max_reviewers_count, required_approvals, allow_other_teams, fallback_team_names, review_sla_seconds)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (team_name) DO UPDATE SET
+    strategy = EXCLUDED.strategy,
+    reviewers_count = EXCLUDED.reviewers_count,
+    min_reviewers_count = EXCLUDED.min_reviewers_count,
+    max_reviewers_count = EXCLUDED.max_reviewers_count,
+    required_approvals = EXCLUDED.required_approvals,
+    allow_other_teams = EXCLUDED.allow_other_teams,
+    fallback_team_names = EXCLUDED.fallback_team_names,
+    review_sla_seconds = EXCLUDED.review_sla_seconds;
+
+-- name: RenameFallbackTeam :exec
+UPDATE team_settings SET fallback_team_names = array_replace(fallback_team_names, sqlc.arg('name')::varchar, sqlc.arg('new_name')::varchar)
+WHERE sqlc.arg('name')::varchar = ANY(fallback_team_names);
+
+-- name: RemoveFallbackTeam :exec
+UPDATE team_settings SET fallback_team_names = array_remove(fallback_team_names, sqlc.arg('name')::varchar)
+WHERE sqlc.arg('name')::varchar = ANY(fallback_team_names);
 
 -- USERS
 
 -- name: GetUserByID :one
-SELECT id, name, active FROM users
-WHERE id = $1;
+SELECT u.id, u.name, u.active, EXISTS (
+    SELECT 1 FROM out_of_office_periods p
+    WHERE p.user_id = u.id AND now() >= p.starts_at AND now() < p.ends_at
+) AS out_of_office
+FROM users u
+WHERE u.id = $1;
 
 -- name: GetManyUsersByIDs :many
-SELECT id, name, active FROM users
-WHERE id = ANY($1::varchar[]);
+SELECT u.id, u.name, u.active, EXISTS (
+    SELECT 1 FROM out_of_office_periods p
+    WHERE p.user_id = u.id AND now() >= p.starts_at AND now() < p.ends_at
+) AS out_of_office
+FROM users u
+WHERE u.id = ANY($1::varchar[]);
+
+-- name: ListUsers :many
+SELECT u.id, u.name, u.active, EXISTS (
+    SELECT 1 FROM out_of_office_periods p
+    WHERE p.user_id = u.id AND now() >= p.starts_at AND now() < p.ends_at
+) AS out_of_office
+FROM users u
+WHERE u.id > sqlc.arg('after_id')::varchar
+  AND (sqlc.narg('active')::boolean IS NULL OR u.active = sqlc.narg('active')::boolean)
+  AND (sqlc.narg('team_name')::varchar IS NULL OR EXISTS (
+    SELECT 1 FROM memberships m WHERE m.user_id = u.id AND m.team_name = sqlc.narg('team_name')::varchar
+  ))
+ORDER BY u.id
+LIMIT sqlc.arg('limit');
 
 -- name: SaveUser :exec
 INSERT INTO users (id, name, active) VALUES ($1, $2, $3)
-ON CONFLICT (id) 
-DO UPDATE SET name = excluded.name, active = excluded.active;
+ON CONFLICT (id)
+DO UPDATE SET name = excluded.name, active = excluded.active,
+    updated_at = CASE WHEN (users.name, users.active) IS DISTINCT FROM (excluded.name, excluded.active)
+        THEN now() ELSE users.updated_at END;
 
 -- name: SaveManyUsers :exec
 INSERT INTO users (id, name, active)
@@ -38,35 +132,100 @@ SELECT UNNEST($1::varchar[]), UNNEST($2::varchar[]), UNNEST($3::boolean[])
 ON CONFLICT (id)
 DO UPDATE SET
     name = EXCLUDED.name,
-    active = EXCLUDED.active;
+    active = EXCLUDED.active,
+    -- members are saved along with their teams, so only actual changes count as updates
+    updated_at = CASE WHEN (users.name, users.active) IS DISTINCT FROM (EXCLUDED.name, EXCLUDED.active)
+        THEN now() ELSE users.updated_at END;
+
+-- OUT OF OFFICE
+
+-- name: CreateOutOfOfficePeriod :one
+INSERT INTO out_of_office_periods (user_id, starts_at, ends_at, reason, reassign_reviews)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id;
 
+-- name: GetOutOfOfficePeriod :one
+SELECT id, user_id, starts_at, ends_at, reason, reassign_reviews
+FROM out_of_office_periods
+WHERE id = $1;
+
+-- name: GetOutOfOfficePeriodsByUserID :many
+SELECT id, user_id, starts_at, ends_at, reason, reassign_reviews
+FROM out_of_office_periods
+WHERE user_id = $1 AND ends_at > now()
+ORDER BY starts_at, id;
+
+-- name: DeleteOutOfOfficePeriod :execrows
+DELETE FROM out_of_office_periods
+WHERE id = $1;
+
+-- name: LockStartedOutOfOfficePeriodsToReassign :many
+SELECT id, user_id, starts_at, ends_at, reason, reassign_reviews
+FROM out_of_office_periods
+WHERE reassign_reviews AND NOT reviews_reassigned
+  AND starts_at <= now() AND ends_at > now()
+ORDER BY starts_at, id
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutOfOfficeReviewsReassigned :exec
+UPDATE out_of_office_periods
+SET reviews_reassigned = true
+WHERE id = ANY($1::bigint[]);
 
 -- PRs
 
 -- name: GetPullRequestByID :one
-SELECT id, name, original_team_name, author_id, status, merged_at FROM pull_requests
+SELECT id, name, original_team_name, author_id, status, merged_at, reviewers_count, created_at, updated_at, version FROM pull_requests
 WHERE id = $1;
 
 -- name: GetManyPullRequestsByReviewerID :many
-SELECT id, name, original_team_name, author_id, status, merged_at FROM pull_requests pr
+SELECT pr.id, pr.name, pr.original_team_name, pr.author_id, pr.status, pr.merged_at, pr.reviewers_count, pr.created_at, pr.updated_at, pr.version FROM pull_requests pr
 JOIN reviewers r ON pr.id = r.pull_request_id
 WHERE r.user_id = $1;
 
--- name: SavePullRequest :exec
-INSERT INTO pull_requests(id, name, original_team_name, author_id, status, merged_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+-- name: SavePullRequest :execrows
+-- Existing pull request is updated only if it is still of the version it was read at ($10).
+INSERT INTO pull_requests(id, name, original_team_name, author_id, status, merged_at, reviewers_count, created_at, updated_at, version)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10::bigint + 1)
 ON CONFLICT (id) DO UPDATE SET
     name = EXCLUDED.name,
     original_team_name = EXCLUDED.original_team_name,
     author_id = EXCLUDED.author_id,
     status = EXCLUDED.status,
-    merged_at = EXCLUDED.merged_at;
+    merged_at = EXCLUDED.merged_at,
+    reviewers_count = EXCLUDED.reviewers_count,
+    updated_at = EXCLUDED.updated_at,
+    version = EXCLUDED.version
+WHERE pull_requests.version = $10::bigint;
+
+-- name: ListPullRequests :many
+SELECT id, name, original_team_name, author_id, status, merged_at, reviewers_count, created_at, updated_at, version FROM pull_requests pr
+WHERE (sqlc.narg('status')::varchar IS NULL OR pr.status = sqlc.narg('status')::varchar)
+  AND (sqlc.narg('author_id')::varchar IS NULL OR pr.author_id = sqlc.narg('author_id')::varchar)
+  AND (sqlc.narg('team_name')::varchar IS NULL OR pr.original_team_name = sqlc.narg('team_name')::varchar)
+  AND (sqlc.narg('reviewer_id')::varchar IS NULL OR EXISTS (
+    SELECT 1 FROM reviewers r WHERE r.pull_request_id = pr.id AND r.user_id = sqlc.narg('reviewer_id')::varchar
+  ))
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR pr.created_at >= sqlc.narg('created_from')::timestamptz)
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR pr.created_at < sqlc.narg('created_to')::timestamptz)
+  AND (sqlc.narg('merged_from')::timestamptz IS NULL
+    OR (pr.status = 'MERGED' AND pr.merged_at IS NOT NULL AND pr.merged_at >= sqlc.narg('merged_from')::timestamptz))
+  AND (sqlc.narg('merged_to')::timestamptz IS NULL
+    OR (pr.status = 'MERGED' AND pr.merged_at IS NOT NULL AND pr.merged_at < sqlc.narg('merged_to')::timestamptz))
+  AND (sqlc.narg('after_created_at')::timestamptz IS NULL
+    OR (pr.created_at, pr.id) < (sqlc.narg('after_created_at')::timestamptz, sqlc.arg('after_id')::varchar))
+ORDER BY pr.created_at DESC, pr.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: GetManyPRReviewers :many
+SELECT pull_request_id, user_id, state, state_updated_at, created_at FROM reviewers
+WHERE pull_request_id = ANY($1::varchar[]);
 
 -- name: GetTeamMembers :many
 SELECT user_id FROM memberships WHERE team_name = $1;
 
--- name: GetPRReviewers :many  
-SELECT user_id FROM reviewers WHERE pull_request_id = $1;
+-- name: GetPRReviewers :many
+SELECT user_id, state, state_updated_at, created_at FROM reviewers WHERE pull_request_id = $1;
 
 -- name: SaveReviewers :exec
 INSERT INTO reviewers (pull_request_id, user_id) 
@@ -88,29 +247,256 @@ SELECT
     pr.author_id,
     pr.status,
     pr.merged_at,
+    pr.reviewers_count,
+    pr.created_at,
+    pr.updated_at,
+    pr.version,
     ARRAY_AGG(r.user_id)::varchar[] AS matched_reviewer_ids
 FROM pull_requests pr
 JOIN reviewers r
   ON pr.id = r.pull_request_id
 WHERE r.user_id = ANY($1::varchar[])
   AND pr.status = 'OPEN'
-GROUP BY pr.id, pr.name, pr.original_team_name, pr.author_id, pr.status, pr.merged_at
+GROUP BY pr.id, pr.name, pr.original_team_name, pr.author_id, pr.status, pr.merged_at, pr.reviewers_count, pr.created_at, pr.updated_at, pr.version
 ORDER BY pr.id;
 
--- name: SaveManyPullRequests :exec
-INSERT INTO pull_requests(id, name, original_team_name, author_id, status, merged_at)
-SELECT UNNEST($1::varchar[]), UNNEST($2::varchar[]), UNNEST($3::varchar[]), UNNEST($4::varchar[]), UNNEST($5::varchar[]), UNNEST($6::timestamptz[])
+-- name: SaveManyPullRequests :execrows
+-- $10 are versions the pull requests were read at, outdated ones are left intact.
+-- Zero time in $6 stands for pull requests which are not merged.
+INSERT INTO pull_requests(id, name, original_team_name, author_id, status, merged_at, reviewers_count, created_at, updated_at, version)
+SELECT UNNEST($1::varchar[]), UNNEST($2::varchar[]), UNNEST($3::varchar[]), UNNEST($4::varchar[]), UNNEST($5::varchar[]), NULLIF(UNNEST($6::timestamptz[]), '0001-01-01 00:00:00+00'::timestamptz), UNNEST($7::int[]), UNNEST($8::timestamptz[]), UNNEST($9::timestamptz[]), UNNEST($10::bigint[]) + 1
 ON CONFLICT (id) DO UPDATE SET
     name = EXCLUDED.name,
     original_team_name = EXCLUDED.original_team_name,
     author_id = EXCLUDED.author_id,
     status = EXCLUDED.status,
-    merged_at = EXCLUDED.merged_at;
+    merged_at = EXCLUDED.merged_at,
+    reviewers_count = EXCLUDED.reviewers_count,
+    updated_at = EXCLUDED.updated_at,
+    version = EXCLUDED.version
+WHERE pull_requests.version = EXCLUDED.version - 1;
 
 -- name: DeleteAllReviewersForPRs :exec
 DELETE FROM reviewers WHERE pull_request_id = ANY($1::varchar[]);
 
 -- name: SaveManyReviewers :exec
-INSERT INTO reviewers (pull_request_id, user_id)
-SELECT UNNEST($1::varchar[]), UNNEST($2::varchar[])
-ON CONFLICT (user_id, pull_request_id) DO NOTHING;
+INSERT INTO reviewers (pull_request_id, user_id, state, state_updated_at, created_at)
+SELECT UNNEST($1::varchar[]), UNNEST($2::varchar[]), UNNEST($3::varchar[]), UNNEST($4::timestamptz[]), UNNEST($5::timestamptz[])
+ON CONFLICT (user_id, pull_request_id) DO UPDATE SET
+    state = EXCLUDED.state,
+    state_updated_at = EXCLUDED.state_updated_at;
+
+-- name: CountOpenReviewsByUserIDs :many
+SELECT r.user_id, COUNT(*) AS open_reviews
+FROM reviewers r
+JOIN pull_requests pr ON pr.id = r.pull_request_id
+WHERE r.user_id = ANY($1::varchar[])
+  AND pr.status = 'OPEN'
+GROUP BY r.user_id;
+
+-- name: MoveUnmergedPullRequestsToTeam :exec
+UPDATE pull_requests SET original_team_name = sqlc.arg('to_team_name'), updated_at = now(), version = version + 1
+WHERE original_team_name = sqlc.arg('from_team_name')
+  AND status <> 'MERGED';
+
+-- name: CountUnmergedPullRequestsByTeam :one
+SELECT COUNT(*) FROM pull_requests
+WHERE original_team_name = $1
+  AND status <> 'MERGED';
+
+-- name: GetStaleReviewAssignments :many
+SELECT r.pull_request_id, r.user_id
+FROM reviewers r
+JOIN pull_requests pr ON pr.id = r.pull_request_id
+JOIN team_settings ts ON ts.team_name = pr.original_team_name
+WHERE pr.status = 'OPEN' AND r.state = 'PENDING' AND ts.review_sla_seconds > 0
+  AND r.state_updated_at < now() - make_interval(secs => ts.review_sla_seconds)
+ORDER BY r.state_updated_at, r.pull_request_id, r.user_id;
+
+-- REVIEW ROTATION
+
+-- name: EnsureRotationCursor :exec
+INSERT INTO review_rotation_cursors (team_name, last_user_id) VALUES ($1, '')
+ON CONFLICT (team_name) DO NOTHING;
+
+-- name: LockRotationCursor :one
+SELECT last_user_id FROM review_rotation_cursors
+WHERE team_name = $1
+FOR UPDATE;
+
+-- name: SaveRotationCursor :exec
+UPDATE review_rotation_cursors SET last_user_id = $2
+WHERE team_name = $1;
+
+-- REVIEW ASSIGNMENTS HISTORY
+
+-- name: AppendAssignmentEvents :exec
+INSERT INTO review_assignments_history (pull_request_id, user_id, action, reason, actor, created_at)
+SELECT UNNEST($1::varchar[]), UNNEST($2::varchar[]), UNNEST($3::varchar[]), UNNEST($4::varchar[]), UNNEST($5::varchar[]), UNNEST($6::timestamptz[]);
+
+-- name: GetAssignmentHistory :many
+SELECT pull_request_id, user_id, action, reason, actor, created_at FROM review_assignments_history
+WHERE pull_request_id = $1
+ORDER BY created_at, id;
+
+-- STATS
+
+-- name: GetReviewerStats :many
+SELECT
+    h.user_id,
+    u.name AS username,
+    COUNT(*) FILTER (WHERE h.action = 'ASSIGNED')::int AS assigned,
+    COUNT(DISTINCT h.pull_request_id) FILTER (
+        WHERE h.action = 'ASSIGNED' AND pr.status = 'OPEN' AND r.user_id IS NOT NULL
+    )::int AS open,
+    COUNT(*) FILTER (WHERE h.action = 'UNASSIGNED' AND h.reason = 'MERGE')::int AS merged,
+    COUNT(*) FILTER (
+        WHERE h.action = 'UNASSIGNED' AND h.reason IN ('MANUAL_REASSIGN', 'DEACTIVATION', 'TEAM_CHANGE', 'OUT_OF_OFFICE')
+    )::int AS reassigned_away
+FROM review_assignments_history h
+JOIN users u ON u.id = h.user_id
+JOIN pull_requests pr ON pr.id = h.pull_request_id
+LEFT JOIN reviewers r ON r.pull_request_id = h.pull_request_id AND r.user_id = h.user_id
+WHERE (sqlc.narg('from')::timestamptz IS NULL OR h.created_at >= sqlc.narg('from')::timestamptz)
+  AND (sqlc.narg('to')::timestamptz IS NULL OR h.created_at < sqlc.narg('to')::timestamptz)
+GROUP BY h.user_id, u.name
+ORDER BY h.user_id;
+
+-- name: GetTeamStats :many
+SELECT
+    pr.original_team_name AS team_name,
+    COUNT(DISTINCT h.pull_request_id)::int AS pull_requests,
+    COUNT(*) FILTER (WHERE h.action = 'ASSIGNED')::int AS assigned,
+    COUNT(DISTINCT (h.pull_request_id, h.user_id)) FILTER (
+        WHERE h.action = 'ASSIGNED' AND pr.status = 'OPEN' AND r.user_id IS NOT NULL
+    )::int AS open,
+    COUNT(*) FILTER (WHERE h.action = 'UNASSIGNED' AND h.reason = 'MERGE')::int AS merged,
+    COUNT(*) FILTER (
+        WHERE h.action = 'UNASSIGNED' AND h.reason IN ('MANUAL_REASSIGN', 'DEACTIVATION', 'TEAM_CHANGE', 'OUT_OF_OFFICE')
+    )::int AS reassigned_away
+FROM review_assignments_history h
+JOIN pull_requests pr ON pr.id = h.pull_request_id
+LEFT JOIN reviewers r ON r.pull_request_id = h.pull_request_id AND r.user_id = h.user_id
+WHERE (sqlc.narg('from')::timestamptz IS NULL OR h.created_at >= sqlc.narg('from')::timestamptz)
+  AND (sqlc.narg('to')::timestamptz IS NULL OR h.created_at < sqlc.narg('to')::timestamptz)
+GROUP BY pr.original_team_name
+ORDER BY pr.original_team_name;
+
+-- name: GetTeamLeadTimes :many
+SELECT
+    pr.original_team_name AS team_name,
+    COUNT(*)::int AS merged,
+    (percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)))::float8 AS p50_seconds,
+    (percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)))::float8 AS p90_seconds
+FROM pull_requests pr
+WHERE pr.status = 'MERGED'
+  AND (sqlc.narg('from')::timestamptz IS NULL OR pr.merged_at >= sqlc.narg('from')::timestamptz)
+  AND (sqlc.narg('to')::timestamptz IS NULL OR pr.merged_at < sqlc.narg('to')::timestamptz)
+GROUP BY pr.original_team_name
+ORDER BY pr.original_team_name;
+
+-- name: GetReviewerLeadTimes :many
+SELECT
+    r.user_id,
+    u.name AS username,
+    COUNT(*)::int AS merged,
+    (percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)))::float8 AS p50_seconds,
+    (percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)))::float8 AS p90_seconds
+FROM reviewers r
+JOIN pull_requests pr ON pr.id = r.pull_request_id
+JOIN users u ON u.id = r.user_id
+WHERE pr.status = 'MERGED'
+  AND (sqlc.narg('from')::timestamptz IS NULL OR pr.merged_at >= sqlc.narg('from')::timestamptz)
+  AND (sqlc.narg('to')::timestamptz IS NULL OR pr.merged_at < sqlc.narg('to')::timestamptz)
+GROUP BY r.user_id, u.name
+ORDER BY r.user_id;
+
+-- name: GetAPITokenByHash :one
+SELECT name, role, team_name
+FROM api_tokens
+WHERE token_hash = $1;
+
+-- name: CreateAPIToken :exec
+INSERT INTO api_tokens (name, token_hash, role, team_name)
+VALUES ($1, $2, $3, $4);
+
+-- name: DeleteAPIToken :execrows
+DELETE FROM api_tokens
+WHERE name = $1;
+
+-- WEBHOOKS
+
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (url, secret, event_types)
+VALUES ($1, $2, $3)
+RETURNING id;
+
+-- name: GetWebhookSubscriptions :many
+SELECT id, url, secret, event_types
+FROM webhook_subscriptions
+ORDER BY id;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: EnqueueWebhookDeliveries :exec
+INSERT INTO webhook_deliveries (subscription_id, event_type, payload)
+SELECT s.id, e.event_type, e.payload
+FROM (
+    SELECT UNNEST(sqlc.arg('event_types')::varchar[]) AS event_type, UNNEST(sqlc.arg('payloads')::jsonb[]) AS payload
+) e
+JOIN webhook_subscriptions s ON e.event_type = ANY(s.event_types);
+
+-- name: NotifyEvents :exec
+SELECT pg_notify(sqlc.arg('channel')::text, payload)
+FROM UNNEST(sqlc.arg('payloads')::text[]) AS payload;
+
+-- name: ClaimDueWebhookDeliveries :many
+WITH due AS (
+    SELECT id
+    FROM webhook_deliveries
+    WHERE status = 'PENDING' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at, id
+    LIMIT sqlc.arg('limit')
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE webhook_deliveries d
+SET next_attempt_at = now() + make_interval(secs => sqlc.arg('lease_seconds')::float8)
+FROM due, webhook_subscriptions s
+WHERE d.id = due.id AND s.id = d.subscription_id
+RETURNING d.id, d.subscription_id, s.url, s.secret, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at, d.last_error;
+
+-- name: SaveWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5
+WHERE id = $1;
+
+-- INTEGRATIONS
+
+-- name: SaveGitAccount :exec
+INSERT INTO git_accounts (host, login, user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (host, login) DO UPDATE SET user_id = EXCLUDED.user_id;
+
+-- name: GetGitAccount :one
+SELECT host, login, user_id
+FROM git_accounts
+WHERE host = $1 AND login = $2;
+
+-- name: DeleteGitAccount :execrows
+DELETE FROM git_accounts
+WHERE host = $1 AND login = $2;
+
+-- name: ClaimIntegrationDelivery :execrows
+INSERT INTO integration_deliveries (host, delivery_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- LOCKS
+
+-- name: TryAdvisoryLock :one
+SELECT pg_try_advisory_lock(hashtext(sqlc.arg('name')::text))::boolean AS acquired;
+
+-- name: AdvisoryUnlock :exec
+SELECT pg_advisory_unlock(hashtext(sqlc.arg('name')::text));
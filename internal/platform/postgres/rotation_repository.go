@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
+)
+
+type RotationRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRotationRepository(pool *pgxpool.Pool) *RotationRepository {
+	return &RotationRepository{pool: pool}
+}
+
+func (r *RotationRepository) getQueries(ctx context.Context) *generated.Queries {
+	if tx, ok := ctx.Value(txKey).(pgx.Tx); ok {
+		return generated.New(tx)
+	}
+	return generated.New(r.pool)
+}
+
+// LockCursor creates team's cursor if it does not exist and locks it until the end of the transaction.
+func (r *RotationRepository) LockCursor(ctx context.Context, teamName string) (lastUserID string, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	if err := queries.EnsureRotationCursor(ctx, teamName); err != nil {
+		return "", err
+	}
+
+	return queries.LockRotationCursor(ctx, teamName)
+}
+
+func (r *RotationRepository) SaveCursor(ctx context.Context, teamName, lastUserID string) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	return queries.SaveRotationCursor(ctx, generated.SaveRotationCursorParams{
+		TeamName:   teamName,
+		LastUserID: lastUserID,
+	})
+}
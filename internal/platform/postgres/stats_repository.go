@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+)
+
+type StatsRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewStatsRepository(pool *pgxpool.Pool) *StatsRepository {
+	return &StatsRepository{pool: pool}
+}
+
+func (r *StatsRepository) getQueries(ctx context.Context) *generated.Queries {
+	if tx, ok := ctx.Value(txKey).(pgx.Tx); ok {
+		return generated.New(tx)
+	}
+	return generated.New(r.pool)
+}
+
+// optionalTimestamptz converts zero time into NULL.
+func optionalTimestamptz(t time.Time) pgtype.Timestamptz {
+	if t.IsZero() {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: t, Valid: true}
+}
+
+// optionalText converts empty string into NULL.
+func optionalText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
+
+func (r *StatsRepository) GetReviewerStats(ctx context.Context, period usecases.StatsPeriod) (result []usecases.ReviewerStats, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	rows, err := queries.GetReviewerStats(ctx, generated.GetReviewerStatsParams{
+		From: optionalTimestamptz(period.From),
+		To:   optionalTimestamptz(period.To),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]usecases.ReviewerStats, len(rows))
+	for i, row := range rows {
+		result[i] = usecases.ReviewerStats{
+			UserID:         row.UserID,
+			Username:       row.Username,
+			Assigned:       int(row.Assigned),
+			Open:           int(row.Open),
+			Merged:         int(row.Merged),
+			ReassignedAway: int(row.ReassignedAway),
+		}
+	}
+
+	return result, nil
+}
+
+func (r *StatsRepository) GetTeamStats(ctx context.Context, period usecases.StatsPeriod) (result []usecases.TeamStats, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	rows, err := queries.GetTeamStats(ctx, generated.GetTeamStatsParams{
+		From: optionalTimestamptz(period.From),
+		To:   optionalTimestamptz(period.To),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]usecases.TeamStats, len(rows))
+	for i, row := range rows {
+		result[i] = usecases.TeamStats{
+			TeamName:       row.TeamName,
+			PullRequests:   int(row.PullRequests),
+			Assigned:       int(row.Assigned),
+			Open:           int(row.Open),
+			Merged:         int(row.Merged),
+			ReassignedAway: int(row.ReassignedAway),
+		}
+	}
+
+	return result, nil
+}
+
+// seconds converts seconds returned by aggregation into a duration.
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+func (r *StatsRepository) GetTeamLeadTimes(ctx context.Context, period usecases.StatsPeriod) (result []usecases.TeamLeadTime, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	rows, err := queries.GetTeamLeadTimes(ctx, generated.GetTeamLeadTimesParams{
+		From: optionalTimestamptz(period.From),
+		To:   optionalTimestamptz(period.To),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]usecases.TeamLeadTime, len(rows))
+	for i, row := range rows {
+		result[i] = usecases.TeamLeadTime{
+			TeamName: row.TeamName,
+			LeadTime: usecases.LeadTime{
+				Merged: int(row.Merged),
+				P50:    seconds(row.P50Seconds),
+				P90:    seconds(row.P90Seconds),
+			},
+		}
+	}
+
+	return result, nil
+}
+
+func (r *StatsRepository) GetReviewerLeadTimes(ctx context.Context, period usecases.StatsPeriod) (result []usecases.ReviewerLeadTime, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	rows, err := queries.GetReviewerLeadTimes(ctx, generated.GetReviewerLeadTimesParams{
+		From: optionalTimestamptz(period.From),
+		To:   optionalTimestamptz(period.To),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]usecases.ReviewerLeadTime, len(rows))
+	for i, row := range rows {
+		result[i] = usecases.ReviewerLeadTime{
+			UserID:   row.UserID,
+			Username: row.Username,
+			LeadTime: usecases.LeadTime{
+				Merged: int(row.Merged),
+				P50:    seconds(row.P50Seconds),
+				P90:    seconds(row.P90Seconds),
+			},
+		}
+	}
+
+	return result, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
 	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
 	"github.com/lezzercringe/avito-test-assignment/internal/teams"
 )
@@ -52,34 +53,92 @@ func (r *TeamRepository) GetByName(ctx context.Context, name string) (team *team
 	return team, nil
 }
 
-func (r *TeamRepository) GetByMemberID(ctx context.Context, memberID string) (team *teams.Team, err error) {
+// GetManyByMemberID returns all teams of the user ordered by name.
+func (r *TeamRepository) GetManyByMemberID(ctx context.Context, memberID string) (result []*teams.Team, err error) {
 	defer func() {
 		err = mapError(err)
 	}()
 
 	queries := r.getQueries(ctx)
 
-	teamName, err := queries.GetTeamByMemberID(ctx, memberID)
+	teamNames, err := queries.GetTeamNamesByMemberID(ctx, memberID)
 	if err != nil {
 		return nil, err
 	}
 
-	memberIDs, err := queries.GetTeamMembers(ctx, teamName)
+	result = make([]*teams.Team, 0, len(teamNames))
+	for _, teamName := range teamNames {
+		memberIDs, err := queries.GetTeamMembers(ctx, teamName)
+		if err != nil {
+			return nil, err
+		}
+
+		memberIDsStr := make([]string, len(memberIDs))
+		copy(memberIDsStr, memberIDs)
+
+		result = append(result, &teams.Team{
+			Name:      teamName,
+			MemberIDs: memberIDsStr,
+		})
+	}
+
+	return result, nil
+}
+
+// GetPrimaryNameByMemberID returns name of the user's primary team, ErrNotFound is returned if it is not set.
+func (r *TeamRepository) GetPrimaryNameByMemberID(ctx context.Context, memberID string) (name string, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	return r.getQueries(ctx).GetPrimaryTeamNameByMemberID(ctx, memberID)
+}
+
+// SetPrimary makes the team primary for the user, ErrNotFound is returned if the user is not a member of the team.
+//
+// WARN: method must be called within a transaction, otherwise the user may be left without primary team.
+func (r *TeamRepository) SetPrimary(ctx context.Context, memberID, teamName string) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	// previous primary team is cleared first, since the unique index is checked after every updated row
+	if err := queries.ClearPrimaryTeam(ctx, memberID); err != nil {
+		return err
+	}
+
+	affected, err := queries.SetPrimaryTeam(ctx, generated.SetPrimaryTeamParams{
+		UserID:   memberID,
+		TeamName: teamName,
+	})
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if affected == 0 {
+		return errorsx.ErrNotFound
 	}
 
-	memberIDsStr := make([]string, len(memberIDs))
-	copy(memberIDsStr, memberIDs)
+	return nil
+}
 
-	team = &teams.Team{
-		Name:      teamName,
-		MemberIDs: memberIDsStr,
+// Create inserts new team, ErrAlreadyExists is returned if the team exists.
+func (r *TeamRepository) Create(ctx context.Context, t *teams.Team) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	if err := queries.CreateTeam(ctx, t.Name); err != nil {
+		return err
 	}
 
-	return team, nil
+	return saveMemberships(ctx, queries, t)
 }
 
+// Save upserts the team, members which are not in the team anymore are removed.
 func (r *TeamRepository) Save(ctx context.Context, t *teams.Team) (err error) {
 	defer func() {
 		err = mapError(err)
@@ -92,8 +151,20 @@ func (r *TeamRepository) Save(ctx context.Context, t *teams.Team) (err error) {
 		return err
 	}
 
+	err = queries.DeleteMembershipsExcept(ctx, generated.DeleteMembershipsExceptParams{
+		TeamName:    t.Name,
+		KeepUserIds: t.MemberIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	return saveMemberships(ctx, queries, t)
+}
+
+func saveMemberships(ctx context.Context, queries *generated.Queries, t *teams.Team) error {
 	for _, memberID := range t.MemberIDs {
-		err = queries.SaveMembership(ctx, generated.SaveMembershipParams{
+		err := queries.SaveMembership(ctx, generated.SaveMembershipParams{
 			TeamName: t.Name,
 			UserID:   memberID,
 		})
@@ -105,6 +176,49 @@ func (r *TeamRepository) Save(ctx context.Context, t *teams.Team) (err error) {
 	return nil
 }
 
+// Rename changes team's name, memberships, settings, rotation cursor and api tokens follow it via cascading foreign keys.
+// Fallback chains of other teams are updated explicitly.
+func (r *TeamRepository) Rename(ctx context.Context, name, newName string) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	affected, err := queries.RenameTeam(ctx, generated.RenameTeamParams{
+		Name:    name,
+		NewName: newName,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errorsx.ErrNotFound
+	}
+
+	return nil
+}
+
+// Delete removes the team along with its settings, rotation cursor and api tokens.
+// Team must not have any members left.
+func (r *TeamRepository) Delete(ctx context.Context, name string) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	affected, err := queries.DeleteTeam(ctx, name)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errorsx.ErrNotFound
+	}
+
+	return nil
+}
+
 func (r *TeamRepository) GetManyByNames(ctx context.Context, names ...string) (result map[string]teams.Team, err error) {
 	defer func() {
 		err = mapError(err)
@@ -139,3 +253,38 @@ func (r *TeamRepository) GetManyByNames(ctx context.Context, names ...string) (r
 
 	return result, nil
 }
+
+func (r *TeamRepository) GetAllMemberIDs(ctx context.Context) (ids []string, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	return queries.GetAllMemberIDs(ctx)
+}
+
+func (r *TeamRepository) List(ctx context.Context, memberID, afterName string, limit int) (result []*teams.Team, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	rows, err := r.getQueries(ctx).ListTeams(ctx, generated.ListTeamsParams{
+		AfterName: afterName,
+		MemberID:  optionalText(memberID),
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]*teams.Team, len(rows))
+	for i, row := range rows {
+		result[i] = &teams.Team{
+			Name:      row.Name,
+			MemberIDs: row.MemberIds,
+		}
+	}
+
+	return result, nil
+}
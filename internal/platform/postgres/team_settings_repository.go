@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+)
+
+type TeamSettingsRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewTeamSettingsRepository(pool *pgxpool.Pool) *TeamSettingsRepository {
+	return &TeamSettingsRepository{pool: pool}
+}
+
+func (r *TeamSettingsRepository) getQueries(ctx context.Context) *generated.Queries {
+	if tx, ok := ctx.Value(txKey).(pgx.Tx); ok {
+		return generated.New(tx)
+	}
+	return generated.New(r.pool)
+}
+
+func (r *TeamSettingsRepository) Get(ctx context.Context, teamName string) (settings *teams.Settings, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	row, err := queries.GetTeamSettings(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	settings = &teams.Settings{
+		TeamName: row.TeamName,
+		Strategy: teams.Strategy(row.Strategy),
+		Reviewers: teams.ReviewersPolicy{
+			Default:           int(row.ReviewersCount),
+			Min:               int(row.MinReviewersCount),
+			Max:               int(row.MaxReviewersCount),
+			RequiredApprovals: int(row.RequiredApprovals),
+		},
+		AllowOtherTeams:   row.AllowOtherTeams,
+		FallbackTeamNames: row.FallbackTeamNames,
+		ReviewSLA:         time.Duration(row.ReviewSlaSeconds) * time.Second,
+	}
+
+	return settings, nil
+}
+
+func (r *TeamSettingsRepository) Save(ctx context.Context, s *teams.Settings) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	queries := r.getQueries(ctx)
+
+	err = queries.SaveTeamSettings(ctx, generated.SaveTeamSettingsParams{
+		TeamName:          s.TeamName,
+		Strategy:          string(s.Strategy),
+		ReviewersCount:    int32(s.Reviewers.Default),
+		MinReviewersCount: int32(s.Reviewers.Min),
+		MaxReviewersCount: int32(s.Reviewers.Max),
+		RequiredApprovals: int32(s.Reviewers.RequiredApprovals),
+		AllowOtherTeams:   s.AllowOtherTeams,
+		FallbackTeamNames: s.FallbackTeamNames,
+		ReviewSlaSeconds:  int32(s.ReviewSLA / time.Second),
+	})
+	return err
+}
+
+// RenameFallbackTeam replaces the team's name in fallback chains of all the teams.
+func (r *TeamSettingsRepository) RenameFallbackTeam(ctx context.Context, name, newName string) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	return r.getQueries(ctx).RenameFallbackTeam(ctx, generated.RenameFallbackTeamParams{
+		Name:    name,
+		NewName: newName,
+	})
+}
+
+// RemoveFallbackTeam removes the team from fallback chains of all the teams.
+func (r *TeamSettingsRepository) RemoveFallbackTeam(ctx context.Context, name string) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	return r.getQueries(ctx).RemoveFallbackTeam(ctx, name)
+}
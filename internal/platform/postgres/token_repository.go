@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
+)
+
+type TokenRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewTokenRepository(pool *pgxpool.Pool) *TokenRepository {
+	return &TokenRepository{pool: pool}
+}
+
+func (r *TokenRepository) getQueries(ctx context.Context) *generated.Queries {
+	if tx, ok := ctx.Value(txKey).(pgx.Tx); ok {
+		return generated.New(tx)
+	}
+	return generated.New(r.pool)
+}
+
+func (r *TokenRepository) GetByHash(ctx context.Context, hash string) (p *auth.Principal, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	row, err := r.getQueries(ctx).GetAPITokenByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.Principal{
+		Name:     row.Name,
+		Role:     auth.Role(row.Role),
+		TeamName: row.TeamName.String,
+	}, nil
+}
+
+func (r *TokenRepository) Create(ctx context.Context, hash string, p *auth.Principal) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	return r.getQueries(ctx).CreateAPIToken(ctx, generated.CreateAPITokenParams{
+		Name:      p.Name,
+		TokenHash: hash,
+		Role:      string(p.Role),
+		TeamName:  pgtype.Text{String: p.TeamName, Valid: p.TeamName != ""},
+	})
+}
+
+func (r *TokenRepository) Delete(ctx context.Context, name string) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	affected, err := r.getQueries(ctx).DeleteAPIToken(ctx, name)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errorsx.ErrNotFound
+	}
+
+	return nil
+}
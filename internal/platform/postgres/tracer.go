@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/lezzercringe/avito-test-assignment/internal/platform/postgres"
+
+var _ pgx.QueryTracer = queryTracer{}
+
+// queryTracer starts a span for every query, named after the sqlc query when possible.
+type queryTracer struct{}
+
+func (queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, _ = otel.Tracer(tracerName).Start(ctx, queryName(data.SQL),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.statement", data.SQL),
+		),
+	)
+	return ctx
+}
+
+func (queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+		return
+	}
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+}
+
+// queryName extracts name from sqlc header comment, e.g. "-- name: GetTeamMembers :many".
+func queryName(sql string) string {
+	header, _, _ := strings.Cut(sql, "\n")
+	if name, ok := strings.CutPrefix(header, "-- name: "); ok {
+		if name, _, ok := strings.Cut(name, " "); ok {
+			return name
+		}
+	}
+
+	command, _, _ := strings.Cut(strings.TrimSpace(sql), " ")
+	return strings.ToUpper(command)
+}
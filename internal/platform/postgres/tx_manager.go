@@ -20,24 +20,41 @@ func NewTxManager(db *pgxpool.Pool) usecases.TxManager {
 	return &PgTxManager{db: db}
 }
 
+// WithTx begins a transaction, or a savepoint if parent context already carries one.
+// Functions registered by usecases.AfterCommit run once the outermost transaction commits.
 func (m *PgTxManager) WithTx(parent context.Context) (context.Context, usecases.TxHandle, error) {
-	tx, err := m.db.Begin(parent)
+	var (
+		tx  pgx.Tx
+		err error
+	)
+	if outer, ok := parent.Value(txKey).(pgx.Tx); ok {
+		tx, err = outer.Begin(parent)
+	} else {
+		tx, err = m.db.Begin(parent)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 
-	ctx := context.WithValue(parent, txKey, tx)
-	handle := &PgTxHandle{tx: tx}
+	ctx, runHooks := usecases.WithCommitHooks(parent)
+	ctx = context.WithValue(ctx, txKey, tx)
+	handle := &PgTxHandle{tx: tx, runHooks: runHooks}
 
 	return ctx, handle, nil
 }
 
 type PgTxHandle struct {
-	tx pgx.Tx
+	tx       pgx.Tx
+	runHooks func()
 }
 
 func (h *PgTxHandle) Commit(ctx context.Context) error {
-	return h.tx.Commit(ctx)
+	if err := h.tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	h.runHooks()
+	return nil
 }
 
 func (h *PgTxHandle) Rollback(ctx context.Context) error {
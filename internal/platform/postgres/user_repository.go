@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
 	"github.com/lezzercringe/avito-test-assignment/internal/users"
 )
 
@@ -52,9 +54,10 @@ func (r *UserRepository) Get(ctx context.Context, id string) (user *users.User,
 	}
 
 	user = &users.User{
-		ID:     generatedUser.ID,
-		Name:   generatedUser.Name,
-		Active: generatedUser.Active,
+		ID:          generatedUser.ID,
+		Name:        generatedUser.Name,
+		Active:      generatedUser.Active,
+		OutOfOffice: generatedUser.OutOfOffice,
 	}
 
 	return user, nil
@@ -108,9 +111,43 @@ func (r *UserRepository) GetMany(ctx context.Context, id ...string) (result []*u
 	result = make([]*users.User, len(generatedUsers))
 	for i, user := range generatedUsers {
 		result[i] = &users.User{
-			ID:     user.ID,
-			Name:   user.Name,
-			Active: user.Active,
+			ID:          user.ID,
+			Name:        user.Name,
+			Active:      user.Active,
+			OutOfOffice: user.OutOfOffice,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *UserRepository) List(ctx context.Context, filter usecases.UserFilter, afterID string, limit int) (result []*users.User, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	var active pgtype.Bool
+	if filter.Active != nil {
+		active = pgtype.Bool{Bool: *filter.Active, Valid: true}
+	}
+
+	rows, err := r.getQueries(ctx).ListUsers(ctx, generated.ListUsersParams{
+		AfterID:  afterID,
+		Active:   active,
+		TeamName: optionalText(filter.TeamName),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]*users.User, len(rows))
+	for i, row := range rows {
+		result[i] = &users.User{
+			ID:          row.ID,
+			Name:        row.Name,
+			Active:      row.Active,
+			OutOfOffice: row.OutOfOffice,
 		}
 	}
 
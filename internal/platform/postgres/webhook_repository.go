@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/postgres/generated"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+)
+
+type WebhookRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewWebhookRepository(pool *pgxpool.Pool) *WebhookRepository {
+	return &WebhookRepository{pool: pool}
+}
+
+func (r *WebhookRepository) getQueries(ctx context.Context) *generated.Queries {
+	if tx, ok := ctx.Value(txKey).(pgx.Tx); ok {
+		return generated.New(tx)
+	}
+	return generated.New(r.pool)
+}
+
+// Create inserts the subscription and sets its ID.
+func (r *WebhookRepository) Create(ctx context.Context, s *webhooks.Subscription) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	eventTypes := make([]string, len(s.EventTypes))
+	for i, t := range s.EventTypes {
+		eventTypes[i] = string(t)
+	}
+
+	id, err := r.getQueries(ctx).CreateWebhookSubscription(ctx, generated.CreateWebhookSubscriptionParams{
+		Url:        s.URL,
+		Secret:     s.Secret,
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.ID = id
+	return nil
+}
+
+func (r *WebhookRepository) GetAll(ctx context.Context) (result []*webhooks.Subscription, err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	rows, err := r.getQueries(ctx).GetWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]*webhooks.Subscription, len(rows))
+	for i, row := range rows {
+		eventTypes := make([]webhooks.EventType, len(row.EventTypes))
+		for j, t := range row.EventTypes {
+			eventTypes[j] = webhooks.EventType(t)
+		}
+
+		result[i] = &webhooks.Subscription{
+			ID:         row.ID,
+			URL:        row.Url,
+			Secret:     row.Secret,
+			EventTypes: eventTypes,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *WebhookRepository) Delete(ctx context.Context, id int64) (err error) {
+	defer func() {
+		err = mapError(err)
+	}()
+
+	affected, err := r.getQueries(ctx).DeleteWebhookSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errorsx.ErrNotFound
+	}
+
+	return nil
+}
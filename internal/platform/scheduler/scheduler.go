@@ -0,0 +1,108 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Scheduler periodically runs background jobs until it is shut down.
+type Scheduler struct {
+	logger     *zap.Logger
+	stop       chan struct{}
+	jobsCtx    context.Context
+	cancelJobs context.CancelFunc
+	// servicesCtx is cancelled as soon as shutdown starts, long-running jobs have nothing to finish
+	servicesCtx    context.Context
+	cancelServices context.CancelFunc
+	wg             sync.WaitGroup
+}
+
+func New(logger *zap.Logger) *Scheduler {
+	jobsCtx, cancelJobs := context.WithCancel(context.Background())
+	servicesCtx, cancelServices := context.WithCancel(jobsCtx)
+
+	return &Scheduler{
+		logger:         logger,
+		stop:           make(chan struct{}),
+		jobsCtx:        jobsCtx,
+		cancelJobs:     cancelJobs,
+		servicesCtx:    servicesCtx,
+		cancelServices: cancelServices,
+	}
+}
+
+// Every runs job each interval. Errors are logged and do not stop the job.
+// Non-positive interval disables the job.
+func (s *Scheduler) Every(name string, interval time.Duration, job func(context.Context) error) {
+	if interval <= 0 {
+		s.logger.Info("background job is disabled", zap.String("job", name))
+		return
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+				if err := job(s.jobsCtx); err != nil {
+					s.logger.Error("background job failed", zap.String("job", name), zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Serve runs long-running job, e.g. a listener, restarting it retryDelay after it fails.
+// Errors are logged, the job is cancelled once shutdown starts.
+func (s *Scheduler) Serve(name string, retryDelay time.Duration, job func(context.Context) error) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		for {
+			err := job(s.servicesCtx)
+			if s.servicesCtx.Err() != nil {
+				return
+			}
+			s.logger.Error("background service failed", zap.String("job", name), zap.Error(err))
+
+			select {
+			case <-s.servicesCtx.Done():
+				return
+			case <-time.After(retryDelay):
+			}
+		}
+	}()
+}
+
+// Shutdown stops scheduling jobs and waits for the running ones to finish.
+// Jobs still running when ctx is done are cancelled.
+func (s *Scheduler) Shutdown(ctx context.Context) error {
+	close(s.stop)
+	s.cancelServices()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	defer s.cancelJobs()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
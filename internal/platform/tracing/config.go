@@ -0,0 +1,17 @@
+package tracing
+
+const (
+	ExporterNone   = ""
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+type Config struct {
+	// Exporter is one of "otlp", "stdout" or empty to disable tracing.
+	Exporter string `yaml:"exporter"`
+	// Endpoint is host:port of OTLP HTTP collector, OTEL_EXPORTER_OTLP_ENDPOINT is used when empty.
+	Endpoint    string  `yaml:"endpoint"`
+	Insecure    bool    `yaml:"insecure"`
+	ServiceName string  `yaml:"service_name"`
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
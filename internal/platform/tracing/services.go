@@ -0,0 +1,421 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/integrations"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const instrumentationName = "github.com/lezzercringe/avito-test-assignment/internal/usecases"
+
+// span runs fn within a child span named after the usecase method.
+func span[T any](ctx context.Context, name string, fn func(context.Context) (T, error)) (T, error) {
+	ctx, s := otel.Tracer(instrumentationName).Start(ctx, name)
+	defer s.End()
+
+	res, err := fn(ctx)
+	if err != nil {
+		s.RecordError(err)
+		s.SetStatus(codes.Error, err.Error())
+	}
+
+	return res, err
+}
+
+var _ usecases.PullRequestService = &pullRequestService{}
+
+type pullRequestService struct {
+	next usecases.PullRequestService
+}
+
+// PullRequestService wraps every method of the service in a span.
+func PullRequestService(next usecases.PullRequestService) usecases.PullRequestService {
+	return &pullRequestService{next: next}
+}
+
+func (s *pullRequestService) Get(ctx context.Context, id string) (*usecases.PullRequestDetailsView, error) {
+	return span(ctx, "PullRequestService.Get", func(ctx context.Context) (*usecases.PullRequestDetailsView, error) {
+		return s.next.Get(ctx, id)
+	})
+}
+
+func (s *pullRequestService) Create(ctx context.Context, req usecases.CreateRequest) (*prs.PullRequestView, error) {
+	return span(ctx, "PullRequestService.Create", func(ctx context.Context) (*prs.PullRequestView, error) {
+		return s.next.Create(ctx, req)
+	})
+}
+
+func (s *pullRequestService) Merge(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	return span(ctx, "PullRequestService.Merge", func(ctx context.Context) (*prs.PullRequestView, error) {
+		return s.next.Merge(ctx, id)
+	})
+}
+
+func (s *pullRequestService) RecordMerged(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	return span(ctx, "PullRequestService.RecordMerged", func(ctx context.Context) (*prs.PullRequestView, error) {
+		return s.next.RecordMerged(ctx, id)
+	})
+}
+
+func (s *pullRequestService) MarkReady(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	return span(ctx, "PullRequestService.MarkReady", func(ctx context.Context) (*prs.PullRequestView, error) {
+		return s.next.MarkReady(ctx, id)
+	})
+}
+
+func (s *pullRequestService) Close(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	return span(ctx, "PullRequestService.Close", func(ctx context.Context) (*prs.PullRequestView, error) {
+		return s.next.Close(ctx, id)
+	})
+}
+
+func (s *pullRequestService) Reopen(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	return span(ctx, "PullRequestService.Reopen", func(ctx context.Context) (*prs.PullRequestView, error) {
+		return s.next.Reopen(ctx, id)
+	})
+}
+
+func (s *pullRequestService) SubmitReview(ctx context.Context, req usecases.SubmitReviewRequest) (*prs.PullRequestView, error) {
+	return span(ctx, "PullRequestService.SubmitReview", func(ctx context.Context) (*prs.PullRequestView, error) {
+		return s.next.SubmitReview(ctx, req)
+	})
+}
+
+func (s *pullRequestService) GetHistory(ctx context.Context, id string) ([]usecases.AssignmentEventView, error) {
+	return span(ctx, "PullRequestService.GetHistory", func(ctx context.Context) ([]usecases.AssignmentEventView, error) {
+		return s.next.GetHistory(ctx, id)
+	})
+}
+
+func (s *pullRequestService) ReassignReviewer(ctx context.Context, req usecases.ReassignReviewerRequest) (*usecases.ReassignReviewerResult, error) {
+	return span(ctx, "PullRequestService.ReassignReviewer", func(ctx context.Context) (*usecases.ReassignReviewerResult, error) {
+		return s.next.ReassignReviewer(ctx, req)
+	})
+}
+
+func (s *pullRequestService) List(ctx context.Context, req usecases.ListPullRequestsRequest) (*usecases.Page[*prs.PullRequestView], error) {
+	return span(ctx, "PullRequestService.List", func(ctx context.Context) (*usecases.Page[*prs.PullRequestView], error) {
+		return s.next.List(ctx, req)
+	})
+}
+
+var _ usecases.TeamService = &teamService{}
+
+type teamService struct {
+	next usecases.TeamService
+}
+
+// TeamService wraps every method of the service in a span.
+func TeamService(next usecases.TeamService) usecases.TeamService {
+	return &teamService{next: next}
+}
+
+func (s *teamService) GetTeam(ctx context.Context, name string) (*usecases.TeamView, error) {
+	return span(ctx, "TeamService.GetTeam", func(ctx context.Context) (*usecases.TeamView, error) {
+		return s.next.GetTeam(ctx, name)
+	})
+}
+
+func (s *teamService) ListTeams(ctx context.Context, req usecases.ListTeamsRequest) (*usecases.Page[*usecases.TeamView], error) {
+	return span(ctx, "TeamService.ListTeams", func(ctx context.Context) (*usecases.Page[*usecases.TeamView], error) {
+		return s.next.ListTeams(ctx, req)
+	})
+}
+
+func (s *teamService) AddTeam(ctx context.Context, req usecases.TeamView) (*usecases.TeamView, error) {
+	return span(ctx, "TeamService.AddTeam", func(ctx context.Context) (*usecases.TeamView, error) {
+		return s.next.AddTeam(ctx, req)
+	})
+}
+
+func (s *teamService) AddMember(ctx context.Context, req usecases.AddMemberRequest) (*usecases.TeamView, error) {
+	return span(ctx, "TeamService.AddMember", func(ctx context.Context) (*usecases.TeamView, error) {
+		return s.next.AddMember(ctx, req)
+	})
+}
+
+func (s *teamService) RemoveMember(ctx context.Context, req usecases.RemoveMemberRequest) (*usecases.TeamView, error) {
+	return span(ctx, "TeamService.RemoveMember", func(ctx context.Context) (*usecases.TeamView, error) {
+		return s.next.RemoveMember(ctx, req)
+	})
+}
+
+func (s *teamService) MoveMember(ctx context.Context, req usecases.MoveMemberRequest) (*usecases.TeamView, error) {
+	return span(ctx, "TeamService.MoveMember", func(ctx context.Context) (*usecases.TeamView, error) {
+		return s.next.MoveMember(ctx, req)
+	})
+}
+
+func (s *teamService) RenameTeam(ctx context.Context, req usecases.RenameTeamRequest) (*usecases.TeamView, error) {
+	return span(ctx, "TeamService.RenameTeam", func(ctx context.Context) (*usecases.TeamView, error) {
+		return s.next.RenameTeam(ctx, req)
+	})
+}
+
+func (s *teamService) DeleteTeam(ctx context.Context, req usecases.DeleteTeamRequest) error {
+	_, err := span(ctx, "TeamService.DeleteTeam", func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, s.next.DeleteTeam(ctx, req)
+	})
+	return err
+}
+
+func (s *teamService) GetSettings(ctx context.Context, teamName string) (*usecases.TeamSettingsView, error) {
+	return span(ctx, "TeamService.GetSettings", func(ctx context.Context) (*usecases.TeamSettingsView, error) {
+		return s.next.GetSettings(ctx, teamName)
+	})
+}
+
+func (s *teamService) SetSettings(ctx context.Context, req usecases.TeamSettingsView) (*usecases.TeamSettingsView, error) {
+	return span(ctx, "TeamService.SetSettings", func(ctx context.Context) (*usecases.TeamSettingsView, error) {
+		return s.next.SetSettings(ctx, req)
+	})
+}
+
+var _ usecases.UserService = &userService{}
+
+type userService struct {
+	next usecases.UserService
+}
+
+// UserService wraps every method of the service in a span.
+func UserService(next usecases.UserService) usecases.UserService {
+	return &userService{next: next}
+}
+
+func (s *userService) SetIsActive(ctx context.Context, id string, isActive bool) (*usecases.UserWithTeamsView, error) {
+	return span(ctx, "UserService.SetIsActive", func(ctx context.Context) (*usecases.UserWithTeamsView, error) {
+		return s.next.SetIsActive(ctx, id, isActive)
+	})
+}
+
+func (s *userService) SetPrimaryTeam(ctx context.Context, id, teamName string) (*usecases.UserWithTeamsView, error) {
+	return span(ctx, "UserService.SetPrimaryTeam", func(ctx context.Context) (*usecases.UserWithTeamsView, error) {
+		return s.next.SetPrimaryTeam(ctx, id, teamName)
+	})
+}
+
+func (s *userService) GetReview(ctx context.Context, id string) ([]*usecases.ReviewedPullRequestView, error) {
+	return span(ctx, "UserService.GetReview", func(ctx context.Context) ([]*usecases.ReviewedPullRequestView, error) {
+		return s.next.GetReview(ctx, id)
+	})
+}
+
+func (s *userService) Deactivate(ctx context.Context, ids ...string) ([]*usecases.UserView, error) {
+	return span(ctx, "UserService.Deactivate", func(ctx context.Context) ([]*usecases.UserView, error) {
+		return s.next.Deactivate(ctx, ids...)
+	})
+}
+
+func (s *userService) ListUsers(ctx context.Context, req usecases.ListUsersRequest) (*usecases.Page[*usecases.UserView], error) {
+	return span(ctx, "UserService.ListUsers", func(ctx context.Context) (*usecases.Page[*usecases.UserView], error) {
+		return s.next.ListUsers(ctx, req)
+	})
+}
+
+var _ usecases.OutOfOfficeService = &outOfOfficeService{}
+
+type outOfOfficeService struct {
+	next usecases.OutOfOfficeService
+}
+
+// OutOfOfficeService wraps every method of the service in a span.
+func OutOfOfficeService(next usecases.OutOfOfficeService) usecases.OutOfOfficeService {
+	return &outOfOfficeService{next: next}
+}
+
+func (s *outOfOfficeService) Add(ctx context.Context, req usecases.AddOutOfOfficeRequest) (*usecases.OutOfOfficeView, error) {
+	return span(ctx, "OutOfOfficeService.Add", func(ctx context.Context) (*usecases.OutOfOfficeView, error) {
+		return s.next.Add(ctx, req)
+	})
+}
+
+func (s *outOfOfficeService) List(ctx context.Context, userID string) ([]*usecases.OutOfOfficeView, error) {
+	return span(ctx, "OutOfOfficeService.List", func(ctx context.Context) ([]*usecases.OutOfOfficeView, error) {
+		return s.next.List(ctx, userID)
+	})
+}
+
+func (s *outOfOfficeService) Delete(ctx context.Context, id int64) error {
+	_, err := span(ctx, "OutOfOfficeService.Delete", func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, s.next.Delete(ctx, id)
+	})
+	return err
+}
+
+func (s *outOfOfficeService) ReassignStarted(ctx context.Context) error {
+	_, err := span(ctx, "OutOfOfficeService.ReassignStarted", func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, s.next.ReassignStarted(ctx)
+	})
+	return err
+}
+
+var _ usecases.StaleReviewService = &staleReviewService{}
+
+type staleReviewService struct {
+	next usecases.StaleReviewService
+}
+
+// StaleReviewService wraps every method of the service in a span.
+func StaleReviewService(next usecases.StaleReviewService) usecases.StaleReviewService {
+	return &staleReviewService{next: next}
+}
+
+func (s *staleReviewService) ReassignStale(ctx context.Context) error {
+	_, err := span(ctx, "StaleReviewService.ReassignStale", func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, s.next.ReassignStale(ctx)
+	})
+	return err
+}
+
+var _ usecases.WebhookService = &webhookService{}
+
+type webhookService struct {
+	next usecases.WebhookService
+}
+
+// WebhookService wraps every method of the service in a span.
+func WebhookService(next usecases.WebhookService) usecases.WebhookService {
+	return &webhookService{next: next}
+}
+
+func (s *webhookService) CreateSubscription(ctx context.Context, req usecases.CreateWebhookRequest) (*usecases.WebhookView, error) {
+	return span(ctx, "WebhookService.CreateSubscription", func(ctx context.Context) (*usecases.WebhookView, error) {
+		return s.next.CreateSubscription(ctx, req)
+	})
+}
+
+func (s *webhookService) ListSubscriptions(ctx context.Context) ([]*usecases.WebhookView, error) {
+	return span(ctx, "WebhookService.ListSubscriptions", func(ctx context.Context) ([]*usecases.WebhookView, error) {
+		return s.next.ListSubscriptions(ctx)
+	})
+}
+
+func (s *webhookService) DeleteSubscription(ctx context.Context, id int64) error {
+	_, err := span(ctx, "WebhookService.DeleteSubscription", func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, s.next.DeleteSubscription(ctx, id)
+	})
+	return err
+}
+
+func (s *webhookService) DeliverDue(ctx context.Context) error {
+	_, err := span(ctx, "WebhookService.DeliverDue", func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, s.next.DeliverDue(ctx)
+	})
+	return err
+}
+
+var _ usecases.StatsService = &statsService{}
+
+type statsService struct {
+	next usecases.StatsService
+}
+
+// StatsService wraps every method of the service in a span.
+func StatsService(next usecases.StatsService) usecases.StatsService {
+	return &statsService{next: next}
+}
+
+func (s *statsService) GetReviewerStats(ctx context.Context, period usecases.StatsPeriod) ([]usecases.ReviewerStats, error) {
+	return span(ctx, "StatsService.GetReviewerStats", func(ctx context.Context) ([]usecases.ReviewerStats, error) {
+		return s.next.GetReviewerStats(ctx, period)
+	})
+}
+
+func (s *statsService) GetTeamStats(ctx context.Context, period usecases.StatsPeriod) ([]usecases.TeamStats, error) {
+	return span(ctx, "StatsService.GetTeamStats", func(ctx context.Context) ([]usecases.TeamStats, error) {
+		return s.next.GetTeamStats(ctx, period)
+	})
+}
+
+func (s *statsService) GetLeadTimes(ctx context.Context, period usecases.StatsPeriod) (*usecases.LeadTimes, error) {
+	return span(ctx, "StatsService.GetLeadTimes", func(ctx context.Context) (*usecases.LeadTimes, error) {
+		return s.next.GetLeadTimes(ctx, period)
+	})
+}
+
+var _ usecases.AuthService = &authService{}
+
+type authService struct {
+	next usecases.AuthService
+}
+
+// AuthService wraps every method of the service in a span.
+func AuthService(next usecases.AuthService) usecases.AuthService {
+	return &authService{next: next}
+}
+
+func (s *authService) Authenticate(ctx context.Context, token string) (*auth.Principal, error) {
+	return span(ctx, "AuthService.Authenticate", func(ctx context.Context) (*auth.Principal, error) {
+		return s.next.Authenticate(ctx, token)
+	})
+}
+
+func (s *authService) CreateToken(ctx context.Context, req usecases.CreateTokenRequest) (*usecases.CreatedTokenView, error) {
+	return span(ctx, "AuthService.CreateToken", func(ctx context.Context) (*usecases.CreatedTokenView, error) {
+		return s.next.CreateToken(ctx, req)
+	})
+}
+
+func (s *authService) RevokeToken(ctx context.Context, name string) error {
+	_, err := span(ctx, "AuthService.RevokeToken", func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, s.next.RevokeToken(ctx, name)
+	})
+	return err
+}
+
+var _ usecases.IntegrationService = &integrationService{}
+
+type integrationService struct {
+	next usecases.IntegrationService
+}
+
+// IntegrationService wraps every method of the service in a span.
+func IntegrationService(next usecases.IntegrationService) usecases.IntegrationService {
+	return &integrationService{next: next}
+}
+
+func (s *integrationService) LinkAccount(ctx context.Context, req usecases.LinkGitAccountRequest) (*usecases.GitAccountView, error) {
+	return span(ctx, "IntegrationService.LinkAccount", func(ctx context.Context) (*usecases.GitAccountView, error) {
+		return s.next.LinkAccount(ctx, req)
+	})
+}
+
+func (s *integrationService) UnlinkAccount(ctx context.Context, host, login string) error {
+	_, err := span(ctx, "IntegrationService.UnlinkAccount", func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, s.next.UnlinkAccount(ctx, host, login)
+	})
+	return err
+}
+
+func (s *integrationService) HandlePullRequestEvent(ctx context.Context, e *integrations.PullRequestEvent) (bool, error) {
+	return span(ctx, "IntegrationService.HandlePullRequestEvent", func(ctx context.Context) (bool, error) {
+		return s.next.HandlePullRequestEvent(ctx, e)
+	})
+}
+
+var _ usecases.EventStreamService = &eventStreamService{}
+
+type eventStreamService struct {
+	next usecases.EventStreamService
+}
+
+// EventStreamService wraps subscribing in a span, listening lasts for the service's lifetime and is not traced.
+func EventStreamService(next usecases.EventStreamService) usecases.EventStreamService {
+	return &eventStreamService{next: next}
+}
+
+func (s *eventStreamService) Subscribe(ctx context.Context, filter usecases.EventStreamFilter) (<-chan webhooks.Event, error) {
+	return span(ctx, "EventStreamService.Subscribe", func(ctx context.Context) (<-chan webhooks.Event, error) {
+		return s.next.Subscribe(ctx, filter)
+	})
+}
+
+func (s *eventStreamService) Listen(ctx context.Context) error {
+	return s.next.Listen(ctx)
+}
@@ -0,0 +1,69 @@
+package webhooksender_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/platform/webhooksender"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+)
+
+func TestSender_Send(t *testing.T) {
+	payload := []byte(`{"event_type":"PR_MERGED","pull_request_id":"pr-1"}`)
+
+	t.Run("signed delivery", func(t *testing.T) {
+		var got *http.Request
+		var body []byte
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer receiver.Close()
+
+		d := &webhooks.Delivery{ID: 7, URL: receiver.URL, Secret: "s3cret", EventType: webhooks.EventPullRequestMerged, Payload: payload}
+
+		err := webhooksender.New(time.Second).Send(context.Background(), d)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPost, got.Method)
+		assert.Equal(t, payload, body)
+		assert.Equal(t, "application/json", got.Header.Get("Content-Type"))
+		assert.Equal(t, "PR_MERGED", got.Header.Get(webhooksender.HeaderEvent))
+		assert.Equal(t, "7", got.Header.Get(webhooksender.HeaderDelivery))
+		assert.Equal(t, webhooks.Sign("s3cret", body), got.Header.Get(webhooksender.HeaderSignature))
+	})
+
+	t.Run("non-2xx response fails the attempt", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer receiver.Close()
+
+		d := &webhooks.Delivery{ID: 7, URL: receiver.URL, Secret: "s3cret", Payload: payload}
+
+		err := webhooksender.New(time.Second).Send(context.Background(), d)
+
+		assert.ErrorContains(t, err, "500")
+	})
+
+	t.Run("timeout fails the attempt", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		}))
+		defer receiver.Close()
+
+		d := &webhooks.Delivery{ID: 7, URL: receiver.URL, Secret: "s3cret", Payload: payload}
+
+		err := webhooksender.New(50*time.Millisecond).Send(context.Background(), d)
+
+		assert.Error(t, err)
+	})
+}
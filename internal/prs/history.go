@@ -0,0 +1,75 @@
+package prs
+
+import (
+	"slices"
+	"time"
+)
+
+type AssignmentAction string
+
+const (
+	ActionAssigned   AssignmentAction = "ASSIGNED"
+	ActionUnassigned AssignmentAction = "UNASSIGNED"
+)
+
+// AssignmentReason explains why reviewer assignment has changed.
+type AssignmentReason string
+
+const (
+	ReasonInitialPick    AssignmentReason = "INITIAL_PICK"
+	ReasonManualReassign AssignmentReason = "MANUAL_REASSIGN"
+	ReasonDeactivation   AssignmentReason = "DEACTIVATION"
+	ReasonTeamChange     AssignmentReason = "TEAM_CHANGE" // reviewer left the team of the PR
+	ReasonOutOfOffice    AssignmentReason = "OUT_OF_OFFICE"
+	// ReasonMerge and ReasonClose end all the assignments, reviewers list of the PR itself is kept.
+	ReasonMerge  AssignmentReason = "MERGE"
+	ReasonClose  AssignmentReason = "CLOSE"
+	ReasonReopen AssignmentReason = "REOPEN"
+)
+
+// AssignmentEvent is an entry of append-only reviewer assignment history.
+type AssignmentEvent struct {
+	PullRequestID string
+	ReviewerID    string
+	Action        AssignmentAction
+	Reason        AssignmentReason
+	Actor         string
+	At            time.Time
+}
+
+// DiffAssignments returns events which turn reviewers list before into after.
+// Unassignments go first.
+func DiffAssignments(
+	pullRequestID string,
+	before, after []string,
+	reason AssignmentReason,
+	actor string,
+	at time.Time,
+) []AssignmentEvent {
+	var events []AssignmentEvent
+
+	newEvent := func(reviewerID string, action AssignmentAction) AssignmentEvent {
+		return AssignmentEvent{
+			PullRequestID: pullRequestID,
+			ReviewerID:    reviewerID,
+			Action:        action,
+			Reason:        reason,
+			Actor:         actor,
+			At:            at,
+		}
+	}
+
+	for _, id := range before {
+		if !slices.Contains(after, id) {
+			events = append(events, newEvent(id, ActionUnassigned))
+		}
+	}
+
+	for _, id := range after {
+		if !slices.Contains(before, id) {
+			events = append(events, newEvent(id, ActionAssigned))
+		}
+	}
+
+	return events
+}
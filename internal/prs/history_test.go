@@ -0,0 +1,39 @@
+package prs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+)
+
+func TestDiffAssignments(t *testing.T) {
+	at := time.Now()
+
+	t.Run("replaced reviewer", func(t *testing.T) {
+		events := prs.DiffAssignments("pr-1", []string{"u1", "u2"}, []string{"u2", "u3"}, prs.ReasonManualReassign, "admin", at)
+
+		assert.Equal(t, []prs.AssignmentEvent{
+			{PullRequestID: "pr-1", ReviewerID: "u1", Action: prs.ActionUnassigned, Reason: prs.ReasonManualReassign, Actor: "admin", At: at},
+			{PullRequestID: "pr-1", ReviewerID: "u3", Action: prs.ActionAssigned, Reason: prs.ReasonManualReassign, Actor: "admin", At: at},
+		}, events)
+	})
+
+	t.Run("all assignments ended", func(t *testing.T) {
+		events := prs.DiffAssignments("pr-1", []string{"u1", "u2"}, nil, prs.ReasonMerge, "system", at)
+
+		assert.Len(t, events, 2)
+		for _, e := range events {
+			assert.Equal(t, prs.ActionUnassigned, e.Action)
+			assert.Equal(t, prs.ReasonMerge, e.Reason)
+		}
+	})
+
+	t.Run("nothing changed", func(t *testing.T) {
+		events := prs.DiffAssignments("pr-1", []string{"u1"}, []string{"u1"}, prs.ReasonInitialPick, "system", at)
+
+		assert.Empty(t, events)
+	})
+}
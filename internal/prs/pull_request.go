@@ -7,15 +7,26 @@ import (
 	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
 )
 
-const maxReviewers = 2
-
 type Status string
 
 const (
+	StatusDraft  Status = "DRAFT"
 	StatusOpen   Status = "OPEN"
 	StatusMerged Status = "MERGED"
+	StatusClosed Status = "CLOSED" // closed without merge
 )
 
+var statuses = []Status{
+	StatusDraft,
+	StatusOpen,
+	StatusMerged,
+	StatusClosed,
+}
+
+func (s Status) Valid() bool {
+	return slices.Contains(statuses, s)
+}
+
 type PullRequest struct {
 	ID               string
 	Name             string
@@ -23,43 +34,189 @@ type PullRequest struct {
 	OriginalTeamName string
 	AuthorID         string
 	ReviewerIDs      []string
+	ReviewersCount   int               // maximum number of assigned reviewers
+	Reviews          map[string]Review // keyed by reviewer id, reviewers without entry are pending
 	MergedAt         time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time // last change of status or reviewers
+	Version          int64     // version of the stored PR, zero if it was never saved
+}
+
+func New(id, name, teamName, authorID string, reviewersCount int, draft bool) (*PullRequest, error) {
+	if reviewersCount < 0 {
+		return nil, errorsx.ErrReviewersCount
+	}
+
+	status := StatusOpen
+	if draft {
+		status = StatusDraft
+	}
+
+	now := time.Now()
+	return &PullRequest{
+		ID:               id,
+		Name:             name,
+		Status:           status,
+		OriginalTeamName: teamName,
+		AuthorID:         authorID,
+		ReviewersCount:   reviewersCount,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}, nil
 }
 
 type PullRequestView struct {
-	ID          string
-	Name        string
-	AuthorID    string
-	Status      string
-	ReviewerIDs []string
-	MergedAt    time.Time
+	ID             string
+	Name           string
+	AuthorID       string
+	Status         string
+	ReviewerIDs    []string
+	ReviewersCount int
+	Reviews        []ReviewView // in the order of ReviewerIDs
+	MergedAt       time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Version        int64
+	// FallbackReviewerIDs are reviewers which are not members of PR's team.
+	// Filled only by operations which assign reviewers.
+	FallbackReviewerIDs []string
 }
 
 func (p *PullRequest) ToView() *PullRequestView {
 	return &PullRequestView{
-		ID:          p.ID,
-		Name:        p.Name,
-		AuthorID:    p.AuthorID,
-		Status:      string(p.Status),
-		ReviewerIDs: p.ReviewerIDs,
-		MergedAt:    p.MergedAt,
+		ID:             p.ID,
+		Name:           p.Name,
+		AuthorID:       p.AuthorID,
+		Status:         string(p.Status),
+		ReviewerIDs:    p.ReviewerIDs,
+		ReviewersCount: p.ReviewersCount,
+		Reviews:        p.reviewViews(),
+		MergedAt:       p.MergedAt,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+		Version:        p.Version,
 	}
 }
 
-func (p *PullRequest) Merge() {
+// touch records the time of the PR's modification.
+func (p *PullRequest) touch() {
+	p.UpdatedAt = time.Now()
+}
+
+// checkNotFinished reports an error if PR is either merged or closed.
+func (p *PullRequest) checkNotFinished() error {
+	switch p.Status {
+	case StatusMerged:
+		return errorsx.ErrModifyMergedPR
+	case StatusClosed:
+		return errorsx.ErrModifyClosedPR
+	}
+	return nil
+}
+
+// Merge requires at least requiredApprovals of assigned reviewers to approve the PR.
+// Merge is idempotent, merging already merged PR is a no-op.
+func (p *PullRequest) Merge(requiredApprovals int) error {
+	if p.Status == StatusMerged {
+		return nil
+	}
+	if err := p.checkNotFinished(); err != nil {
+		return err
+	}
+	if p.Status == StatusDraft {
+		return errorsx.ErrDraftPR
+	}
+	if p.Approvals() < requiredApprovals {
+		return errorsx.ErrNotEnoughApprovals
+	}
+
+	p.Status = StatusMerged
+	p.MergedAt = time.Now()
+	p.UpdatedAt = p.MergedAt
+	return nil
+}
+
+// RecordMerged marks PR merged elsewhere, e.g. on the Git host, whose own merge policy has already been applied.
+// Recording merge of already merged PR is a no-op.
+func (p *PullRequest) RecordMerged() {
 	if p.Status == StatusMerged {
 		return
 	}
+
 	p.Status = StatusMerged
 	p.MergedAt = time.Now()
+	p.UpdatedAt = p.MergedAt
 }
 
-func (p *PullRequest) AssignReviewer(userID string) error {
+// MarkReady moves draft PR to review.
+func (p *PullRequest) MarkReady() error {
+	if err := p.checkNotFinished(); err != nil {
+		return err
+	}
+	if p.Status != StatusDraft {
+		return errorsx.ErrNotDraftPR
+	}
+
+	p.Status = StatusOpen
+	p.touch()
+	return nil
+}
+
+// Close abandons PR without merging, assigned reviewers are kept.
+// Closing already closed PR is a no-op.
+func (p *PullRequest) Close() error {
+	if p.Status == StatusClosed {
+		return nil
+	}
 	if p.Status == StatusMerged {
 		return errorsx.ErrModifyMergedPR
 	}
 
-	if len(p.ReviewerIDs) >= maxReviewers {
+	p.Status = StatusClosed
+	p.touch()
+	return nil
+}
+
+// Reopen moves closed PR back to review.
+func (p *PullRequest) Reopen() error {
+	if p.Status == StatusMerged {
+		return errorsx.ErrModifyMergedPR
+	}
+	if p.Status != StatusClosed {
+		return errorsx.ErrNotClosedPR
+	}
+
+	p.Status = StatusOpen
+	p.touch()
+	return nil
+}
+
+// OutsideReviewerIDs returns reviewers which are not among the given team members.
+func (p *PullRequest) OutsideReviewerIDs(teamMemberIDs []string) []string {
+	var ids []string
+	for _, id := range p.ReviewerIDs {
+		if !slices.Contains(teamMemberIDs, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// MissingReviewersCount returns how many reviewers may still be assigned to the PR.
+func (p *PullRequest) MissingReviewersCount() int {
+	return max(p.ReviewersCount-len(p.ReviewerIDs), 0)
+}
+
+func (p *PullRequest) AssignReviewer(userID string) error {
+	if err := p.checkNotFinished(); err != nil {
+		return err
+	}
+
+	if p.Status == StatusDraft {
+		return errorsx.ErrDraftPR
+	}
+
+	if len(p.ReviewerIDs) >= p.ReviewersCount {
 		return errorsx.ErrToManyReviewers
 	}
 
@@ -67,13 +224,16 @@ func (p *PullRequest) AssignReviewer(userID string) error {
 		return errorsx.ErrCandidateIsAlreadyReviewer
 	}
 
+	now := time.Now()
 	p.ReviewerIDs = append(p.ReviewerIDs, userID)
+	p.setReview(userID, Review{State: ReviewPending, AssignedAt: now, UpdatedAt: now})
+	p.UpdatedAt = now
 	return nil
 }
 
 func (p *PullRequest) UnassignReviewer(userID string) error {
-	if p.Status == StatusMerged {
-		return errorsx.ErrModifyMergedPR
+	if err := p.checkNotFinished(); err != nil {
+		return err
 	}
 
 	ix := slices.Index(p.ReviewerIDs, userID)
@@ -82,5 +242,7 @@ func (p *PullRequest) UnassignReviewer(userID string) error {
 	}
 
 	p.ReviewerIDs = append(p.ReviewerIDs[:ix], p.ReviewerIDs[ix+1:]...)
+	delete(p.Reviews, userID)
+	p.touch()
 	return nil
 }
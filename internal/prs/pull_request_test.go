@@ -11,6 +11,33 @@ import (
 	"github.com/lezzercringe/avito-test-assignment/internal/prs"
 )
 
+func TestNew(t *testing.T) {
+	t.Run("creates open PR with reviewers count", func(t *testing.T) {
+		pr, err := prs.New("pr-123", "Fix bug", "team-alpha", "author-1", 3, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, prs.StatusOpen, pr.Status)
+		assert.Equal(t, 3, pr.ReviewersCount)
+		assert.Empty(t, pr.ReviewerIDs)
+		assert.False(t, pr.CreatedAt.IsZero())
+		assert.Equal(t, pr.CreatedAt, pr.UpdatedAt)
+	})
+
+	t.Run("creates draft PR", func(t *testing.T) {
+		pr, err := prs.New("pr-123", "Fix bug", "team-alpha", "author-1", 2, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, prs.StatusDraft, pr.Status)
+	})
+
+	t.Run("negative reviewers count", func(t *testing.T) {
+		pr, err := prs.New("pr-123", "Fix bug", "team-alpha", "author-1", -1, false)
+
+		assert.ErrorIs(t, err, errorsx.ErrReviewersCount)
+		assert.Nil(t, pr)
+	})
+}
+
 func TestPullRequest_ToView(t *testing.T) {
 	pr := &prs.PullRequest{
 		ID:               "pr-123",
@@ -19,6 +46,7 @@ func TestPullRequest_ToView(t *testing.T) {
 		OriginalTeamName: "team-alpha",
 		AuthorID:         "author-1",
 		ReviewerIDs:      []string{"reviewer-1", "reviewer-2"},
+		ReviewersCount:   2,
 		MergedAt:         time.Time{},
 	}
 
@@ -29,9 +57,35 @@ func TestPullRequest_ToView(t *testing.T) {
 	assert.Equal(t, pr.AuthorID, view.AuthorID)
 	assert.Equal(t, string(pr.Status), view.Status)
 	assert.Equal(t, pr.ReviewerIDs, view.ReviewerIDs)
+	assert.Equal(t, pr.ReviewersCount, view.ReviewersCount)
+	assert.Equal(t, []prs.ReviewView{
+		{ReviewerID: "reviewer-1", State: "PENDING"},
+		{ReviewerID: "reviewer-2", State: "PENDING"},
+	}, view.Reviews)
 	assert.Equal(t, pr.MergedAt, view.MergedAt)
 }
 
+func TestPullRequest_RecordMerged(t *testing.T) {
+	t.Run("ignores missing approvals", func(t *testing.T) {
+		pr := &prs.PullRequest{ID: "pr-123", Status: prs.StatusOpen, ReviewerIDs: []string{"u1"}}
+
+		require.ErrorIs(t, pr.Merge(1), errorsx.ErrNotEnoughApprovals)
+		pr.RecordMerged()
+
+		assert.Equal(t, prs.StatusMerged, pr.Status)
+		assert.False(t, pr.MergedAt.IsZero())
+	})
+
+	t.Run("already merged PR is kept", func(t *testing.T) {
+		mergedAt := time.Now().Add(-time.Hour)
+		pr := &prs.PullRequest{ID: "pr-456", Status: prs.StatusMerged, MergedAt: mergedAt}
+
+		pr.RecordMerged()
+
+		assert.Equal(t, mergedAt, pr.MergedAt)
+	})
+}
+
 func TestPullRequest_Merge(t *testing.T) {
 	t.Run("merge open PR", func(t *testing.T) {
 		pr := &prs.PullRequest{
@@ -40,7 +94,7 @@ func TestPullRequest_Merge(t *testing.T) {
 			Status: prs.StatusOpen,
 		}
 
-		pr.Merge()
+		require.NoError(t, pr.Merge(0))
 
 		assert.Equal(t, prs.StatusMerged, pr.Status)
 		assert.False(t, pr.MergedAt.IsZero())
@@ -55,19 +109,111 @@ func TestPullRequest_Merge(t *testing.T) {
 			MergedAt: originalMergedAt,
 		}
 
-		pr.Merge()
+		require.NoError(t, pr.Merge(0))
 
 		assert.Equal(t, prs.StatusMerged, pr.Status)
 		assert.Equal(t, originalMergedAt, pr.MergedAt)
 	})
+
+	t.Run("cannot merge draft PR", func(t *testing.T) {
+		pr := &prs.PullRequest{ID: "pr-123", Status: prs.StatusDraft}
+
+		assert.Equal(t, errorsx.ErrDraftPR, pr.Merge(0))
+		assert.Equal(t, prs.StatusDraft, pr.Status)
+		assert.True(t, pr.MergedAt.IsZero())
+	})
+
+	t.Run("cannot merge closed PR", func(t *testing.T) {
+		pr := &prs.PullRequest{ID: "pr-123", Status: prs.StatusClosed}
+
+		assert.Equal(t, errorsx.ErrModifyClosedPR, pr.Merge(0))
+		assert.Equal(t, prs.StatusClosed, pr.Status)
+	})
+}
+
+func TestPullRequest_MarkReady(t *testing.T) {
+	t.Run("draft PR becomes open", func(t *testing.T) {
+		pr := &prs.PullRequest{ID: "pr-123", Status: prs.StatusDraft}
+
+		require.NoError(t, pr.MarkReady())
+		assert.Equal(t, prs.StatusOpen, pr.Status)
+	})
+
+	t.Run("guards", func(t *testing.T) {
+		cases := map[prs.Status]error{
+			prs.StatusOpen:   errorsx.ErrNotDraftPR,
+			prs.StatusMerged: errorsx.ErrModifyMergedPR,
+			prs.StatusClosed: errorsx.ErrModifyClosedPR,
+		}
+		for status, want := range cases {
+			pr := &prs.PullRequest{ID: "pr-123", Status: status}
+
+			assert.Equal(t, want, pr.MarkReady(), status)
+			assert.Equal(t, status, pr.Status)
+		}
+	})
+}
+
+func TestPullRequest_Close(t *testing.T) {
+	t.Run("open and draft PRs can be closed", func(t *testing.T) {
+		for _, status := range []prs.Status{prs.StatusOpen, prs.StatusDraft} {
+			pr := &prs.PullRequest{
+				ID:          "pr-123",
+				Status:      status,
+				ReviewerIDs: []string{"reviewer-1"},
+			}
+
+			require.NoError(t, pr.Close())
+			assert.Equal(t, prs.StatusClosed, pr.Status)
+			assert.Equal(t, []string{"reviewer-1"}, pr.ReviewerIDs)
+		}
+	})
+
+	t.Run("idempotent close - already closed PR", func(t *testing.T) {
+		pr := &prs.PullRequest{ID: "pr-123", Status: prs.StatusClosed}
+
+		require.NoError(t, pr.Close())
+		assert.Equal(t, prs.StatusClosed, pr.Status)
+	})
+
+	t.Run("cannot close merged PR", func(t *testing.T) {
+		pr := &prs.PullRequest{ID: "pr-123", Status: prs.StatusMerged}
+
+		assert.Equal(t, errorsx.ErrModifyMergedPR, pr.Close())
+		assert.Equal(t, prs.StatusMerged, pr.Status)
+	})
+}
+
+func TestPullRequest_Reopen(t *testing.T) {
+	t.Run("closed PR becomes open", func(t *testing.T) {
+		pr := &prs.PullRequest{ID: "pr-123", Status: prs.StatusClosed}
+
+		require.NoError(t, pr.Reopen())
+		assert.Equal(t, prs.StatusOpen, pr.Status)
+	})
+
+	t.Run("guards", func(t *testing.T) {
+		cases := map[prs.Status]error{
+			prs.StatusOpen:   errorsx.ErrNotClosedPR,
+			prs.StatusDraft:  errorsx.ErrNotClosedPR,
+			prs.StatusMerged: errorsx.ErrModifyMergedPR,
+		}
+		for status, want := range cases {
+			pr := &prs.PullRequest{ID: "pr-123", Status: status}
+
+			assert.Equal(t, want, pr.Reopen(), status)
+			assert.Equal(t, status, pr.Status)
+		}
+	})
 }
 
 func TestPullRequest_AssignReviewer(t *testing.T) {
 	t.Run("assign reviewer to open PR", func(t *testing.T) {
 		pr := &prs.PullRequest{
-			ID:          "pr-123",
-			Status:      prs.StatusOpen,
-			ReviewerIDs: []string{"reviewer-1"},
+			ID:             "pr-123",
+			Status:         prs.StatusOpen,
+			ReviewerIDs:    []string{"reviewer-1"},
+			ReviewersCount: 2,
 		}
 
 		err := pr.AssignReviewer("reviewer-2")
@@ -75,13 +221,18 @@ func TestPullRequest_AssignReviewer(t *testing.T) {
 		require.NoError(t, err)
 		assert.Contains(t, pr.ReviewerIDs, "reviewer-2")
 		assert.Len(t, pr.ReviewerIDs, 2)
+
+		review := pr.ReviewOf("reviewer-2")
+		assert.False(t, review.AssignedAt.IsZero())
+		assert.Equal(t, review.AssignedAt, pr.UpdatedAt)
 	})
 
 	t.Run("cannot assign to merged PR", func(t *testing.T) {
 		pr := &prs.PullRequest{
-			ID:          "pr-123",
-			Status:      prs.StatusMerged,
-			ReviewerIDs: []string{"reviewer-1"},
+			ID:             "pr-123",
+			Status:         prs.StatusMerged,
+			ReviewerIDs:    []string{"reviewer-1"},
+			ReviewersCount: 2,
 		}
 
 		err := pr.AssignReviewer("reviewer-2")
@@ -93,9 +244,10 @@ func TestPullRequest_AssignReviewer(t *testing.T) {
 
 	t.Run("cannot assign already assigned reviewer", func(t *testing.T) {
 		pr := &prs.PullRequest{
-			ID:          "pr-123",
-			Status:      prs.StatusOpen,
-			ReviewerIDs: []string{"reviewer-1"},
+			ID:             "pr-123",
+			Status:         prs.StatusOpen,
+			ReviewerIDs:    []string{"reviewer-1"},
+			ReviewersCount: 2,
 		}
 
 		err := pr.AssignReviewer("reviewer-1")
@@ -107,9 +259,10 @@ func TestPullRequest_AssignReviewer(t *testing.T) {
 
 	t.Run("cannot assign more than max reviewers", func(t *testing.T) {
 		pr := &prs.PullRequest{
-			ID:          "pr-123",
-			Status:      prs.StatusOpen,
-			ReviewerIDs: []string{"reviewer-1", "reviewer-2"},
+			ID:             "pr-123",
+			Status:         prs.StatusOpen,
+			ReviewerIDs:    []string{"reviewer-1", "reviewer-2"},
+			ReviewersCount: 2,
 		}
 
 		err := pr.AssignReviewer("reviewer-3")
@@ -118,14 +271,41 @@ func TestPullRequest_AssignReviewer(t *testing.T) {
 		assert.Equal(t, errorsx.ErrToManyReviewers, err)
 		assert.Len(t, pr.ReviewerIDs, 2)
 	})
+
+	t.Run("cannot assign to draft or closed PR", func(t *testing.T) {
+		cases := map[prs.Status]error{
+			prs.StatusDraft:  errorsx.ErrDraftPR,
+			prs.StatusClosed: errorsx.ErrModifyClosedPR,
+		}
+		for status, want := range cases {
+			pr := &prs.PullRequest{ID: "pr-123", Status: status, ReviewersCount: 2}
+
+			assert.Equal(t, want, pr.AssignReviewer("reviewer-1"), status)
+			assert.Empty(t, pr.ReviewerIDs)
+		}
+	})
+
+	t.Run("respects per-PR reviewers count", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:             "pr-123",
+			Status:         prs.StatusOpen,
+			ReviewerIDs:    []string{"reviewer-1", "reviewer-2"},
+			ReviewersCount: 3,
+		}
+
+		require.NoError(t, pr.AssignReviewer("reviewer-3"))
+		assert.Equal(t, errorsx.ErrToManyReviewers, pr.AssignReviewer("reviewer-4"))
+		assert.Len(t, pr.ReviewerIDs, 3)
+	})
 }
 
 func TestPullRequest_UnassignReviewer(t *testing.T) {
 	t.Run("unassign reviewer from open PR", func(t *testing.T) {
 		pr := &prs.PullRequest{
-			ID:          "pr-123",
-			Status:      prs.StatusOpen,
-			ReviewerIDs: []string{"reviewer-1", "reviewer-2"},
+			ID:             "pr-123",
+			Status:         prs.StatusOpen,
+			ReviewerIDs:    []string{"reviewer-1", "reviewer-2"},
+			ReviewersCount: 2,
 		}
 
 		err := pr.UnassignReviewer("reviewer-1")
@@ -138,9 +318,10 @@ func TestPullRequest_UnassignReviewer(t *testing.T) {
 
 	t.Run("cannot unassign from merged PR", func(t *testing.T) {
 		pr := &prs.PullRequest{
-			ID:          "pr-123",
-			Status:      prs.StatusMerged,
-			ReviewerIDs: []string{"reviewer-1", "reviewer-2"},
+			ID:             "pr-123",
+			Status:         prs.StatusMerged,
+			ReviewerIDs:    []string{"reviewer-1", "reviewer-2"},
+			ReviewersCount: 2,
 		}
 
 		err := pr.UnassignReviewer("reviewer-1")
@@ -152,9 +333,10 @@ func TestPullRequest_UnassignReviewer(t *testing.T) {
 
 	t.Run("cannot unassign not assigned reviewer", func(t *testing.T) {
 		pr := &prs.PullRequest{
-			ID:          "pr-123",
-			Status:      prs.StatusOpen,
-			ReviewerIDs: []string{"reviewer-1", "reviewer-2"},
+			ID:             "pr-123",
+			Status:         prs.StatusOpen,
+			ReviewerIDs:    []string{"reviewer-1", "reviewer-2"},
+			ReviewersCount: 2,
 		}
 
 		err := pr.UnassignReviewer("reviewer-3")
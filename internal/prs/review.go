@@ -0,0 +1,106 @@
+package prs
+
+import (
+	"slices"
+	"time"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+)
+
+// ReviewState is a verdict of a single reviewer.
+type ReviewState string
+
+const (
+	ReviewPending          ReviewState = "PENDING"
+	ReviewApproved         ReviewState = "APPROVED"
+	ReviewChangesRequested ReviewState = "CHANGES_REQUESTED"
+	ReviewCommented        ReviewState = "COMMENTED"
+)
+
+// verdicts are review states which may be submitted by a reviewer.
+var verdicts = []ReviewState{
+	ReviewApproved,
+	ReviewChangesRequested,
+	ReviewCommented,
+}
+
+type Review struct {
+	State      ReviewState
+	AssignedAt time.Time
+	UpdatedAt  time.Time
+}
+
+type ReviewView struct {
+	ReviewerID string
+	State      string
+	AssignedAt time.Time
+	UpdatedAt  time.Time
+}
+
+// ReviewOf returns review of the assigned reviewer, reviewers without recorded review are pending.
+func (p *PullRequest) ReviewOf(reviewerID string) Review {
+	if review, ok := p.Reviews[reviewerID]; ok {
+		return review
+	}
+	return Review{State: ReviewPending}
+}
+
+// Approvals returns number of assigned reviewers which approved the PR.
+func (p *PullRequest) Approvals() int {
+	n := 0
+	for _, id := range p.ReviewerIDs {
+		if p.ReviewOf(id).State == ReviewApproved {
+			n++
+		}
+	}
+	return n
+}
+
+// SubmitReview records verdict of the assigned reviewer, previous verdict is overwritten.
+func (p *PullRequest) SubmitReview(reviewerID string, state ReviewState) error {
+	if !slices.Contains(verdicts, state) {
+		return errorsx.ErrReviewState
+	}
+
+	if err := p.checkNotFinished(); err != nil {
+		return err
+	}
+
+	if p.Status == StatusDraft {
+		return errorsx.ErrDraftPR
+	}
+
+	if !slices.Contains(p.ReviewerIDs, reviewerID) {
+		return errorsx.ErrNotPreviouslyAssigned
+	}
+
+	now := time.Now()
+	p.setReview(reviewerID, Review{
+		State:      state,
+		AssignedAt: p.ReviewOf(reviewerID).AssignedAt,
+		UpdatedAt:  now,
+	})
+	p.UpdatedAt = now
+	return nil
+}
+
+func (p *PullRequest) setReview(reviewerID string, review Review) {
+	if p.Reviews == nil {
+		p.Reviews = make(map[string]Review)
+	}
+	p.Reviews[reviewerID] = review
+}
+
+func (p *PullRequest) reviewViews() []ReviewView {
+	views := make([]ReviewView, len(p.ReviewerIDs))
+	for i, id := range p.ReviewerIDs {
+		review := p.ReviewOf(id)
+		views[i] = ReviewView{
+			ReviewerID: id,
+			State:      string(review.State),
+			AssignedAt: review.AssignedAt,
+			UpdatedAt:  review.UpdatedAt,
+		}
+	}
+	return views
+}
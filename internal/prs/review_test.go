@@ -0,0 +1,121 @@
+package prs_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+)
+
+func TestPullRequest_SubmitReview(t *testing.T) {
+	t.Run("assigned reviewer approves PR", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:             "pr-123",
+			Status:         prs.StatusOpen,
+			ReviewersCount: 2,
+		}
+		require.NoError(t, pr.AssignReviewer("reviewer-1"))
+		assert.Equal(t, prs.ReviewPending, pr.ReviewOf("reviewer-1").State)
+		assignedAt := pr.ReviewOf("reviewer-1").AssignedAt
+
+		err := pr.SubmitReview("reviewer-1", prs.ReviewApproved)
+
+		require.NoError(t, err)
+		assert.Equal(t, prs.ReviewApproved, pr.ReviewOf("reviewer-1").State)
+		assert.False(t, pr.ReviewOf("reviewer-1").UpdatedAt.IsZero())
+		assert.Equal(t, assignedAt, pr.ReviewOf("reviewer-1").AssignedAt)
+		assert.Equal(t, pr.ReviewOf("reviewer-1").UpdatedAt, pr.UpdatedAt)
+		assert.Equal(t, 1, pr.Approvals())
+	})
+
+	t.Run("verdict can be changed", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:          "pr-123",
+			Status:      prs.StatusOpen,
+			ReviewerIDs: []string{"reviewer-1"},
+		}
+
+		require.NoError(t, pr.SubmitReview("reviewer-1", prs.ReviewApproved))
+		require.NoError(t, pr.SubmitReview("reviewer-1", prs.ReviewChangesRequested))
+
+		assert.Equal(t, prs.ReviewChangesRequested, pr.ReviewOf("reviewer-1").State)
+		assert.Zero(t, pr.Approvals())
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:          "pr-123",
+			Status:      prs.StatusOpen,
+			ReviewerIDs: []string{"reviewer-1"},
+		}
+
+		for _, state := range []prs.ReviewState{prs.ReviewPending, "LGTM"} {
+			assert.Equal(t, errorsx.ErrReviewState, pr.SubmitReview("reviewer-1", state))
+		}
+		assert.Equal(t, prs.ReviewPending, pr.ReviewOf("reviewer-1").State)
+	})
+
+	t.Run("reviewer is not assigned", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:          "pr-123",
+			Status:      prs.StatusOpen,
+			ReviewerIDs: []string{"reviewer-1"},
+		}
+
+		err := pr.SubmitReview("reviewer-2", prs.ReviewApproved)
+
+		assert.Equal(t, errorsx.ErrNotPreviouslyAssigned, err)
+	})
+
+	t.Run("PR is not under review", func(t *testing.T) {
+		cases := map[prs.Status]error{
+			prs.StatusDraft:  errorsx.ErrDraftPR,
+			prs.StatusMerged: errorsx.ErrModifyMergedPR,
+			prs.StatusClosed: errorsx.ErrModifyClosedPR,
+		}
+		for status, want := range cases {
+			pr := &prs.PullRequest{
+				ID:          "pr-123",
+				Status:      status,
+				ReviewerIDs: []string{"reviewer-1"},
+			}
+
+			assert.Equal(t, want, pr.SubmitReview("reviewer-1", prs.ReviewApproved), status)
+		}
+	})
+
+	t.Run("unassigned reviewer's verdict is dropped", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:             "pr-123",
+			Status:         prs.StatusOpen,
+			ReviewerIDs:    []string{"reviewer-1"},
+			ReviewersCount: 1,
+		}
+		require.NoError(t, pr.SubmitReview("reviewer-1", prs.ReviewApproved))
+
+		require.NoError(t, pr.UnassignReviewer("reviewer-1"))
+		require.NoError(t, pr.AssignReviewer("reviewer-1"))
+
+		assert.Equal(t, prs.ReviewPending, pr.ReviewOf("reviewer-1").State)
+	})
+}
+
+func TestPullRequest_Merge_RequiredApprovals(t *testing.T) {
+	pr := &prs.PullRequest{
+		ID:          "pr-123",
+		Status:      prs.StatusOpen,
+		ReviewerIDs: []string{"reviewer-1", "reviewer-2"},
+	}
+	require.NoError(t, pr.SubmitReview("reviewer-1", prs.ReviewApproved))
+	require.NoError(t, pr.SubmitReview("reviewer-2", prs.ReviewCommented))
+
+	assert.Equal(t, errorsx.ErrNotEnoughApprovals, pr.Merge(2))
+	assert.Equal(t, prs.StatusOpen, pr.Status)
+
+	require.NoError(t, pr.SubmitReview("reviewer-2", prs.ReviewApproved))
+	require.NoError(t, pr.Merge(2))
+	assert.Equal(t, prs.StatusMerged, pr.Status)
+}
@@ -0,0 +1,130 @@
+package teams
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+)
+
+// Strategy defines how reviewers are selected for team's pull requests.
+type Strategy string
+
+const (
+	// StrategyDefault follows the service-wide strategy.
+	StrategyDefault     Strategy = "default"
+	StrategyRandom      Strategy = "random"
+	StrategyLeastLoaded Strategy = "least_loaded"
+	StrategyRoundRobin  Strategy = "round_robin"
+)
+
+var strategies = []Strategy{
+	StrategyDefault,
+	StrategyRandom,
+	StrategyLeastLoaded,
+	StrategyRoundRobin,
+}
+
+const (
+	DefaultReviewersCount = 2
+	// ReviewersCountLimit is the upper bound for reviewers count any team may allow.
+	ReviewersCountLimit = 10
+)
+
+// ReviewersPolicy limits number of reviewers on team's pull requests.
+type ReviewersPolicy struct {
+	Default           int // used when pull request author does not ask for specific count
+	Min               int
+	Max               int
+	RequiredApprovals int // approvals needed to merge pull request, may not exceed Min
+}
+
+// Settings describe team's reviewer selection policy.
+type Settings struct {
+	TeamName        string
+	Strategy        Strategy
+	Reviewers       ReviewersPolicy
+	AllowOtherTeams bool // members of other teams are eligible when team lacks candidates
+	// FallbackTeamNames are looked through in order when team lacks candidates,
+	// before members of other teams if those are allowed.
+	FallbackTeamNames []string
+	// ReviewSLA is how long a review may stay pending before it is reassigned, zero disables reassignment.
+	ReviewSLA time.Duration
+}
+
+func NewSettings(
+	teamName string,
+	strategy Strategy,
+	reviewers ReviewersPolicy,
+	fallbackTeamNames []string,
+	allowOtherTeams bool,
+	reviewSLA time.Duration,
+) (*Settings, error) {
+	if !slices.Contains(strategies, strategy) {
+		return nil, errorsx.ErrUnknownStrategy
+	}
+
+	if reviewers.Min < 0 ||
+		reviewers.Min > reviewers.Default ||
+		reviewers.Default > reviewers.Max ||
+		reviewers.Max > ReviewersCountLimit {
+		return nil, errorsx.ErrReviewersCount
+	}
+
+	if reviewers.RequiredApprovals < 0 || reviewers.RequiredApprovals > reviewers.Min {
+		return nil, errorsx.ErrRequiredApprovals
+	}
+
+	if err := checkFallbackTeamNames(teamName, fallbackTeamNames); err != nil {
+		return nil, err
+	}
+
+	if reviewSLA < 0 {
+		return nil, errorsx.ErrReviewSLA
+	}
+
+	return &Settings{
+		TeamName:          teamName,
+		Strategy:          strategy,
+		Reviewers:         reviewers,
+		FallbackTeamNames: fallbackTeamNames,
+		AllowOtherTeams:   allowOtherTeams,
+		ReviewSLA:         reviewSLA,
+	}, nil
+}
+
+// checkFallbackTeamNames requires fallback chain to consist of distinct teams other than the team itself.
+func checkFallbackTeamNames(teamName string, names []string) error {
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if strings.TrimSpace(name) == "" || name == teamName {
+			return errorsx.ErrFallbackTeams
+		}
+		if _, ok := seen[name]; ok {
+			return errorsx.ErrFallbackTeams
+		}
+		seen[name] = struct{}{}
+	}
+	return nil
+}
+
+// CheckReviewersCount reports whether n reviewers may be requested for team's pull request.
+func (s *Settings) CheckReviewersCount(n int) error {
+	if n < s.Reviewers.Min || n > s.Reviewers.Max {
+		return errorsx.ErrReviewersCount
+	}
+	return nil
+}
+
+// DefaultSettings returns settings applied to teams which were never configured.
+func DefaultSettings(teamName string) *Settings {
+	return &Settings{
+		TeamName: teamName,
+		Strategy: StrategyDefault,
+		Reviewers: ReviewersPolicy{
+			Default: DefaultReviewersCount,
+			Max:     DefaultReviewersCount,
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package teams_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+)
+
+func TestNewSettings(t *testing.T) {
+	t.Run("valid settings", func(t *testing.T) {
+		policy := teams.ReviewersPolicy{Default: 2, Min: 1, Max: 3, RequiredApprovals: 1}
+
+		settings, err := teams.NewSettings("alpha-team", teams.StrategyLeastLoaded, policy, nil, true, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, "alpha-team", settings.TeamName)
+		assert.Equal(t, teams.StrategyLeastLoaded, settings.Strategy)
+		assert.Equal(t, policy, settings.Reviewers)
+		assert.True(t, settings.AllowOtherTeams)
+	})
+
+	t.Run("valid fallback teams", func(t *testing.T) {
+		policy := teams.ReviewersPolicy{Default: 1, Max: 1}
+
+		settings, err := teams.NewSettings("alpha-team", teams.StrategyRandom, policy, []string{"beta-team", "gamma-team"}, false, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"beta-team", "gamma-team"}, settings.FallbackTeamNames)
+	})
+
+	t.Run("invalid fallback teams", func(t *testing.T) {
+		policy := teams.ReviewersPolicy{Default: 1, Max: 1}
+
+		for _, names := range [][]string{{"alpha-team"}, {"beta-team", "beta-team"}, {" "}} {
+			_, err := teams.NewSettings("alpha-team", teams.StrategyRandom, policy, names, false, 0)
+
+			assert.Equal(t, errorsx.ErrFallbackTeams, err)
+		}
+	})
+
+	t.Run("review SLA", func(t *testing.T) {
+		policy := teams.ReviewersPolicy{Default: 1, Max: 1}
+
+		settings, err := teams.NewSettings("alpha-team", teams.StrategyRandom, policy, nil, false, 48*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 48*time.Hour, settings.ReviewSLA)
+
+		_, err = teams.NewSettings("alpha-team", teams.StrategyRandom, policy, nil, false, -time.Hour)
+		assert.Equal(t, errorsx.ErrReviewSLA, err)
+	})
+
+	t.Run("unknown strategy", func(t *testing.T) {
+		_, err := teams.NewSettings("alpha-team", teams.Strategy("fastest"), teams.ReviewersPolicy{Default: 1, Max: 1}, nil, false, 0)
+
+		assert.Equal(t, errorsx.ErrUnknownStrategy, err)
+	})
+
+	t.Run("negative minimum", func(t *testing.T) {
+		_, err := teams.NewSettings("alpha-team", teams.StrategyRandom, teams.ReviewersPolicy{Default: 1, Min: -1, Max: 1}, nil, false, 0)
+
+		assert.Equal(t, errorsx.ErrReviewersCount, err)
+	})
+
+	t.Run("default outside of bounds", func(t *testing.T) {
+		_, err := teams.NewSettings("alpha-team", teams.StrategyRandom, teams.ReviewersPolicy{Default: 3, Min: 1, Max: 2}, nil, false, 0)
+
+		assert.Equal(t, errorsx.ErrReviewersCount, err)
+	})
+
+	t.Run("maximum above limit", func(t *testing.T) {
+		policy := teams.ReviewersPolicy{Default: 2, Max: teams.ReviewersCountLimit + 1}
+
+		_, err := teams.NewSettings("alpha-team", teams.StrategyRandom, policy, nil, false, 0)
+
+		assert.Equal(t, errorsx.ErrReviewersCount, err)
+	})
+
+	t.Run("required approvals above minimum", func(t *testing.T) {
+		policy := teams.ReviewersPolicy{Default: 2, Min: 1, Max: 3, RequiredApprovals: 2}
+
+		_, err := teams.NewSettings("alpha-team", teams.StrategyRandom, policy, nil, false, 0)
+
+		assert.Equal(t, errorsx.ErrRequiredApprovals, err)
+	})
+}
+
+func TestSettings_CheckReviewersCount(t *testing.T) {
+	settings := &teams.Settings{
+		TeamName:  "alpha-team",
+		Strategy:  teams.StrategyRandom,
+		Reviewers: teams.ReviewersPolicy{Default: 2, Min: 1, Max: 3},
+	}
+
+	assert.NoError(t, settings.CheckReviewersCount(1))
+	assert.NoError(t, settings.CheckReviewersCount(3))
+	assert.Equal(t, errorsx.ErrReviewersCount, settings.CheckReviewersCount(0))
+	assert.Equal(t, errorsx.ErrReviewersCount, settings.CheckReviewersCount(4))
+}
+
+func TestDefaultSettings(t *testing.T) {
+	settings := teams.DefaultSettings("alpha-team")
+
+	assert.Equal(t, "alpha-team", settings.TeamName)
+	assert.Equal(t, teams.StrategyDefault, settings.Strategy)
+	assert.Equal(t, teams.DefaultReviewersCount, settings.Reviewers.Default)
+	assert.Equal(t, teams.DefaultReviewersCount, settings.Reviewers.Max)
+	assert.Zero(t, settings.Reviewers.RequiredApprovals)
+	assert.False(t, settings.AllowOtherTeams)
+}
@@ -1,6 +1,7 @@
 package teams
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
@@ -29,3 +30,69 @@ func New(name string, memberIDs []string) (*Team, error) {
 		MemberIDs: memberIDs,
 	}, nil
 }
+
+func (t *Team) Rename(newName string) error {
+	if strings.TrimSpace(newName) == "" {
+		return errorsx.ErrTeamName
+	}
+
+	t.Name = newName
+	return nil
+}
+
+func (t *Team) HasMember(userID string) bool {
+	return slices.Contains(t.MemberIDs, userID)
+}
+
+func (t *Team) AddMember(userID string) error {
+	if strings.TrimSpace(userID) == "" {
+		return errorsx.ErrUserID
+	}
+	if t.HasMember(userID) {
+		return errorsx.ErrDuplicateMember
+	}
+
+	t.MemberIDs = append(t.MemberIDs, userID)
+	return nil
+}
+
+func (t *Team) RemoveMember(userID string) error {
+	idx := slices.Index(t.MemberIDs, userID)
+	if idx == -1 {
+		return errorsx.ErrNotTeamMember
+	}
+
+	t.MemberIDs = slices.Delete(t.MemberIDs, idx, idx+1)
+	return nil
+}
+
+// MoveMember moves user from one team to another.
+func MoveMember(from, to *Team, userID string) error {
+	if from.Name == to.Name {
+		return errorsx.ErrSameTeam
+	}
+	if err := from.RemoveMember(userID); err != nil {
+		return err
+	}
+	return to.AddMember(userID)
+}
+
+// MoveAllMembers moves every member of one team to another, leaving the source team empty.
+// Users which are members of both teams just leave the source team.
+func MoveAllMembers(from, to *Team) error {
+	if from.Name == to.Name {
+		return errorsx.ErrSameTeam
+	}
+	for _, userID := range slices.Clone(from.MemberIDs) {
+		if to.HasMember(userID) {
+			if err := from.RemoveMember(userID); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := MoveMember(from, to, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -45,3 +45,99 @@ func TestNewTeam(t *testing.T) {
 		assert.Equal(t, errorsx.ErrDuplicateMember, err)
 	})
 }
+
+func TestTeam_Members(t *testing.T) {
+	t.Run("add member", func(t *testing.T) {
+		team := &teams.Team{Name: "alpha", MemberIDs: []string{"u1"}}
+
+		require.NoError(t, team.AddMember("u2"))
+		assert.Equal(t, []string{"u1", "u2"}, team.MemberIDs)
+		assert.True(t, team.HasMember("u2"))
+	})
+
+	t.Run("add duplicate member", func(t *testing.T) {
+		team := &teams.Team{Name: "alpha", MemberIDs: []string{"u1"}}
+
+		assert.Equal(t, errorsx.ErrDuplicateMember, team.AddMember("u1"))
+	})
+
+	t.Run("add member with empty id", func(t *testing.T) {
+		team := &teams.Team{Name: "alpha"}
+
+		assert.Equal(t, errorsx.ErrUserID, team.AddMember(" "))
+	})
+
+	t.Run("remove member", func(t *testing.T) {
+		team := &teams.Team{Name: "alpha", MemberIDs: []string{"u1", "u2", "u3"}}
+
+		require.NoError(t, team.RemoveMember("u2"))
+		assert.Equal(t, []string{"u1", "u3"}, team.MemberIDs)
+		assert.False(t, team.HasMember("u2"))
+	})
+
+	t.Run("remove non-member", func(t *testing.T) {
+		team := &teams.Team{Name: "alpha", MemberIDs: []string{"u1"}}
+
+		assert.Equal(t, errorsx.ErrNotTeamMember, team.RemoveMember("u2"))
+	})
+}
+
+func TestMoveMember(t *testing.T) {
+	t.Run("move member", func(t *testing.T) {
+		from := &teams.Team{Name: "alpha", MemberIDs: []string{"u1", "u2"}}
+		to := &teams.Team{Name: "beta", MemberIDs: []string{"u3"}}
+
+		require.NoError(t, teams.MoveMember(from, to, "u1"))
+		assert.Equal(t, []string{"u2"}, from.MemberIDs)
+		assert.Equal(t, []string{"u3", "u1"}, to.MemberIDs)
+	})
+
+	t.Run("same team", func(t *testing.T) {
+		team := &teams.Team{Name: "alpha", MemberIDs: []string{"u1"}}
+
+		assert.Equal(t, errorsx.ErrSameTeam, teams.MoveMember(team, team, "u1"))
+	})
+
+	t.Run("not a member of source team", func(t *testing.T) {
+		from := &teams.Team{Name: "alpha"}
+		to := &teams.Team{Name: "beta"}
+
+		assert.Equal(t, errorsx.ErrNotTeamMember, teams.MoveMember(from, to, "u1"))
+	})
+}
+
+func TestMoveAllMembers(t *testing.T) {
+	t.Run("move all members", func(t *testing.T) {
+		from := &teams.Team{Name: "alpha", MemberIDs: []string{"u1", "u2"}}
+		to := &teams.Team{Name: "beta", MemberIDs: []string{"u3"}}
+
+		require.NoError(t, teams.MoveAllMembers(from, to))
+		assert.Empty(t, from.MemberIDs)
+		assert.Equal(t, []string{"u3", "u1", "u2"}, to.MemberIDs)
+	})
+
+	t.Run("same team", func(t *testing.T) {
+		team := &teams.Team{Name: "alpha"}
+
+		assert.Equal(t, errorsx.ErrSameTeam, teams.MoveAllMembers(team, team))
+	})
+
+	t.Run("member of both teams", func(t *testing.T) {
+		from := &teams.Team{Name: "alpha", MemberIDs: []string{"u1", "u2"}}
+		to := &teams.Team{Name: "beta", MemberIDs: []string{"u1"}}
+
+		require.NoError(t, teams.MoveAllMembers(from, to))
+		assert.Empty(t, from.MemberIDs)
+		assert.Equal(t, []string{"u1", "u2"}, to.MemberIDs)
+	})
+}
+
+func TestTeam_Rename(t *testing.T) {
+	team := &teams.Team{Name: "alpha"}
+
+	assert.Equal(t, errorsx.ErrTeamName, team.Rename(" "))
+	assert.Equal(t, "alpha", team.Name)
+
+	require.NoError(t, team.Rename("beta"))
+	assert.Equal(t, "beta", team.Name)
+}
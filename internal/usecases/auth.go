@@ -0,0 +1,163 @@
+package usecases
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+)
+
+// BootstrapPrincipalName is the name of admin authenticated by the bootstrap token from config.
+const BootstrapPrincipalName = "bootstrap"
+
+type CreateTokenRequest struct {
+	Name     string
+	Role     string
+	TeamName string
+}
+
+type CreatedTokenView struct {
+	Name     string
+	Role     string
+	TeamName string
+	// Token is returned only once, the service keeps its hash.
+	Token string
+}
+
+type AuthService interface {
+	Authenticate(ctx context.Context, token string) (*auth.Principal, error)
+	CreateToken(ctx context.Context, req CreateTokenRequest) (*CreatedTokenView, error)
+	RevokeToken(ctx context.Context, name string) error
+}
+
+var _ AuthService = &AuthServiceImpl{}
+
+type AuthServiceImpl struct {
+	tokenRepo     tokenRepository
+	teamRepo      teamRepository
+	bootstrapHash string
+}
+
+// authorizeTeam checks that principal from the context may modify the team.
+// Operations without principal are performed by the service itself and are always allowed.
+func authorizeTeam(ctx context.Context, teamName string) error {
+	if p, ok := auth.PrincipalFromContext(ctx); ok && !p.CanManageTeam(teamName) {
+		return errorsx.ErrForbidden
+	}
+	return nil
+}
+
+// authorizeAdmin checks that principal from the context, if any, is an admin.
+func authorizeAdmin(ctx context.Context) error {
+	if p, ok := auth.PrincipalFromContext(ctx); ok && !p.HasRole(auth.RoleAdmin) {
+		return errorsx.ErrForbidden
+	}
+	return nil
+}
+
+// authorizeMembers checks that principal from the context may modify all the users,
+// which requires managing at least one team of every user.
+func authorizeMembers(ctx context.Context, teamRepo teamRepository, ids ...string) error {
+	p, ok := auth.PrincipalFromContext(ctx)
+	if !ok || p.HasRole(auth.RoleAdmin) {
+		return nil
+	}
+
+	for _, id := range ids {
+		userTeams, err := teamRepo.GetManyByMemberID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting teams of user %s: %w", id, err)
+		}
+
+		managed := slices.ContainsFunc(userTeams, func(t *teams.Team) bool {
+			return p.CanManageTeam(t.Name)
+		})
+		if !managed {
+			return errorsx.ErrForbidden
+		}
+	}
+
+	return nil
+}
+
+func (s *AuthServiceImpl) Authenticate(ctx context.Context, token string) (*auth.Principal, error) {
+	if token == "" {
+		return nil, errorsx.ErrUnauthenticated
+	}
+
+	hash := auth.HashToken(token)
+	if s.bootstrapHash != "" && subtle.ConstantTimeCompare([]byte(hash), []byte(s.bootstrapHash)) == 1 {
+		return &auth.Principal{Name: BootstrapPrincipalName, Role: auth.RoleAdmin}, nil
+	}
+
+	p, err := s.tokenRepo.GetByHash(ctx, hash)
+	if errors.Is(err, errorsx.ErrNotFound) {
+		return nil, errorsx.ErrUnauthenticated
+	}
+	if err != nil {
+		return nil, fmt.Errorf("retrieving token: %w", err)
+	}
+
+	return p, nil
+}
+
+func (s *AuthServiceImpl) CreateToken(ctx context.Context, req CreateTokenRequest) (*CreatedTokenView, error) {
+	if err := authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	p, err := auth.NewPrincipal(req.Name, auth.Role(req.Role), req.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.TeamName != "" {
+		if _, err := s.teamRepo.GetByName(ctx, p.TeamName); err != nil {
+			return nil, fmt.Errorf("retrieving team: %w", err)
+		}
+	}
+
+	token := auth.GenerateToken()
+	if err := s.tokenRepo.Create(ctx, auth.HashToken(token), p); err != nil {
+		return nil, fmt.Errorf("saving token: %w", err)
+	}
+
+	return &CreatedTokenView{
+		Name:     p.Name,
+		Role:     string(p.Role),
+		TeamName: p.TeamName,
+		Token:    token,
+	}, nil
+}
+
+func (s *AuthServiceImpl) RevokeToken(ctx context.Context, name string) error {
+	if err := authorizeAdmin(ctx); err != nil {
+		return err
+	}
+
+	if err := s.tokenRepo.Delete(ctx, name); err != nil {
+		return fmt.Errorf("deleting token: %w", err)
+	}
+
+	return nil
+}
+
+// NewAuthService creates service which additionally accepts bootstrapToken as an admin one.
+// Bootstrap token is meant for issuing the first tokens and is disabled when empty.
+func NewAuthService(tokenRepo tokenRepository, teamRepo teamRepository, bootstrapToken string) *AuthServiceImpl {
+	var bootstrapHash string
+	if bootstrapToken != "" {
+		bootstrapHash = auth.HashToken(bootstrapToken)
+	}
+
+	return &AuthServiceImpl{
+		tokenRepo:     tokenRepo,
+		teamRepo:      teamRepo,
+		bootstrapHash: bootstrapHash,
+	}
+}
@@ -0,0 +1,183 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+func setupAuthTest(t *testing.T) (*usecases.AuthServiceImpl, *mocks.MocktokenRepository, *mocks.MockteamRepository) {
+	ctrl := gomock.NewController(t)
+	tokenRepo := mocks.NewMocktokenRepository(ctrl)
+	teamRepo := mocks.NewMockteamRepository(ctrl)
+	return usecases.NewAuthService(tokenRepo, teamRepo, "bootstrap-secret"), tokenRepo, teamRepo
+}
+
+func withPrincipal(role auth.Role, teamName string) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{Name: "tester", Role: role, TeamName: teamName})
+}
+
+func TestAuthService_Authenticate(t *testing.T) {
+	service, tokenRepo, _ := setupAuthTest(t)
+	ctx := context.Background()
+
+	t.Run("stored token", func(t *testing.T) {
+		want := &auth.Principal{Name: "alice", Role: auth.RoleTeamLead, TeamName: "backend"}
+		tokenRepo.EXPECT().GetByHash(ctx, auth.HashToken("alice-token")).Return(want, nil)
+
+		p, err := service.Authenticate(ctx, "alice-token")
+
+		require.NoError(t, err)
+		assert.Equal(t, want, p)
+	})
+
+	t.Run("bootstrap token", func(t *testing.T) {
+		p, err := service.Authenticate(ctx, "bootstrap-secret")
+
+		require.NoError(t, err)
+		assert.Equal(t, usecases.BootstrapPrincipalName, p.Name)
+		assert.Equal(t, auth.RoleAdmin, p.Role)
+	})
+
+	t.Run("empty token", func(t *testing.T) {
+		_, err := service.Authenticate(ctx, "")
+
+		assert.ErrorIs(t, err, errorsx.ErrUnauthenticated)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		tokenRepo.EXPECT().GetByHash(ctx, gomock.Any()).Return(nil, errorsx.ErrNotFound)
+
+		_, err := service.Authenticate(ctx, "unknown")
+
+		assert.ErrorIs(t, err, errorsx.ErrUnauthenticated)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		tokenRepo.EXPECT().GetByHash(ctx, gomock.Any()).Return(nil, errors.New("db error"))
+
+		_, err := service.Authenticate(ctx, "any")
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, errorsx.ErrUnauthenticated)
+	})
+}
+
+func TestAuthService_CreateToken(t *testing.T) {
+	service, tokenRepo, teamRepo := setupAuthTest(t)
+	ctx := withPrincipal(auth.RoleAdmin, "")
+
+	t.Run("team lead token", func(t *testing.T) {
+		var storedHash string
+		teamRepo.EXPECT().GetByName(ctx, "backend").Return(&teams.Team{Name: "backend"}, nil)
+		tokenRepo.EXPECT().Create(ctx, gomock.Any(), &auth.Principal{Name: "alice", Role: auth.RoleTeamLead, TeamName: "backend"}).
+			DoAndReturn(func(_ context.Context, hash string, _ *auth.Principal) error {
+				storedHash = hash
+				return nil
+			})
+
+		res, err := service.CreateToken(ctx, usecases.CreateTokenRequest{Name: "alice", Role: "team_lead", TeamName: "backend"})
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, res.Token)
+		assert.Equal(t, auth.HashToken(res.Token), storedHash)
+	})
+
+	t.Run("unknown team", func(t *testing.T) {
+		teamRepo.EXPECT().GetByName(ctx, "unknown").Return(nil, errorsx.ErrNotFound)
+
+		_, err := service.CreateToken(ctx, usecases.CreateTokenRequest{Name: "bob", Role: "team_lead", TeamName: "unknown"})
+
+		assert.ErrorIs(t, err, errorsx.ErrNotFound)
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		_, err := service.CreateToken(ctx, usecases.CreateTokenRequest{Name: "bob", Role: "owner"})
+
+		assert.ErrorIs(t, err, errorsx.ErrUnknownRole)
+	})
+
+	t.Run("not an admin", func(t *testing.T) {
+		_, err := service.CreateToken(withPrincipal(auth.RoleTeamLead, "backend"), usecases.CreateTokenRequest{Name: "bob", Role: "admin"})
+
+		assert.ErrorIs(t, err, errorsx.ErrForbidden)
+	})
+}
+
+func TestAuthService_RevokeToken(t *testing.T) {
+	service, tokenRepo, _ := setupAuthTest(t)
+	ctx := withPrincipal(auth.RoleAdmin, "")
+
+	t.Run("ok", func(t *testing.T) {
+		tokenRepo.EXPECT().Delete(ctx, "alice").Return(nil)
+
+		assert.NoError(t, service.RevokeToken(ctx, "alice"))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		tokenRepo.EXPECT().Delete(ctx, "bob").Return(errorsx.ErrNotFound)
+
+		assert.ErrorIs(t, service.RevokeToken(ctx, "bob"), errorsx.ErrNotFound)
+	})
+}
+
+func TestTeamLeadScope(t *testing.T) {
+	ctx := withPrincipal(auth.RoleTeamLead, "backend")
+
+	t.Run("merge PR of another team", func(t *testing.T) {
+		service, _, prRepo, _ := setupPRTest(t)
+		prRepo.EXPECT().GetByID(ctx, "pr-1").Return(&prs.PullRequest{
+			ID:               "pr-1",
+			Status:           prs.StatusOpen,
+			OriginalTeamName: "frontend",
+		}, nil)
+
+		result, err := service.Merge(ctx, "pr-1")
+
+		assert.ErrorIs(t, err, errorsx.ErrForbidden)
+		assert.Nil(t, result)
+	})
+
+	t.Run("read-only principal creates PR", func(t *testing.T) {
+		service, _, _, teamRepo := setupPRTest(t)
+		teamRepo.EXPECT().GetManyByMemberID(gomock.Any(), "author-1").Return([]*teams.Team{{Name: "backend"}}, nil)
+
+		result, err := service.Create(withPrincipal(auth.RoleReadOnly, ""), usecases.CreateRequest{
+			ID: "pr-1", Name: "Fix", AuthorID: "author-1",
+		})
+
+		assert.ErrorIs(t, err, errorsx.ErrForbidden)
+		assert.Nil(t, result)
+	})
+
+	t.Run("set settings of another team", func(t *testing.T) {
+		service, _, _, _, _, _ := setupTeamSettingsTest(t)
+
+		result, err := service.SetSettings(ctx, usecases.TeamSettingsView{TeamName: "frontend", Strategy: "random"})
+
+		assert.ErrorIs(t, err, errorsx.ErrForbidden)
+		assert.Nil(t, result)
+	})
+
+	t.Run("deactivate member of another team", func(t *testing.T) {
+		service, teamRepo, _, _, _, _, _ := setupUserTest(t)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, "u1").Return([]*teams.Team{{Name: "backend"}}, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, "u2").Return([]*teams.Team{{Name: "frontend"}}, nil)
+
+		result, err := service.Deactivate(ctx, "u1", "u2")
+
+		assert.ErrorIs(t, err, errorsx.ErrForbidden)
+		assert.Nil(t, result)
+	})
+}
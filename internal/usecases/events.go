@@ -0,0 +1,127 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+)
+
+// streamBufferSize is a number of events buffered for a subscriber, subscribers falling further behind are dropped.
+const streamBufferSize = 64
+
+// EventStreamFilter selects events concerning the user and the team, empty fields match any event.
+type EventStreamFilter struct {
+	UserID   string
+	TeamName string
+}
+
+// matches compares the user with the reviewer, the author of merged PR and the deactivated user.
+func (f EventStreamFilter) matches(e webhooks.Event) bool {
+	if f.UserID != "" && !slices.Contains([]string{e.ReviewerID, e.AuthorID, e.UserID}, f.UserID) {
+		return false
+	}
+	if f.TeamName != "" && !slices.Contains(e.TeamNames, f.TeamName) {
+		return false
+	}
+	return true
+}
+
+type EventStreamService interface {
+	// Subscribe streams committed events matching the filter until ctx is done.
+	// The channel is also closed when the subscriber falls behind or events may have been missed,
+	// clients are expected to resubscribe and refresh their state then.
+	Subscribe(ctx context.Context, filter EventStreamFilter) (<-chan webhooks.Event, error)
+	// Listen receives events committed by all replicas and fans them out to subscribers.
+	// It blocks until ctx is done or listening fails and is meant to be restarted after failures.
+	Listen(ctx context.Context) error
+}
+
+var _ EventStreamService = &EventStreamServiceImpl{}
+
+type EventStreamServiceImpl struct {
+	listener eventListener
+	userRepo userRepository
+	teamRepo teamRepository
+
+	mu          sync.Mutex
+	subscribers map[chan webhooks.Event]EventStreamFilter
+}
+
+func (s *EventStreamServiceImpl) Subscribe(ctx context.Context, filter EventStreamFilter) (<-chan webhooks.Event, error) {
+	if filter.UserID != "" {
+		if _, err := s.userRepo.Get(ctx, filter.UserID); err != nil {
+			return nil, fmt.Errorf("retrieving user: %w", err)
+		}
+	}
+	if filter.TeamName != "" {
+		if _, err := s.teamRepo.GetByName(ctx, filter.TeamName); err != nil {
+			return nil, fmt.Errorf("retrieving team: %w", err)
+		}
+	}
+
+	events := make(chan webhooks.Event, streamBufferSize)
+
+	s.mu.Lock()
+	s.subscribers[events] = filter
+	s.mu.Unlock()
+
+	context.AfterFunc(ctx, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.unsubscribe(events)
+	})
+
+	return events, nil
+}
+
+// Listen drops all the subscribers once it returns, as events committed until it is restarted are missed.
+func (s *EventStreamServiceImpl) Listen(ctx context.Context) error {
+	defer func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for events := range s.subscribers {
+			s.unsubscribe(events)
+		}
+	}()
+
+	return s.listener.Listen(ctx, s.publish)
+}
+
+func (s *EventStreamServiceImpl) publish(e webhooks.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for events, filter := range s.subscribers {
+		if !filter.matches(e) {
+			continue
+		}
+
+		select {
+		case events <- e:
+		default:
+			s.unsubscribe(events)
+		}
+	}
+}
+
+// unsubscribe closes the subscriber's channel unless it is already closed.
+//
+// WARN: must be called with s.mu held.
+func (s *EventStreamServiceImpl) unsubscribe(events chan webhooks.Event) {
+	if _, ok := s.subscribers[events]; ok {
+		delete(s.subscribers, events)
+		close(events)
+	}
+}
+
+func NewEventStreamService(listener eventListener, userRepo userRepository, teamRepo teamRepository) *EventStreamServiceImpl {
+	return &EventStreamServiceImpl{
+		listener:    listener,
+		userRepo:    userRepo,
+		teamRepo:    teamRepo,
+		subscribers: make(map[chan webhooks.Event]EventStreamFilter),
+	}
+}
@@ -0,0 +1,132 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+func setupEventStreamTest(t *testing.T) (*usecases.EventStreamServiceImpl, *mocks.MockeventListener, *mocks.MockuserRepository, *mocks.MockteamRepository) {
+	ctrl := gomock.NewController(t)
+
+	listener := mocks.NewMockeventListener(ctrl)
+	userRepo := mocks.NewMockuserRepository(ctrl)
+	teamRepo := mocks.NewMockteamRepository(ctrl)
+
+	return usecases.NewEventStreamService(listener, userRepo, teamRepo), listener, userRepo, teamRepo
+}
+
+// expectListen makes the listener deliver events and return, which closes all the subscriptions.
+func expectListen(listener *mocks.MockeventListener, events ...webhooks.Event) {
+	listener.EXPECT().Listen(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, handle func(webhooks.Event)) error {
+		for _, e := range events {
+			handle(e)
+		}
+		return nil
+	})
+}
+
+func drain(events <-chan webhooks.Event) []webhooks.Event {
+	var res []webhooks.Event
+	for e := range events {
+		res = append(res, e)
+	}
+	return res
+}
+
+func TestEventStreamService_Subscribe(t *testing.T) {
+	assigned := webhooks.Event{Type: webhooks.EventReviewerAssigned, ReviewerID: "u2", TeamNames: []string{"backend"}}
+	merged := webhooks.Event{Type: webhooks.EventPullRequestMerged, AuthorID: "u1", TeamNames: []string{"backend"}}
+	deactivated := webhooks.Event{Type: webhooks.EventUserDeactivated, UserID: "u3", TeamNames: []string{"frontend"}}
+
+	t.Run("filters by user", func(t *testing.T) {
+		service, listener, userRepo, _ := setupEventStreamTest(t)
+		ctx := context.Background()
+
+		userRepo.EXPECT().Get(ctx, "u1").Return(&users.User{ID: "u1"}, nil)
+		expectListen(listener, assigned, merged, deactivated)
+
+		events, err := service.Subscribe(ctx, usecases.EventStreamFilter{UserID: "u1"})
+		require.NoError(t, err)
+		require.NoError(t, service.Listen(ctx))
+
+		assert.Equal(t, []webhooks.Event{merged}, drain(events))
+	})
+
+	t.Run("filters by team", func(t *testing.T) {
+		service, listener, _, teamRepo := setupEventStreamTest(t)
+		ctx := context.Background()
+
+		teamRepo.EXPECT().GetByName(ctx, "backend").Return(&teams.Team{Name: "backend"}, nil)
+		expectListen(listener, assigned, merged, deactivated)
+
+		events, err := service.Subscribe(ctx, usecases.EventStreamFilter{TeamName: "backend"})
+		require.NoError(t, err)
+		require.NoError(t, service.Listen(ctx))
+
+		assert.Equal(t, []webhooks.Event{assigned, merged}, drain(events))
+	})
+
+	t.Run("empty filter matches every event", func(t *testing.T) {
+		service, listener, _, _ := setupEventStreamTest(t)
+		ctx := context.Background()
+
+		expectListen(listener, assigned, merged, deactivated)
+
+		events, err := service.Subscribe(ctx, usecases.EventStreamFilter{})
+		require.NoError(t, err)
+		require.NoError(t, service.Listen(ctx))
+
+		assert.Equal(t, []webhooks.Event{assigned, merged, deactivated}, drain(events))
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		service, _, userRepo, _ := setupEventStreamTest(t)
+		ctx := context.Background()
+
+		userRepo.EXPECT().Get(ctx, "ghost").Return(nil, errorsx.ErrNotFound)
+
+		_, err := service.Subscribe(ctx, usecases.EventStreamFilter{UserID: "ghost"})
+
+		assert.ErrorIs(t, err, errorsx.ErrNotFound)
+	})
+
+	t.Run("slow subscriber is dropped", func(t *testing.T) {
+		service, listener, _, _ := setupEventStreamTest(t)
+		ctx := context.Background()
+
+		burst := make([]webhooks.Event, 100)
+		for i := range burst {
+			burst[i] = assigned
+		}
+		expectListen(listener, burst...)
+
+		events, err := service.Subscribe(ctx, usecases.EventStreamFilter{})
+		require.NoError(t, err)
+		require.NoError(t, service.Listen(ctx))
+
+		assert.Len(t, drain(events), 64)
+	})
+
+	t.Run("cancelled subscription is closed", func(t *testing.T) {
+		service, _, _, _ := setupEventStreamTest(t)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		events, err := service.Subscribe(ctx, usecases.EventStreamFilter{})
+		require.NoError(t, err)
+
+		cancel()
+
+		assert.Empty(t, drain(events))
+	})
+}
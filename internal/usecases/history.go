@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+)
+
+// SystemActor is recorded as an actor of changes which were not requested on behalf of anyone.
+const SystemActor = "system"
+
+type actorKey struct{}
+
+// WithActor returns context carrying identity of whoever performs the operation.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns actor stored by WithActor or SystemActor.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return SystemActor
+}
+
+type AssignmentEventView struct {
+	ReviewerID string
+	Action     string
+	Reason     string
+	Actor      string
+	At         time.Time
+}
+
+// recordAssignments appends changes between reviewer lists before and after to the assignment history
+// and enqueues them for webhook subscribers.
+//
+// WARN: must be called within the same transaction as the PR save.
+func recordAssignments(
+	ctx context.Context,
+	historyRepo historyRepository,
+	outboxRepo outboxRepository,
+	pr *prs.PullRequest,
+	before, after []string,
+	reason prs.AssignmentReason,
+) error {
+	events := prs.DiffAssignments(pr.ID, before, after, reason, ActorFromContext(ctx), time.Now())
+	if len(events) == 0 {
+		return nil
+	}
+
+	if err := historyRepo.Append(ctx, events...); err != nil {
+		return err
+	}
+
+	if err := outboxRepo.Enqueue(ctx, webhooks.EventsFromAssignments(pr, events)...); err != nil {
+		return fmt.Errorf("enqueueing webhook events: %w", err)
+	}
+
+	return nil
+}
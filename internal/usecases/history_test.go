@@ -0,0 +1,168 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+type historyTestMocks struct {
+	rpicker     *mocks.MockReviewerPicker
+	prRepo      *mocks.MockprRepository
+	teamRepo    *mocks.MockteamRepository
+	userRepo    *mocks.MockuserRepository
+	historyRepo *mocks.MockhistoryRepository
+}
+
+func setupHistoryTest(t *testing.T) (*usecases.PullRequestServiceImpl, historyTestMocks) {
+	ctrl := gomock.NewController(t)
+
+	m := historyTestMocks{
+		rpicker:     mocks.NewMockReviewerPicker(ctrl),
+		prRepo:      mocks.NewMockprRepository(ctrl),
+		teamRepo:    mocks.NewMockteamRepository(ctrl),
+		userRepo:    mocks.NewMockuserRepository(ctrl),
+		historyRepo: mocks.NewMockhistoryRepository(ctrl),
+	}
+
+	settingsRepo := mocks.NewMockteamSettingsRepository(ctrl)
+	settingsRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errorsx.ErrNotFound).AnyTimes()
+
+	service := usecases.NewPullRequestService(setupNoopTx(ctrl), m.rpicker, m.prRepo, m.teamRepo, m.userRepo, settingsRepo, m.historyRepo, setupNoopOutbox(ctrl))
+
+	return service, m
+}
+
+// expectEvents captures appended events, ignoring their time.
+func expectEvents(historyRepo *mocks.MockhistoryRepository, want ...prs.AssignmentEvent) {
+	historyRepo.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, got ...prs.AssignmentEvent) error {
+		for i := range got {
+			got[i].At = time.Time{}
+		}
+		if !assert.ObjectsAreEqual(want, got) {
+			return errors.New("unexpected events")
+		}
+		return nil
+	})
+}
+
+func TestActorFromContext(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Equal(t, usecases.SystemActor, usecases.ActorFromContext(ctx))
+	assert.Equal(t, "admin", usecases.ActorFromContext(usecases.WithActor(ctx, "admin")))
+}
+
+func TestPullRequestService_History_Reassign(t *testing.T) {
+	service, m := setupHistoryTest(t)
+	ctx := usecases.WithActor(context.Background(), "lead-1")
+
+	team := &teams.Team{Name: "team-alpha", MemberIDs: []string{"author-1", "u1", "u2", "u3"}}
+	pr := &prs.PullRequest{
+		ID:               "pr-1",
+		Status:           prs.StatusOpen,
+		OriginalTeamName: team.Name,
+		AuthorID:         "author-1",
+		ReviewerIDs:      []string{"u1", "u2"},
+		ReviewersCount:   2,
+	}
+
+	m.prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+	m.teamRepo.EXPECT().GetByName(ctx, team.Name).Return(team, nil)
+	m.rpicker.EXPECT().PickReviewersFromTeam(ctx, gomock.Any()).Return([]string{"u3"}, nil)
+	m.prRepo.EXPECT().Save(ctx, pr).Return(nil)
+	expectEvents(m.historyRepo,
+		prs.AssignmentEvent{PullRequestID: "pr-1", ReviewerID: "u1", Action: prs.ActionUnassigned, Reason: prs.ReasonManualReassign, Actor: "lead-1"},
+		prs.AssignmentEvent{PullRequestID: "pr-1", ReviewerID: "u3", Action: prs.ActionAssigned, Reason: prs.ReasonManualReassign, Actor: "lead-1"},
+	)
+
+	_, err := service.ReassignReviewer(ctx, usecases.ReassignReviewerRequest{
+		PullRequestID:    pr.ID,
+		UserIDToReassign: "u1",
+	})
+
+	require.NoError(t, err)
+}
+
+func TestPullRequestService_History_Merge(t *testing.T) {
+	service, m := setupHistoryTest(t)
+	ctx := context.Background()
+
+	t.Run("merge ends all assignments", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:             "pr-1",
+			Status:         prs.StatusOpen,
+			ReviewerIDs:    []string{"u1", "u2"},
+			ReviewersCount: 2,
+		}
+
+		m.prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+		m.prRepo.EXPECT().Save(ctx, pr).Return(nil)
+		expectEvents(m.historyRepo,
+			prs.AssignmentEvent{PullRequestID: "pr-1", ReviewerID: "u1", Action: prs.ActionUnassigned, Reason: prs.ReasonMerge, Actor: usecases.SystemActor},
+			prs.AssignmentEvent{PullRequestID: "pr-1", ReviewerID: "u2", Action: prs.ActionUnassigned, Reason: prs.ReasonMerge, Actor: usecases.SystemActor},
+		)
+
+		_, err := service.Merge(ctx, pr.ID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("repeated merge records nothing", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:             "pr-2",
+			Status:         prs.StatusMerged,
+			ReviewerIDs:    []string{"u1"},
+			ReviewersCount: 2,
+			MergedAt:       time.Now(),
+		}
+
+		m.prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+		m.prRepo.EXPECT().Save(ctx, pr).Return(nil)
+
+		_, err := service.Merge(ctx, pr.ID)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestPullRequestService_GetHistory(t *testing.T) {
+	service, m := setupHistoryTest(t)
+	ctx := context.Background()
+
+	t.Run("returns recorded events", func(t *testing.T) {
+		at := time.Now()
+
+		m.prRepo.EXPECT().GetByID(ctx, "pr-1").Return(&prs.PullRequest{ID: "pr-1"}, nil)
+		m.historyRepo.EXPECT().GetByPullRequestID(ctx, "pr-1").Return([]prs.AssignmentEvent{
+			{PullRequestID: "pr-1", ReviewerID: "u1", Action: prs.ActionAssigned, Reason: prs.ReasonInitialPick, Actor: "system", At: at},
+		}, nil)
+
+		result, err := service.GetHistory(ctx, "pr-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, []usecases.AssignmentEventView{
+			{ReviewerID: "u1", Action: "ASSIGNED", Reason: "INITIAL_PICK", Actor: "system", At: at},
+		}, result)
+	})
+
+	t.Run("pr not found", func(t *testing.T) {
+		m.prRepo.EXPECT().GetByID(ctx, "pr-404").Return(nil, errorsx.ErrNotFound)
+
+		result, err := service.GetHistory(ctx, "pr-404")
+
+		assert.ErrorIs(t, err, errorsx.ErrNotFound)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,177 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/integrations"
+)
+
+type GitAccountView struct {
+	Host   string
+	Login  string
+	UserID string
+}
+
+type LinkGitAccountRequest struct {
+	Host   string
+	Login  string
+	UserID string
+}
+
+type IntegrationService interface {
+	// LinkAccount maps the login on the Git host to the user, replacing previous mapping of the login.
+	LinkAccount(ctx context.Context, req LinkGitAccountRequest) (*GitAccountView, error)
+	UnlinkAccount(ctx context.Context, host, login string) error
+	// HandlePullRequestEvent mirrors the event received from the Git host onto the service PR.
+	// It reports false without doing anything for redelivered events.
+	HandlePullRequestEvent(ctx context.Context, e *integrations.PullRequestEvent) (bool, error)
+}
+
+var _ IntegrationService = &IntegrationServiceImpl{}
+
+type IntegrationServiceImpl struct {
+	txManager    TxManager
+	accountRepo  gitAccountRepository
+	deliveryRepo integrationDeliveryRepository
+	userRepo     userRepository
+	teamRepo     teamRepository
+	prService    PullRequestService
+}
+
+func gitAccountIntoView(a *integrations.Account) *GitAccountView {
+	return &GitAccountView{
+		Host:   string(a.Host),
+		Login:  a.Login,
+		UserID: a.UserID,
+	}
+}
+
+func (s *IntegrationServiceImpl) LinkAccount(ctx context.Context, req LinkGitAccountRequest) (*GitAccountView, error) {
+	account, err := integrations.NewAccount(integrations.Host(req.Host), req.Login, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.userRepo.Get(ctx, req.UserID); err != nil {
+		return nil, fmt.Errorf("retrieving user: %w", err)
+	}
+
+	// relinking takes the login away from its current user, who must be manageable as well
+	affectedIDs := []string{req.UserID}
+	current, err := s.accountRepo.Get(ctx, account.Host, account.Login)
+	switch {
+	case err == nil:
+		affectedIDs = append(affectedIDs, current.UserID)
+	case !errors.Is(err, errorsx.ErrNotFound):
+		return nil, fmt.Errorf("retrieving account: %w", err)
+	}
+
+	if err := authorizeMembers(ctx, s.teamRepo, affectedIDs...); err != nil {
+		return nil, err
+	}
+
+	if err := s.accountRepo.Save(ctx, account); err != nil {
+		return nil, fmt.Errorf("saving account: %w", err)
+	}
+
+	return gitAccountIntoView(account), nil
+}
+
+func (s *IntegrationServiceImpl) UnlinkAccount(ctx context.Context, host, login string) error {
+	account, err := s.accountRepo.Get(ctx, integrations.Host(host), login)
+	if err != nil {
+		return fmt.Errorf("retrieving account: %w", err)
+	}
+
+	if err := authorizeMembers(ctx, s.teamRepo, account.UserID); err != nil {
+		return err
+	}
+
+	if err := s.accountRepo.Delete(ctx, account.Host, account.Login); err != nil {
+		return fmt.Errorf("deleting account: %w", err)
+	}
+
+	return nil
+}
+
+// HandlePullRequestEvent claims the delivery and applies the event in the same transaction,
+// so concurrent redeliveries are applied once and the delivery is not recorded if the event could not be applied.
+func (s *IntegrationServiceImpl) HandlePullRequestEvent(ctx context.Context, e *integrations.PullRequestEvent) (bool, error) {
+	ctx, txHandle, err := s.txManager.WithTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer txHandle.Rollback(ctx)
+
+	claimed, err := s.deliveryRepo.Claim(ctx, e.Host, e.DeliveryID)
+	if err != nil {
+		return false, fmt.Errorf("claiming delivery: %w", err)
+	}
+	if !claimed {
+		return false, nil
+	}
+
+	if err := s.apply(WithActor(ctx, string(e.Host)), e); err != nil {
+		return false, err
+	}
+
+	if err := txHandle.Commit(ctx); err != nil {
+		return false, fmt.Errorf("commiting tx: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *IntegrationServiceImpl) apply(ctx context.Context, e *integrations.PullRequestEvent) error {
+	id := e.PullRequestID()
+
+	var err error
+	switch e.Action {
+	case integrations.ActionOpened:
+		account, accountErr := s.accountRepo.Get(ctx, e.Host, e.AuthorLogin)
+		if errors.Is(accountErr, errorsx.ErrNotFound) {
+			return errorsx.ErrGitAccountNotLinked
+		}
+		if accountErr != nil {
+			return fmt.Errorf("retrieving author account: %w", accountErr)
+		}
+
+		_, err = s.prService.Create(ctx, CreateRequest{
+			ID:       id,
+			AuthorID: account.UserID,
+			Name:     e.Title,
+		})
+	case integrations.ActionMerged:
+		// the host has already merged the PR, so it is recorded regardless of the team's merge policy
+		_, err = s.prService.RecordMerged(ctx, id)
+	case integrations.ActionClosed:
+		_, err = s.prService.Close(ctx, id)
+	case integrations.ActionReopened:
+		_, err = s.prService.Reopen(ctx, id)
+	default:
+		return fmt.Errorf("unknown action %s", e.Action)
+	}
+
+	return err
+}
+
+func NewIntegrationService(
+	txManager TxManager,
+	accountRepo gitAccountRepository,
+	deliveryRepo integrationDeliveryRepository,
+	userRepo userRepository,
+	teamRepo teamRepository,
+	prService PullRequestService,
+) *IntegrationServiceImpl {
+	return &IntegrationServiceImpl{
+		txManager:    txManager,
+		accountRepo:  accountRepo,
+		deliveryRepo: deliveryRepo,
+		userRepo:     userRepo,
+		teamRepo:     teamRepo,
+		prService:    prService,
+	}
+}
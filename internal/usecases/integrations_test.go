@@ -0,0 +1,222 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/integrations"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+type integrationTestMocks struct {
+	txHandle     *mocks.MockTxHandle
+	accountRepo  *mocks.MockgitAccountRepository
+	deliveryRepo *mocks.MockintegrationDeliveryRepository
+	userRepo     *mocks.MockuserRepository
+	teamRepo     *mocks.MockteamRepository
+	prService    *mocks.MockPullRequestService
+}
+
+func setupIntegrationTest(t *testing.T) (*usecases.IntegrationServiceImpl, integrationTestMocks) {
+	ctrl := gomock.NewController(t)
+	txManager := mocks.NewMockTxManager(ctrl)
+	m := integrationTestMocks{
+		txHandle:     mocks.NewMockTxHandle(ctrl),
+		accountRepo:  mocks.NewMockgitAccountRepository(ctrl),
+		deliveryRepo: mocks.NewMockintegrationDeliveryRepository(ctrl),
+		userRepo:     mocks.NewMockuserRepository(ctrl),
+		teamRepo:     mocks.NewMockteamRepository(ctrl),
+		prService:    mocks.NewMockPullRequestService(ctrl),
+	}
+
+	txManager.EXPECT().WithTx(gomock.Any()).DoAndReturn(func(ctx context.Context) (context.Context, usecases.TxHandle, error) {
+		return ctx, m.txHandle, nil
+	}).AnyTimes()
+	m.txHandle.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+
+	service := usecases.NewIntegrationService(txManager, m.accountRepo, m.deliveryRepo, m.userRepo, m.teamRepo, m.prService)
+
+	return service, m
+}
+
+func TestIntegrationService_LinkAccount(t *testing.T) {
+	req := usecases.LinkGitAccountRequest{Host: "github", Login: "octocat", UserID: "u1"}
+	user := &users.User{ID: "u1", Name: "Alice", Active: true}
+
+	t.Run("links account", func(t *testing.T) {
+		service, m := setupIntegrationTest(t)
+		ctx := context.Background()
+
+		m.userRepo.EXPECT().Get(ctx, "u1").Return(user, nil)
+		m.accountRepo.EXPECT().Get(ctx, integrations.HostGitHub, "octocat").Return(nil, errorsx.ErrNotFound)
+		m.accountRepo.EXPECT().Save(ctx, &integrations.Account{Host: integrations.HostGitHub, Login: "octocat", UserID: "u1"}).Return(nil)
+
+		res, err := service.LinkAccount(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, &usecases.GitAccountView{Host: "github", Login: "octocat", UserID: "u1"}, res)
+	})
+
+	t.Run("team lead can not take login of other team's member", func(t *testing.T) {
+		service, m := setupIntegrationTest(t)
+		ctx := withPrincipal(auth.RoleTeamLead, "backend")
+
+		m.userRepo.EXPECT().Get(ctx, "u1").Return(user, nil)
+		m.accountRepo.EXPECT().Get(ctx, integrations.HostGitHub, "octocat").
+			Return(&integrations.Account{Host: integrations.HostGitHub, Login: "octocat", UserID: "u9"}, nil)
+		m.teamRepo.EXPECT().GetManyByMemberID(ctx, "u1").Return([]*teams.Team{{Name: "backend"}}, nil)
+		m.teamRepo.EXPECT().GetManyByMemberID(ctx, "u9").Return([]*teams.Team{{Name: "frontend"}}, nil)
+
+		res, err := service.LinkAccount(ctx, req)
+
+		assert.ErrorIs(t, err, errorsx.ErrForbidden)
+		assert.Nil(t, res)
+	})
+
+	t.Run("unknown host", func(t *testing.T) {
+		service, _ := setupIntegrationTest(t)
+		req := req
+		req.Host = "bitbucket"
+
+		_, err := service.LinkAccount(context.Background(), req)
+
+		assert.ErrorIs(t, err, errorsx.ErrGitHost)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		service, m := setupIntegrationTest(t)
+		ctx := context.Background()
+
+		m.userRepo.EXPECT().Get(ctx, "u1").Return(nil, errorsx.ErrNotFound)
+
+		_, err := service.LinkAccount(ctx, req)
+
+		assert.ErrorIs(t, err, errorsx.ErrNotFound)
+	})
+}
+
+func TestIntegrationService_HandlePullRequestEvent(t *testing.T) {
+	event := func(action integrations.Action) *integrations.PullRequestEvent {
+		return &integrations.PullRequestEvent{
+			Host:        integrations.HostGitHub,
+			DeliveryID:  "d-1",
+			Action:      action,
+			Repository:  "acme/api",
+			Number:      12,
+			Title:       "Add search",
+			AuthorLogin: "octocat",
+		}
+	}
+	const prID = "github:acme/api#12"
+	pr := &prs.PullRequestView{ID: prID}
+
+	t.Run("opened creates PR authored by linked user", func(t *testing.T) {
+		service, m := setupIntegrationTest(t)
+		ctx := context.Background()
+
+		m.deliveryRepo.EXPECT().Claim(ctx, integrations.HostGitHub, "d-1").Return(true, nil)
+		m.accountRepo.EXPECT().Get(gomock.Any(), integrations.HostGitHub, "octocat").
+			Return(&integrations.Account{Host: integrations.HostGitHub, Login: "octocat", UserID: "u1"}, nil)
+		m.prService.EXPECT().Create(gomock.Any(), usecases.CreateRequest{ID: prID, AuthorID: "u1", Name: "Add search"}).
+			DoAndReturn(func(ctx context.Context, _ usecases.CreateRequest) (*prs.PullRequestView, error) {
+				assert.Equal(t, "github", usecases.ActorFromContext(ctx))
+				return pr, nil
+			})
+		m.txHandle.EXPECT().Commit(ctx).Return(nil)
+
+		applied, err := service.HandlePullRequestEvent(ctx, event(integrations.ActionOpened))
+
+		require.NoError(t, err)
+		assert.True(t, applied)
+	})
+
+	t.Run("state changes", func(t *testing.T) {
+		cases := map[integrations.Action]func(m integrationTestMocks){
+			integrations.ActionMerged: func(m integrationTestMocks) {
+				m.prService.EXPECT().RecordMerged(gomock.Any(), prID).Return(pr, nil)
+			},
+			integrations.ActionClosed: func(m integrationTestMocks) {
+				m.prService.EXPECT().Close(gomock.Any(), prID).Return(pr, nil)
+			},
+			integrations.ActionReopened: func(m integrationTestMocks) {
+				m.prService.EXPECT().Reopen(gomock.Any(), prID).Return(pr, nil)
+			},
+		}
+		for action, expect := range cases {
+			service, m := setupIntegrationTest(t)
+			ctx := context.Background()
+
+			m.deliveryRepo.EXPECT().Claim(ctx, integrations.HostGitHub, "d-1").Return(true, nil)
+			expect(m)
+			m.txHandle.EXPECT().Commit(ctx).Return(nil)
+
+			applied, err := service.HandlePullRequestEvent(ctx, event(action))
+
+			require.NoError(t, err, action)
+			assert.True(t, applied, action)
+		}
+	})
+
+	t.Run("redelivery is ignored", func(t *testing.T) {
+		service, m := setupIntegrationTest(t)
+		ctx := context.Background()
+
+		m.deliveryRepo.EXPECT().Claim(ctx, integrations.HostGitHub, "d-1").Return(false, nil)
+
+		applied, err := service.HandlePullRequestEvent(ctx, event(integrations.ActionMerged))
+
+		require.NoError(t, err)
+		assert.False(t, applied)
+	})
+
+	t.Run("unlinked author does not record delivery", func(t *testing.T) {
+		service, m := setupIntegrationTest(t)
+		ctx := context.Background()
+
+		m.deliveryRepo.EXPECT().Claim(ctx, integrations.HostGitHub, "d-1").Return(true, nil)
+		m.accountRepo.EXPECT().Get(gomock.Any(), integrations.HostGitHub, "octocat").Return(nil, errorsx.ErrNotFound)
+
+		applied, err := service.HandlePullRequestEvent(ctx, event(integrations.ActionOpened))
+
+		assert.ErrorIs(t, err, errorsx.ErrGitAccountNotLinked)
+		assert.False(t, applied)
+	})
+
+	t.Run("failed merge does not record delivery", func(t *testing.T) {
+		service, m := setupIntegrationTest(t)
+		ctx := context.Background()
+
+		m.deliveryRepo.EXPECT().Claim(ctx, integrations.HostGitHub, "d-1").Return(true, nil)
+		m.prService.EXPECT().RecordMerged(gomock.Any(), prID).Return(nil, errorsx.ErrNotFound)
+
+		applied, err := service.HandlePullRequestEvent(ctx, event(integrations.ActionMerged))
+
+		assert.ErrorIs(t, err, errorsx.ErrNotFound)
+		assert.False(t, applied)
+	})
+
+	t.Run("failed commit is reported", func(t *testing.T) {
+		service, m := setupIntegrationTest(t)
+		ctx := context.Background()
+
+		m.deliveryRepo.EXPECT().Claim(ctx, integrations.HostGitHub, "d-1").Return(true, nil)
+		m.prService.EXPECT().RecordMerged(gomock.Any(), prID).Return(pr, nil)
+		m.txHandle.EXPECT().Commit(ctx).Return(errors.New("db error"))
+
+		applied, err := service.HandlePullRequestEvent(ctx, event(integrations.ActionMerged))
+
+		assert.ErrorContains(t, err, "db error")
+		assert.False(t, applied)
+	})
+}
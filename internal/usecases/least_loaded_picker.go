@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+)
+
+var _ ReviewerPicker = &LeastLoadedReviewerPicker{}
+
+// LeastLoadedReviewerPicker picks active candidates with the least amount of open review assignments.
+// Candidates with equal load are picked in random order.
+type LeastLoadedReviewerPicker struct {
+	userRepo userRepository
+	prRepo   prRepository
+}
+
+func (p *LeastLoadedReviewerPicker) PickReviewersFromTeam(ctx context.Context, req PickReviewersRequest) ([]string, error) {
+	active, err := activeCandidates(ctx, p.userRepo, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(active))
+	for i, u := range active {
+		ids[i] = u.ID
+	}
+
+	load, err := p.prRepo.CountOpenReviews(ctx, ids...)
+	if err != nil {
+		return nil, fmt.Errorf("counting open reviews: %w", err)
+	}
+
+	// shuffling before the stable sort breaks ties randomly
+	rand.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+	slices.SortStableFunc(ids, func(a, b string) int {
+		return cmp.Compare(load[a], load[b])
+	})
+
+	return ids[:minInt(req.WantCount, len(ids))], nil
+}
+
+func NewLeastLoadedReviewerPicker(userRepo userRepository, prRepo prRepository) *LeastLoadedReviewerPicker {
+	return &LeastLoadedReviewerPicker{
+		userRepo: userRepo,
+		prRepo:   prRepo,
+	}
+}
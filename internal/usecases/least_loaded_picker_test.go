@@ -0,0 +1,124 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+func setupLeastLoadedPickerTest(t *testing.T) (*usecases.LeastLoadedReviewerPicker, *mocks.MockuserRepository, *mocks.MockprRepository) {
+	ctrl := gomock.NewController(t)
+	userRepo := mocks.NewMockuserRepository(ctrl)
+	prRepo := mocks.NewMockprRepository(ctrl)
+	picker := usecases.NewLeastLoadedReviewerPicker(userRepo, prRepo)
+	return picker, userRepo, prRepo
+}
+
+func TestLeastLoadedReviewerPicker_PickReviewersFromTeam(t *testing.T) {
+	picker, userRepo, prRepo := setupLeastLoadedPickerTest(t)
+	ctx := context.Background()
+
+	t.Run("picks least loaded active reviewers", func(t *testing.T) {
+		req := usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"author"},
+			Team: teams.Team{
+				Name:      "test-team",
+				MemberIDs: []string{"author", "user1", "user2", "user3", "user4"},
+			},
+			WantCount: 2,
+		}
+
+		userRepo.EXPECT().GetMany(ctx, "user1", "user2", "user3", "user4").Return([]*users.User{
+			{ID: "user1", Name: "User 1", Active: true},
+			{ID: "user2", Name: "User 2", Active: true},
+			{ID: "user3", Name: "User 3", Active: true},
+			{ID: "user4", Name: "User 4", Active: false},
+		}, nil)
+		prRepo.EXPECT().CountOpenReviews(ctx, "user1", "user2", "user3").Return(map[string]int{
+			"user1": 5,
+			"user2": 1,
+		}, nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user3", "user2"}, result)
+	})
+
+	t.Run("breaks ties between equally loaded reviewers", func(t *testing.T) {
+		req := usecases.PickReviewersRequest{
+			Team: teams.Team{
+				Name:      "test-team",
+				MemberIDs: []string{"user1", "user2", "user3"},
+			},
+			WantCount: 1,
+		}
+
+		userRepo.EXPECT().GetMany(ctx, "user1", "user2", "user3").Return([]*users.User{
+			{ID: "user1", Name: "User 1", Active: true},
+			{ID: "user2", Name: "User 2", Active: true},
+			{ID: "user3", Name: "User 3", Active: true},
+		}, nil)
+		prRepo.EXPECT().CountOpenReviews(ctx, "user1", "user2", "user3").Return(map[string]int{
+			"user1": 3,
+			"user2": 1,
+			"user3": 1,
+		}, nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		require.NoError(t, err)
+		assertPickedReviewers(t, result, 1, []string{"user2", "user3"})
+	})
+
+	t.Run("returns error when there are no active candidates", func(t *testing.T) {
+		req := usecases.PickReviewersRequest{
+			Team: teams.Team{
+				Name:      "test-team",
+				MemberIDs: []string{"user1"},
+			},
+			WantCount: 1,
+		}
+
+		userRepo.EXPECT().GetMany(ctx, "user1").Return([]*users.User{
+			{ID: "user1", Name: "User 1", Active: false},
+		}, nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		assert.Equal(t, errorsx.ErrNoCandidate, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("propagates repository error when counting reviews fails", func(t *testing.T) {
+		req := usecases.PickReviewersRequest{
+			Team: teams.Team{
+				Name:      "test-team",
+				MemberIDs: []string{"user1"},
+			},
+			WantCount: 1,
+		}
+
+		expectedErr := errors.New("database connection failed")
+		userRepo.EXPECT().GetMany(ctx, "user1").Return([]*users.User{
+			{ID: "user1", Name: "User 1", Active: true},
+		}, nil)
+		prRepo.EXPECT().CountOpenReviews(ctx, "user1").Return(nil, expectedErr)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Contains(t, err.Error(), "counting open reviews")
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,194 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+)
+
+func TestPullRequestService_List(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	filter := usecases.PullRequestFilter{Status: "OPEN", TeamName: "backend"}
+
+	list := []*prs.PullRequest{
+		{ID: "pr-3", Status: prs.StatusOpen, CreatedAt: createdAt.Add(2 * time.Hour)},
+		{ID: "pr-2", Status: prs.StatusOpen, CreatedAt: createdAt.Add(time.Hour)},
+		{ID: "pr-1", Status: prs.StatusOpen, CreatedAt: createdAt},
+	}
+
+	t.Run("pages follow each other", func(t *testing.T) {
+		service, m := setupHistoryTest(t)
+
+		m.prRepo.EXPECT().List(ctx, filter, usecases.PullRequestCursor{}, 3).Return(list, nil)
+
+		first, err := service.List(ctx, usecases.ListPullRequestsRequest{
+			Filter: filter,
+			Page:   usecases.PageRequest{Limit: 2},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, first.Items, 2)
+		assert.Equal(t, "pr-3", first.Items[0].ID)
+		assert.Equal(t, "pr-2", first.Items[1].ID)
+		require.NotEmpty(t, first.NextCursor)
+
+		after := usecases.PullRequestCursor{CreatedAt: createdAt.Add(time.Hour), ID: "pr-2"}
+		m.prRepo.EXPECT().List(ctx, filter, after, 3).Return(list[2:], nil)
+
+		second, err := service.List(ctx, usecases.ListPullRequestsRequest{
+			Filter: filter,
+			Page:   usecases.PageRequest{Cursor: first.NextCursor, Limit: 2},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, second.Items, 1)
+		assert.Equal(t, "pr-1", second.Items[0].ID)
+		assert.Empty(t, second.NextCursor)
+	})
+
+	t.Run("default limit", func(t *testing.T) {
+		service, m := setupHistoryTest(t)
+
+		m.prRepo.EXPECT().List(ctx, usecases.PullRequestFilter{}, usecases.PullRequestCursor{}, usecases.DefaultPageLimit+1).Return(nil, nil)
+
+		page, err := service.List(ctx, usecases.ListPullRequestsRequest{})
+
+		require.NoError(t, err)
+		assert.Empty(t, page.Items)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		service, _ := setupHistoryTest(t)
+
+		_, err := service.List(ctx, usecases.ListPullRequestsRequest{
+			Filter: usecases.PullRequestFilter{Status: "REVIEWED"},
+		})
+
+		assert.ErrorIs(t, err, errorsx.ErrPRStatus)
+	})
+
+	t.Run("inverted time range", func(t *testing.T) {
+		service, _ := setupHistoryTest(t)
+
+		_, err := service.List(ctx, usecases.ListPullRequestsRequest{
+			Filter: usecases.PullRequestFilter{MergedFrom: createdAt, MergedTo: createdAt.Add(-time.Hour)},
+		})
+
+		assert.ErrorIs(t, err, errorsx.ErrPeriod)
+	})
+
+	t.Run("limit out of bounds", func(t *testing.T) {
+		service, _ := setupHistoryTest(t)
+
+		_, err := service.List(ctx, usecases.ListPullRequestsRequest{
+			Page: usecases.PageRequest{Limit: usecases.MaxPageLimit + 1},
+		})
+
+		assert.ErrorIs(t, err, errorsx.ErrPageLimit)
+	})
+
+	t.Run("malformed cursor", func(t *testing.T) {
+		service, _ := setupHistoryTest(t)
+
+		_, err := service.List(ctx, usecases.ListPullRequestsRequest{
+			Page: usecases.PageRequest{Cursor: "not a cursor"},
+		})
+
+		assert.ErrorIs(t, err, errorsx.ErrPageCursor)
+	})
+}
+
+func TestTeamService_ListTeams(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("teams with members", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().List(ctx, "u2", "", 2).Return([]*teams.Team{
+			{Name: "alpha", MemberIDs: []string{"u1", "u2"}},
+			{Name: "beta", MemberIDs: []string{"u2"}},
+		}, nil)
+		m.userRepo.EXPECT().GetMany(ctx, "u1", "u2", "u2").Return([]*users.User{
+			{ID: "u2", Name: "Bob", Active: false},
+			{ID: "u1", Name: "Alice", Active: true},
+		}, nil)
+
+		page, err := service.ListTeams(ctx, usecases.ListTeamsRequest{
+			MemberID: "u2",
+			Page:     usecases.PageRequest{Limit: 1},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []*usecases.TeamView{{
+			Name: "alpha",
+			Members: []usecases.TeamMemberView{
+				{ID: "u1", Name: "Alice", Active: true},
+				{ID: "u2", Name: "Bob", Active: false},
+			},
+		}}, page.Items)
+		require.NotEmpty(t, page.NextCursor)
+
+		m.teamRepo.EXPECT().List(ctx, "", "alpha", 2).Return(nil, nil)
+		m.userRepo.EXPECT().GetMany(ctx).Return(nil, nil)
+
+		next, err := service.ListTeams(ctx, usecases.ListTeamsRequest{
+			Page: usecases.PageRequest{Cursor: page.NextCursor, Limit: 1},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, next.Items)
+		assert.Empty(t, next.NextCursor)
+	})
+
+	t.Run("cursor of another listing", func(t *testing.T) {
+		service, m := setupHistoryTest(t)
+
+		m.prRepo.EXPECT().List(ctx, gomock.Any(), gomock.Any(), 2).Return([]*prs.PullRequest{
+			{ID: "pr-2", CreatedAt: time.Now()},
+			{ID: "pr-1", CreatedAt: time.Now()},
+		}, nil)
+
+		page, err := service.List(ctx, usecases.ListPullRequestsRequest{Page: usecases.PageRequest{Limit: 1}})
+		require.NoError(t, err)
+
+		teamService, _ := setupMembershipTest(t)
+
+		_, err = teamService.ListTeams(ctx, usecases.ListTeamsRequest{
+			Page: usecases.PageRequest{Cursor: page.NextCursor},
+		})
+
+		assert.ErrorIs(t, err, errorsx.ErrPageCursor)
+	})
+}
+
+func TestUserService_ListUsers(t *testing.T) {
+	service, _, userRepo, _, _, _, _ := setupUserTest(t)
+	ctx := context.Background()
+	active := true
+	filter := usecases.UserFilter{TeamName: "backend", Active: &active}
+
+	userRepo.EXPECT().List(ctx, filter, "", usecases.DefaultPageLimit+1).Return([]*users.User{
+		{ID: "u1", Name: "Alice", Active: true},
+		{ID: "u2", Name: "Bob", Active: true},
+	}, nil)
+
+	page, err := service.ListUsers(ctx, usecases.ListUsersRequest{Filter: filter})
+
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+	assertUserView(t, page.Items[0], "u1", "Alice", true)
+	assertUserView(t, page.Items[1], "u2", "Bob", true)
+	assert.Empty(t, page.NextCursor)
+}
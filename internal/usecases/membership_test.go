@@ -0,0 +1,232 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+type membershipTestMocks struct {
+	teamRepo     *mocks.MockteamRepository
+	userRepo     *mocks.MockuserRepository
+	prRepo       *mocks.MockprRepository
+	rpicker      *mocks.MockReviewerPicker
+	historyRepo  *mocks.MockhistoryRepository
+	settingsRepo *mocks.MockteamSettingsRepository
+}
+
+func setupMembershipTest(t *testing.T) (*usecases.TeamServiceImpl, membershipTestMocks) {
+	ctrl := gomock.NewController(t)
+
+	m := membershipTestMocks{
+		teamRepo:     mocks.NewMockteamRepository(ctrl),
+		userRepo:     mocks.NewMockuserRepository(ctrl),
+		prRepo:       mocks.NewMockprRepository(ctrl),
+		rpicker:      mocks.NewMockReviewerPicker(ctrl),
+		historyRepo:  mocks.NewMockhistoryRepository(ctrl),
+		settingsRepo: mocks.NewMockteamSettingsRepository(ctrl),
+	}
+
+	service := usecases.NewTeamService(
+		setupNoopTx(ctrl), m.teamRepo, m.userRepo, m.settingsRepo,
+		m.prRepo, m.rpicker, m.historyRepo, setupNoopOutbox(ctrl),
+	)
+
+	return service, m
+}
+
+func TestTeamService_AddMember(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("new member", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+		user := &users.User{ID: "u2", Name: "Bob", Active: true}
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha", MemberIDs: []string{"u1"}}, nil)
+		m.userRepo.EXPECT().Get(ctx, "u2").Return(nil, errorsx.ErrNotFound)
+		m.userRepo.EXPECT().Save(ctx, user).Return(nil)
+		m.teamRepo.EXPECT().Save(ctx, &teams.Team{Name: "alpha", MemberIDs: []string{"u1", "u2"}}).Return(nil)
+		m.userRepo.EXPECT().GetMany(ctx, "u1", "u2").Return([]*users.User{{ID: "u1", Name: "Alice", Active: true}, user}, nil)
+
+		result, err := service.AddMember(ctx, usecases.AddMemberRequest{
+			TeamName: "alpha",
+			Member:   usecases.TeamMemberView{ID: "u2", Name: "Bob", Active: true},
+		})
+
+		require.NoError(t, err)
+		assertTeamView(t, result, "alpha", []usecases.TeamMemberView{
+			{ID: "u1", Name: "Alice", Active: true},
+			{ID: "u2", Name: "Bob", Active: true},
+		})
+	})
+
+	t.Run("existing user is kept as is", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+		ctx := withPrincipal(auth.RoleAdmin, "")
+		user := &users.User{ID: "u2", Name: "Bob", Active: true}
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha", MemberIDs: []string{"u1"}}, nil)
+		m.userRepo.EXPECT().Get(ctx, "u2").Return(user, nil)
+		m.teamRepo.EXPECT().Save(ctx, &teams.Team{Name: "alpha", MemberIDs: []string{"u1", "u2"}}).Return(nil)
+		m.userRepo.EXPECT().GetMany(ctx, "u1", "u2").Return([]*users.User{{ID: "u1", Name: "Alice", Active: true}, user}, nil)
+
+		result, err := service.AddMember(ctx, usecases.AddMemberRequest{
+			TeamName: "alpha",
+			Member:   usecases.TeamMemberView{ID: "u2", Name: "Bobby", Active: false},
+		})
+
+		require.NoError(t, err)
+		assertTeamView(t, result, "alpha", []usecases.TeamMemberView{
+			{ID: "u1", Name: "Alice", Active: true},
+			{ID: "u2", Name: "Bob", Active: true},
+		})
+	})
+
+	t.Run("team lead can not take user of other team", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+		ctx := withPrincipal(auth.RoleTeamLead, "alpha")
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha", MemberIDs: []string{"u1"}}, nil)
+		m.userRepo.EXPECT().Get(ctx, "u2").Return(&users.User{ID: "u2", Name: "Bob", Active: true}, nil)
+		m.teamRepo.EXPECT().GetManyByMemberID(ctx, "u2").Return([]*teams.Team{{Name: "beta"}}, nil)
+
+		result, err := service.AddMember(ctx, usecases.AddMemberRequest{
+			TeamName: "alpha",
+			Member:   usecases.TeamMemberView{ID: "u2", Name: "Bob", Active: true},
+		})
+
+		assert.ErrorIs(t, err, errorsx.ErrForbidden)
+		assert.Nil(t, result)
+	})
+
+	t.Run("already a member", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+		team := &teams.Team{Name: "alpha", MemberIDs: []string{"u2"}}
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(team, nil)
+
+		result, err := service.AddMember(ctx, usecases.AddMemberRequest{
+			TeamName: "alpha",
+			Member:   usecases.TeamMemberView{ID: "u2", Name: "Bob", Active: true},
+		})
+
+		assert.ErrorIs(t, err, errorsx.ErrDuplicateMember)
+		assert.Nil(t, result)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().GetByName(ctx, "unknown").Return(nil, errorsx.ErrNotFound)
+
+		result, err := service.AddMember(ctx, usecases.AddMemberRequest{
+			TeamName: "unknown",
+			Member:   usecases.TeamMemberView{ID: "u2", Name: "Bob", Active: true},
+		})
+
+		assert.ErrorIs(t, err, errorsx.ErrNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTeamService_RemoveMember(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("open reviews of the team are reassigned", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+		team := teams.Team{Name: "alpha", MemberIDs: []string{"u1", "u3"}}
+
+		alphaPR := &prs.PullRequest{
+			ID: "pr-1", Status: prs.StatusOpen, OriginalTeamName: "alpha", AuthorID: "u1",
+			ReviewerIDs: []string{"u2"}, ReviewersCount: 1, Reviews: map[string]prs.Review{},
+		}
+		betaPR := &prs.PullRequest{
+			ID: "pr-2", Status: prs.StatusOpen, OriginalTeamName: "beta", AuthorID: "u9",
+			ReviewerIDs: []string{"u2"}, ReviewersCount: 1, Reviews: map[string]prs.Review{},
+		}
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha", MemberIDs: []string{"u1", "u2", "u3"}}, nil)
+		m.teamRepo.EXPECT().Save(ctx, &team).Return(nil)
+		m.prRepo.EXPECT().GetAllUnmergedWithAnyOfReviewers(ctx, "u2").Return([]*usecases.PRWithMatchedReviewers{
+			{PR: alphaPR, MatchedReviewerIDs: []string{"u2"}},
+			{PR: betaPR, MatchedReviewerIDs: []string{"u2"}},
+		}, nil)
+		m.teamRepo.EXPECT().GetManyByNames(ctx, "alpha").Return(map[string]teams.Team{"alpha": team}, nil)
+		m.rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"u2", "u1"},
+			WantCount:        1,
+			Team:             team,
+		}).Return([]string{"u3"}, nil)
+		m.prRepo.EXPECT().SaveMany(ctx, alphaPR).Return(nil)
+		m.historyRepo.EXPECT().Append(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events ...prs.AssignmentEvent) error {
+			require.Len(t, events, 2)
+			for _, e := range events {
+				assert.Equal(t, prs.ReasonTeamChange, e.Reason)
+			}
+			return nil
+		})
+		m.userRepo.EXPECT().GetMany(ctx, "u1", "u3").Return([]*users.User{{ID: "u1", Name: "Alice"}, {ID: "u3", Name: "Carol"}}, nil)
+
+		result, err := service.RemoveMember(ctx, usecases.RemoveMemberRequest{TeamName: "alpha", UserID: "u2"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "alpha", result.Name)
+		assert.Len(t, result.Members, 2)
+		assert.Equal(t, []string{"u3"}, alphaPR.ReviewerIDs)
+		assert.Equal(t, []string{"u2"}, betaPR.ReviewerIDs)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha", MemberIDs: []string{"u1"}}, nil)
+
+		result, err := service.RemoveMember(ctx, usecases.RemoveMemberRequest{TeamName: "alpha", UserID: "u2"})
+
+		assert.ErrorIs(t, err, errorsx.ErrNotTeamMember)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTeamService_MoveMember(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("member is moved", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha", MemberIDs: []string{"u1", "u2"}}, nil)
+		m.teamRepo.EXPECT().GetByName(ctx, "beta").Return(&teams.Team{Name: "beta", MemberIDs: []string{"u3"}}, nil)
+		m.teamRepo.EXPECT().Save(ctx, &teams.Team{Name: "alpha", MemberIDs: []string{"u1"}}).Return(nil)
+		m.teamRepo.EXPECT().Save(ctx, &teams.Team{Name: "beta", MemberIDs: []string{"u3", "u2"}}).Return(nil)
+		m.prRepo.EXPECT().GetAllUnmergedWithAnyOfReviewers(ctx, "u2").Return(nil, nil)
+		m.userRepo.EXPECT().GetMany(ctx, "u3", "u2").Return([]*users.User{{ID: "u3", Name: "Carol"}, {ID: "u2", Name: "Bob"}}, nil)
+
+		result, err := service.MoveMember(ctx, usecases.MoveMemberRequest{UserID: "u2", FromTeamName: "alpha", ToTeamName: "beta"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "beta", result.Name)
+		assert.Len(t, result.Members, 2)
+	})
+
+	t.Run("same team", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha", MemberIDs: []string{"u2"}}, nil).Times(2)
+
+		result, err := service.MoveMember(ctx, usecases.MoveMemberRequest{UserID: "u2", FromTeamName: "alpha", ToTeamName: "alpha"})
+
+		assert.ErrorIs(t, err, errorsx.ErrSameTeam)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,136 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+func TestPullRequestService_Create_AuthorTeam(t *testing.T) {
+	ctx := context.Background()
+
+	alpha := &teams.Team{Name: "alpha", MemberIDs: []string{"author-1", "u1"}}
+	beta := &teams.Team{Name: "beta", MemberIDs: []string{"author-1", "u2"}}
+
+	expectPickFrom := func(rpicker *mocks.MockReviewerPicker, prRepo *mocks.MockprRepository, team *teams.Team) {
+		rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"author-1"},
+			Team:             *team,
+			WantCount:        2,
+		}).Return(team.MemberIDs[1:], nil)
+		prRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+	}
+
+	t.Run("requested team", func(t *testing.T) {
+		service, rpicker, prRepo, teamRepo := setupPRTest(t)
+
+		teamRepo.EXPECT().GetByName(ctx, "beta").Return(beta, nil)
+		expectPickFrom(rpicker, prRepo, beta)
+
+		result, err := service.Create(ctx, usecases.CreateRequest{ID: "pr-1", Name: "Fix", AuthorID: "author-1", TeamName: "beta"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"u2"}, result.ReviewerIDs)
+	})
+
+	t.Run("requested team of which author is not a member", func(t *testing.T) {
+		service, _, _, teamRepo := setupPRTest(t)
+
+		teamRepo.EXPECT().GetByName(ctx, "gamma").Return(&teams.Team{Name: "gamma", MemberIDs: []string{"u3"}}, nil)
+
+		result, err := service.Create(ctx, usecases.CreateRequest{ID: "pr-1", Name: "Fix", AuthorID: "author-1", TeamName: "gamma"})
+
+		assert.ErrorIs(t, err, errorsx.ErrNotTeamMember)
+		assert.Nil(t, result)
+	})
+
+	t.Run("primary team", func(t *testing.T) {
+		service, rpicker, prRepo, teamRepo := setupPRTest(t)
+
+		teamRepo.EXPECT().GetManyByMemberID(ctx, "author-1").Return([]*teams.Team{alpha, beta}, nil)
+		teamRepo.EXPECT().GetPrimaryNameByMemberID(ctx, "author-1").Return("beta", nil)
+		expectPickFrom(rpicker, prRepo, beta)
+
+		result, err := service.Create(ctx, usecases.CreateRequest{ID: "pr-1", Name: "Fix", AuthorID: "author-1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"u2"}, result.ReviewerIDs)
+	})
+
+	t.Run("several teams without primary one", func(t *testing.T) {
+		service, _, _, teamRepo := setupPRTest(t)
+
+		teamRepo.EXPECT().GetManyByMemberID(ctx, "author-1").Return([]*teams.Team{alpha, beta}, nil)
+		teamRepo.EXPECT().GetPrimaryNameByMemberID(ctx, "author-1").Return("", errorsx.ErrNotFound)
+
+		result, err := service.Create(ctx, usecases.CreateRequest{ID: "pr-1", Name: "Fix", AuthorID: "author-1"})
+
+		assert.ErrorIs(t, err, errorsx.ErrAmbiguousTeam)
+		assert.Nil(t, result)
+	})
+
+	t.Run("author without team", func(t *testing.T) {
+		service, _, _, teamRepo := setupPRTest(t)
+
+		teamRepo.EXPECT().GetManyByMemberID(ctx, "author-1").Return(nil, nil)
+
+		result, err := service.Create(ctx, usecases.CreateRequest{ID: "pr-1", Name: "Fix", AuthorID: "author-1"})
+
+		assert.ErrorIs(t, err, errorsx.ErrNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestUserService_SetPrimaryTeam(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*usecases.UserServiceImpl, *mocks.MockteamRepository, *mocks.MockuserRepository) {
+		ctrl := gomock.NewController(t)
+		teamRepo := mocks.NewMockteamRepository(ctrl)
+		userRepo := mocks.NewMockuserRepository(ctrl)
+
+		service := usecases.NewUserService(
+			setupNoopTx(ctrl), userRepo, teamRepo, mocks.NewMockprRepository(ctrl),
+			mocks.NewMockReviewerPicker(ctrl), setupNoopHistory(ctrl), setupNoopOutbox(ctrl),
+		)
+
+		return service, teamRepo, userRepo
+	}
+
+	t.Run("member of the team", func(t *testing.T) {
+		service, teamRepo, userRepo := setup(t)
+		user := &users.User{ID: "u1", Name: "Alice", Active: true}
+
+		userRepo.EXPECT().Get(ctx, "u1").Return(user, nil)
+		teamRepo.EXPECT().SetPrimary(ctx, "u1", "beta").Return(nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, "u1").Return([]*teams.Team{{Name: "alpha"}, {Name: "beta"}}, nil)
+		teamRepo.EXPECT().GetPrimaryNameByMemberID(ctx, "u1").Return("beta", nil)
+
+		result, err := service.SetPrimaryTeam(ctx, "u1", "beta")
+
+		require.NoError(t, err)
+		assertUserWithTeamsView(t, result, "u1", "Alice", []string{"alpha", "beta"}, true)
+		assert.Equal(t, "beta", result.PrimaryTeamName)
+	})
+
+	t.Run("not a member of the team", func(t *testing.T) {
+		service, teamRepo, userRepo := setup(t)
+
+		userRepo.EXPECT().Get(ctx, "u1").Return(&users.User{ID: "u1", Name: "Alice"}, nil)
+		teamRepo.EXPECT().SetPrimary(ctx, "u1", "gamma").Return(errorsx.ErrNotFound)
+
+		result, err := service.SetPrimaryTeam(ctx, "u1", "gamma")
+
+		assert.ErrorIs(t, err, errorsx.ErrNotTeamMember)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,177 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+)
+
+type OutOfOfficeView struct {
+	ID              int64
+	UserID          string
+	StartsAt        time.Time
+	EndsAt          time.Time
+	Reason          string
+	ReassignReviews bool
+}
+
+type AddOutOfOfficeRequest struct {
+	UserID   string
+	StartsAt time.Time
+	EndsAt   time.Time
+	Reason   string
+	// ReassignReviews requests reassignment of user's open reviews once the period starts.
+	ReassignReviews bool
+}
+
+type OutOfOfficeService interface {
+	Add(ctx context.Context, req AddOutOfOfficeRequest) (*OutOfOfficeView, error)
+	// List returns user's periods which have not ended yet.
+	List(ctx context.Context, userID string) ([]*OutOfOfficeView, error)
+	Delete(ctx context.Context, id int64) error
+	// ReassignStarted reassigns open reviews of users whose periods have started and requested reassignment.
+	// It is meant to be run periodically.
+	ReassignStarted(ctx context.Context) error
+}
+
+var _ OutOfOfficeService = &OutOfOfficeServiceImpl{}
+
+type OutOfOfficeServiceImpl struct {
+	txManager  TxManager
+	oooRepo    outOfOfficeRepository
+	userRepo   userRepository
+	teamRepo   teamRepository
+	reassigner *reviewerReassigner
+}
+
+func outOfOfficeIntoView(o *users.OutOfOffice) *OutOfOfficeView {
+	return &OutOfOfficeView{
+		ID:              o.ID,
+		UserID:          o.UserID,
+		StartsAt:        o.StartsAt,
+		EndsAt:          o.EndsAt,
+		Reason:          o.Reason,
+		ReassignReviews: o.ReassignReviews,
+	}
+}
+
+func (s *OutOfOfficeServiceImpl) Add(ctx context.Context, req AddOutOfOfficeRequest) (*OutOfOfficeView, error) {
+	period, err := users.NewOutOfOffice(req.UserID, req.StartsAt, req.EndsAt, req.Reason, req.ReassignReviews)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.userRepo.Get(ctx, req.UserID); err != nil {
+		return nil, fmt.Errorf("retrieving user: %w", err)
+	}
+
+	if err := authorizeMembers(ctx, s.teamRepo, req.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := s.oooRepo.Create(ctx, period); err != nil {
+		return nil, fmt.Errorf("creating period: %w", err)
+	}
+
+	return outOfOfficeIntoView(period), nil
+}
+
+func (s *OutOfOfficeServiceImpl) List(ctx context.Context, userID string) ([]*OutOfOfficeView, error) {
+	if _, err := s.userRepo.Get(ctx, userID); err != nil {
+		return nil, fmt.Errorf("retrieving user: %w", err)
+	}
+
+	periods, err := s.oooRepo.GetManyByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving periods: %w", err)
+	}
+
+	views := make([]*OutOfOfficeView, len(periods))
+	for i, p := range periods {
+		views[i] = outOfOfficeIntoView(p)
+	}
+
+	return views, nil
+}
+
+// Delete removes the period, reviews which were already reassigned are not returned to the user.
+func (s *OutOfOfficeServiceImpl) Delete(ctx context.Context, id int64) error {
+	period, err := s.oooRepo.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving period: %w", err)
+	}
+
+	if err := authorizeMembers(ctx, s.teamRepo, period.UserID); err != nil {
+		return err
+	}
+
+	if err := s.oooRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("deleting period: %w", err)
+	}
+
+	return nil
+}
+
+func (s *OutOfOfficeServiceImpl) ReassignStarted(ctx context.Context) error {
+	ctx, txHandle, err := s.txManager.WithTx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting tx: %w", err)
+	}
+
+	defer txHandle.Rollback(ctx)
+
+	periods, err := s.oooRepo.LockStartedPendingReassignment(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving started periods: %w", err)
+	}
+
+	if len(periods) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(periods))
+	userIDs := make([]string, 0, len(periods))
+	for i, p := range periods {
+		ids[i] = p.ID
+		if !slices.Contains(userIDs, p.UserID) {
+			userIDs = append(userIDs, p.UserID)
+		}
+	}
+
+	if err := s.reassigner.reassign(ctx, prs.ReasonOutOfOffice, "", userIDs...); err != nil {
+		return fmt.Errorf("reassigning reviewed prs: %w", err)
+	}
+
+	if err := s.oooRepo.MarkReassigned(ctx, ids...); err != nil {
+		return fmt.Errorf("marking periods: %w", err)
+	}
+
+	if err := txHandle.Commit(ctx); err != nil {
+		return fmt.Errorf("commiting tx: %w", err)
+	}
+
+	return nil
+}
+
+func NewOutOfOfficeService(
+	txManager TxManager,
+	oooRepo outOfOfficeRepository,
+	userRepo userRepository,
+	teamRepo teamRepository,
+	prRepo prRepository,
+	rpicker ReviewerPicker,
+	historyRepo historyRepository,
+	outboxRepo outboxRepository,
+) *OutOfOfficeServiceImpl {
+	return &OutOfOfficeServiceImpl{
+		txManager:  txManager,
+		oooRepo:    oooRepo,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		reassigner: newReviewerReassigner(prRepo, teamRepo, rpicker, historyRepo, outboxRepo),
+	}
+}
@@ -0,0 +1,196 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+type oooTestMocks struct {
+	oooRepo     *mocks.MockoutOfOfficeRepository
+	userRepo    *mocks.MockuserRepository
+	teamRepo    *mocks.MockteamRepository
+	prRepo      *mocks.MockprRepository
+	rpicker     *mocks.MockReviewerPicker
+	historyRepo *mocks.MockhistoryRepository
+}
+
+func setupOutOfOfficeTest(t *testing.T) (*usecases.OutOfOfficeServiceImpl, oooTestMocks) {
+	ctrl := gomock.NewController(t)
+	m := oooTestMocks{
+		oooRepo:     mocks.NewMockoutOfOfficeRepository(ctrl),
+		userRepo:    mocks.NewMockuserRepository(ctrl),
+		teamRepo:    mocks.NewMockteamRepository(ctrl),
+		prRepo:      mocks.NewMockprRepository(ctrl),
+		rpicker:     mocks.NewMockReviewerPicker(ctrl),
+		historyRepo: mocks.NewMockhistoryRepository(ctrl),
+	}
+
+	service := usecases.NewOutOfOfficeService(setupNoopTx(ctrl), m.oooRepo, m.userRepo, m.teamRepo, m.prRepo, m.rpicker, m.historyRepo, setupNoopOutbox(ctrl))
+
+	return service, m
+}
+
+func TestOutOfOfficeService_Add(t *testing.T) {
+	start := time.Date(2025, 12, 22, 0, 0, 0, 0, time.UTC)
+	end := start.Add(7 * 24 * time.Hour)
+	user := &users.User{ID: "u1", Name: "Alice", Active: true}
+
+	t.Run("creates period", func(t *testing.T) {
+		service, m := setupOutOfOfficeTest(t)
+		ctx := context.Background()
+
+		m.userRepo.EXPECT().Get(ctx, "u1").Return(user, nil)
+		m.oooRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, o *users.OutOfOffice) error {
+			o.ID = 42
+			return nil
+		})
+
+		res, err := service.Add(ctx, usecases.AddOutOfOfficeRequest{
+			UserID:          "u1",
+			StartsAt:        start,
+			EndsAt:          end,
+			Reason:          "vacation",
+			ReassignReviews: true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &usecases.OutOfOfficeView{
+			ID:              42,
+			UserID:          "u1",
+			StartsAt:        start,
+			EndsAt:          end,
+			Reason:          "vacation",
+			ReassignReviews: true,
+		}, res)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		service, _ := setupOutOfOfficeTest(t)
+
+		_, err := service.Add(context.Background(), usecases.AddOutOfOfficeRequest{UserID: "u1", StartsAt: end, EndsAt: start})
+
+		assert.ErrorIs(t, err, errorsx.ErrPeriod)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		service, m := setupOutOfOfficeTest(t)
+		ctx := context.Background()
+
+		m.userRepo.EXPECT().Get(ctx, "u1").Return(nil, errorsx.ErrNotFound)
+
+		_, err := service.Add(ctx, usecases.AddOutOfOfficeRequest{UserID: "u1", StartsAt: start, EndsAt: end})
+
+		assert.ErrorIs(t, err, errorsx.ErrNotFound)
+	})
+
+	t.Run("team lead of another team is forbidden", func(t *testing.T) {
+		service, m := setupOutOfOfficeTest(t)
+		ctx := withPrincipal(auth.RoleTeamLead, "frontend")
+
+		m.userRepo.EXPECT().Get(ctx, "u1").Return(user, nil)
+		m.teamRepo.EXPECT().GetManyByMemberID(ctx, "u1").Return([]*teams.Team{{Name: "backend"}}, nil)
+
+		_, err := service.Add(ctx, usecases.AddOutOfOfficeRequest{UserID: "u1", StartsAt: start, EndsAt: end})
+
+		assert.ErrorIs(t, err, errorsx.ErrForbidden)
+	})
+}
+
+func TestOutOfOfficeService_Delete(t *testing.T) {
+	period := &users.OutOfOffice{ID: 7, UserID: "u1"}
+
+	t.Run("team lead of user's team", func(t *testing.T) {
+		service, m := setupOutOfOfficeTest(t)
+		ctx := withPrincipal(auth.RoleTeamLead, "backend")
+
+		m.oooRepo.EXPECT().Get(ctx, int64(7)).Return(period, nil)
+		m.teamRepo.EXPECT().GetManyByMemberID(ctx, "u1").Return([]*teams.Team{{Name: "backend"}}, nil)
+		m.oooRepo.EXPECT().Delete(ctx, int64(7)).Return(nil)
+
+		require.NoError(t, service.Delete(ctx, 7))
+	})
+
+	t.Run("period not found", func(t *testing.T) {
+		service, m := setupOutOfOfficeTest(t)
+		ctx := context.Background()
+
+		m.oooRepo.EXPECT().Get(ctx, int64(7)).Return(nil, errorsx.ErrNotFound)
+
+		assert.ErrorIs(t, service.Delete(ctx, 7), errorsx.ErrNotFound)
+	})
+}
+
+func TestOutOfOfficeService_ReassignStarted(t *testing.T) {
+	t.Run("reassigns reviews of users whose periods started", func(t *testing.T) {
+		service, m := setupOutOfOfficeTest(t)
+		ctx := context.Background()
+
+		team := teams.Team{Name: "backend", MemberIDs: []string{"author", "u1", "u2", "u3"}}
+		pr := &prs.PullRequest{
+			ID:               "pr-1",
+			Status:           prs.StatusOpen,
+			OriginalTeamName: team.Name,
+			AuthorID:         "author",
+			ReviewerIDs:      []string{"u1", "u2"},
+			ReviewersCount:   2,
+		}
+
+		m.oooRepo.EXPECT().LockStartedPendingReassignment(ctx).Return([]*users.OutOfOffice{
+			{ID: 1, UserID: "u1", ReassignReviews: true},
+			{ID: 2, UserID: "u1", ReassignReviews: true},
+		}, nil)
+		m.prRepo.EXPECT().GetAllUnmergedWithAnyOfReviewers(ctx, "u1").Return([]*usecases.PRWithMatchedReviewers{
+			{PR: pr, MatchedReviewerIDs: []string{"u1"}},
+		}, nil)
+		m.teamRepo.EXPECT().GetManyByNames(ctx, team.Name).Return(map[string]teams.Team{team.Name: team}, nil)
+		m.rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"u1", "author", "u2"},
+			WantCount:        1,
+			Team:             team,
+		}).Return([]string{"u3"}, nil)
+		m.prRepo.EXPECT().SaveMany(ctx, pr).Return(nil)
+		expectEvents(m.historyRepo,
+			prs.AssignmentEvent{PullRequestID: "pr-1", ReviewerID: "u1", Action: prs.ActionUnassigned, Reason: prs.ReasonOutOfOffice, Actor: usecases.SystemActor},
+			prs.AssignmentEvent{PullRequestID: "pr-1", ReviewerID: "u3", Action: prs.ActionAssigned, Reason: prs.ReasonOutOfOffice, Actor: usecases.SystemActor},
+		)
+		m.oooRepo.EXPECT().MarkReassigned(ctx, int64(1), int64(2)).Return(nil)
+
+		require.NoError(t, service.ReassignStarted(ctx))
+		assert.Equal(t, []string{"u2", "u3"}, pr.ReviewerIDs)
+	})
+
+	t.Run("nothing to reassign", func(t *testing.T) {
+		service, m := setupOutOfOfficeTest(t)
+		ctx := context.Background()
+
+		m.oooRepo.EXPECT().LockStartedPendingReassignment(ctx).Return([]*users.OutOfOffice{}, nil)
+
+		require.NoError(t, service.ReassignStarted(ctx))
+	})
+
+	t.Run("periods are not marked when reassignment fails", func(t *testing.T) {
+		service, m := setupOutOfOfficeTest(t)
+		ctx := context.Background()
+
+		m.oooRepo.EXPECT().LockStartedPendingReassignment(ctx).Return([]*users.OutOfOffice{{ID: 1, UserID: "u1"}}, nil)
+		m.prRepo.EXPECT().GetAllUnmergedWithAnyOfReviewers(ctx, "u1").Return(nil, errors.New("db error"))
+
+		err := service.ReassignStarted(ctx)
+
+		assert.Error(t, err)
+	})
+}
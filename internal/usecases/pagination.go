@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"encoding/base64"
+	"encoding/json/v2"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+)
+
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 100
+)
+
+// PageRequest asks for items following the cursor, empty cursor asks for the first page.
+// Zero limit means DefaultPageLimit.
+type PageRequest struct {
+	Cursor string
+	Limit  int
+}
+
+// Page is a part of a listing in its stable order, NextCursor is empty on the last page.
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+}
+
+// limit returns number of items to return, ErrPageLimit is returned if it is out of bounds.
+func (r PageRequest) limit() (int, error) {
+	switch {
+	case r.Limit == 0:
+		return DefaultPageLimit, nil
+	case r.Limit < 0 || r.Limit > MaxPageLimit:
+		return 0, errorsx.ErrPageLimit
+	}
+	return r.Limit, nil
+}
+
+// decodeCursor reads the sort key of the last item of the previous page into v.
+// It reports false for the first page.
+func (r PageRequest) decodeCursor(v any) (bool, error) {
+	if r.Cursor == "" {
+		return false, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(r.Cursor)
+	if err != nil {
+		return false, errorsx.ErrPageCursor
+	}
+	if err := json.Unmarshal(data, v, json.RejectUnknownMembers(true)); err != nil {
+		return false, errorsx.ErrPageCursor
+	}
+
+	return true, nil
+}
+
+// newPage expects items to be fetched with limit+1, the extra item only tells that the next page exists.
+// Cursor of the next page is the sort key of the last returned item.
+func newPage[T any](items []T, limit int, key func(last T) any) *Page[T] {
+	if len(items) <= limit {
+		return &Page[T]{Items: items}
+	}
+
+	items = items[:limit]
+	// sort keys are plain structs, so marshaling them never fails
+	data, _ := json.Marshal(key(items[limit-1]))
+
+	return &Page[T]{
+		Items:      items,
+		NextCursor: base64.RawURLEncoding.EncodeToString(data),
+	}
+}
@@ -4,22 +4,46 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
 	"github.com/lezzercringe/avito-test-assignment/internal/prs"
 	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
 )
 
+//go:generate mockgen -typed -destination ../../mocks/services.go -package mocks . PullRequestService
+
 type PullRequestService interface {
+	// Get returns the PR along with details of its author and reviewers.
+	Get(ctx context.Context, id string) (*PullRequestDetailsView, error)
 	Create(ctx context.Context, req CreateRequest) (*prs.PullRequestView, error)
 	Merge(ctx context.Context, id string) (*prs.PullRequestView, error)
+	// RecordMerged marks the PR merged on the Git host, bypassing the team's merge policy.
+	RecordMerged(ctx context.Context, id string) (*prs.PullRequestView, error)
+	MarkReady(ctx context.Context, id string) (*prs.PullRequestView, error)
+	Close(ctx context.Context, id string) (*prs.PullRequestView, error)
+	Reopen(ctx context.Context, id string) (*prs.PullRequestView, error)
+	SubmitReview(ctx context.Context, req SubmitReviewRequest) (*prs.PullRequestView, error)
+	GetHistory(ctx context.Context, id string) ([]AssignmentEventView, error)
 	ReassignReviewer(ctx context.Context, req ReassignReviewerRequest) (*ReassignReviewerResult, error)
+	// List returns pull requests matching the filter, newest first.
+	List(ctx context.Context, req ListPullRequestsRequest) (*Page[*prs.PullRequestView], error)
 }
 
 type CreateRequest struct {
 	ID       string
 	AuthorID string
 	Name     string
+	// TeamName selects which of the author's teams reviews the PR.
+	// Author's only team or its primary team is used when empty.
+	TeamName string
+	// ReviewersCount overrides team's default number of reviewers when set.
+	ReviewersCount *int
+	// Draft PRs get their reviewers only after being marked ready.
+	Draft bool
 }
 
 type ReassignReviewerRequest struct {
@@ -27,73 +51,497 @@ type ReassignReviewerRequest struct {
 	UserIDToReassign string
 }
 
+type SubmitReviewRequest struct {
+	PullRequestID string
+	ReviewerID    string
+	State         string
+}
+
 type ReassignReviewerResult struct {
 	PR           *prs.PullRequestView
 	ReplacedByID string
 }
 
+// PullRequestFilter narrows listing of pull requests, zero fields match any pull request.
+// Time ranges include their start and exclude their end.
+type PullRequestFilter struct {
+	Status      string
+	AuthorID    string
+	ReviewerID  string
+	TeamName    string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	MergedFrom  time.Time
+	MergedTo    time.Time
+}
+
+// PullRequestCursor is the sort key of the last listed pull request.
+type PullRequestCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+type ListPullRequestsRequest struct {
+	Filter PullRequestFilter
+	Page   PageRequest
+}
+
+type PullRequestUserView struct {
+	ID       string
+	Name     string
+	IsActive bool
+	// TeamName is PR's team for its members, otherwise user's primary or first team.
+	// It is empty for users which are not members of any team.
+	TeamName string
+}
+
+type PullRequestReviewerView struct {
+	PullRequestUserView
+	// AssignedAt is the time of the latest assignment.
+	AssignedAt time.Time
+}
+
+type PullRequestDetailsView struct {
+	PR        *prs.PullRequestView
+	Author    PullRequestUserView
+	Reviewers []PullRequestReviewerView // in the order of PR's ReviewerIDs
+}
+
 type PickReviewersRequest struct {
 	UserIDsToExclude []string
 	WantCount        int
 	Team             teams.Team
+	// KeepRotation prevents picks from advancing team's rotation cursor,
+	// it is set when candidates are not members of the team.
+	KeepRotation bool
 }
 
 type ReviewerPicker interface {
 	PickReviewersFromTeam(ctx context.Context, req PickReviewersRequest) ([]string, error)
 }
 
+type expectedVersionKey struct{}
+
+// WithExpectedVersion returns context which allows modifying only the given versions of a pull request.
+// Without versions the pull request can not be modified at all.
+func WithExpectedVersion(ctx context.Context, versions ...int64) context.Context {
+	return context.WithValue(ctx, expectedVersionKey{}, versions)
+}
+
+// checkExpectedVersion reports ErrVersionMismatch if PR is not of any version stored by WithExpectedVersion.
+func checkExpectedVersion(ctx context.Context, pr *prs.PullRequest) error {
+	if versions, ok := ctx.Value(expectedVersionKey{}).([]int64); ok && !slices.Contains(versions, pr.Version) {
+		return errorsx.ErrVersionMismatch
+	}
+	return nil
+}
+
 var _ PullRequestService = &PullRequestServiceImpl{}
 
 type PullRequestServiceImpl struct {
-	rpicker  ReviewerPicker
-	prRepo   prRepository
-	teamRepo teamRepository
+	txManager    TxManager
+	rpicker      ReviewerPicker
+	prRepo       prRepository
+	teamRepo     teamRepository
+	userRepo     userRepository
+	settingsRepo teamSettingsRepository
+	historyRepo  historyRepository
+	outboxRepo   outboxRepository
+}
+
+func (m *PullRequestServiceImpl) Get(ctx context.Context, id string) (*PullRequestDetailsView, error) {
+	pr, err := m.prRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving pr by id: %w", err)
+	}
+
+	people, err := m.userRepo.GetMany(ctx, append([]string{pr.AuthorID}, pr.ReviewerIDs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving users: %w", err)
+	}
+
+	peopleByID := make(map[string]*users.User, len(people))
+	for _, u := range people {
+		peopleByID[u.ID] = u
+	}
+
+	author, err := m.prUserIntoView(ctx, pr, peopleByID, pr.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewers := make([]PullRequestReviewerView, len(pr.ReviewerIDs))
+	for i, reviewerID := range pr.ReviewerIDs {
+		reviewer, err := m.prUserIntoView(ctx, pr, peopleByID, reviewerID)
+		if err != nil {
+			return nil, err
+		}
+
+		reviewers[i] = PullRequestReviewerView{
+			PullRequestUserView: reviewer,
+			AssignedAt:          pr.ReviewOf(reviewerID).AssignedAt,
+		}
+	}
+
+	return &PullRequestDetailsView{
+		PR:        pr.ToView(),
+		Author:    author,
+		Reviewers: reviewers,
+	}, nil
+}
+
+func (m *PullRequestServiceImpl) prUserIntoView(
+	ctx context.Context,
+	pr *prs.PullRequest,
+	peopleByID map[string]*users.User,
+	id string,
+) (PullRequestUserView, error) {
+	view := PullRequestUserView{ID: id}
+	if u, ok := peopleByID[id]; ok {
+		view.Name = u.Name
+		view.IsActive = u.Active
+	}
+
+	userTeams, err := m.teamRepo.GetManyByMemberID(ctx, id)
+	if err != nil {
+		return PullRequestUserView{}, fmt.Errorf("retrieving teams of %s: %w", id, err)
+	}
+
+	switch {
+	case len(userTeams) == 0:
+	case slices.ContainsFunc(userTeams, func(t *teams.Team) bool { return t.Name == pr.OriginalTeamName }):
+		view.TeamName = pr.OriginalTeamName
+	default:
+		primary, err := m.teamRepo.GetPrimaryNameByMemberID(ctx, id)
+		switch {
+		case err == nil:
+			view.TeamName = primary
+		case errors.Is(err, errorsx.ErrNotFound):
+			view.TeamName = userTeams[0].Name
+		default:
+			return PullRequestUserView{}, fmt.Errorf("retrieving primary team of %s: %w", id, err)
+		}
+	}
+
+	return view, nil
 }
 
 func (m *PullRequestServiceImpl) Create(ctx context.Context, req CreateRequest) (*prs.PullRequestView, error) {
-	team, err := m.teamRepo.GetByMemberID(ctx, req.AuthorID)
+	ctx, txHandle, err := m.txManager.WithTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting tx: %w", err)
+	}
+
+	defer txHandle.Rollback(ctx)
+
+	team, err := m.resolveAuthorTeam(ctx, req.AuthorID, req.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeTeam(ctx, team.Name); err != nil {
+		return nil, err
+	}
+
+	settings, err := getTeamSettings(ctx, m.settingsRepo, team.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving team settings: %w", err)
+	}
+
+	reviewersCount := settings.Reviewers.Default
+	if req.ReviewersCount != nil {
+		if err := settings.CheckReviewersCount(*req.ReviewersCount); err != nil {
+			return nil, err
+		}
+		reviewersCount = *req.ReviewersCount
+	}
+
+	pr, err := prs.New(req.ID, req.Name, team.Name, req.AuthorID, reviewersCount, req.Draft)
 	if err != nil {
 		return nil, err
 	}
 
-	pr := prs.PullRequest{
-		ID:               req.ID,
-		Name:             req.Name,
-		Status:           prs.StatusOpen,
-		OriginalTeamName: team.Name,
-		AuthorID:         req.AuthorID,
+	if pr.Status != prs.StatusDraft {
+		if err := m.assignMissingReviewers(ctx, pr, team); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.prRepo.Save(ctx, pr); err != nil {
+		return nil, fmt.Errorf("saving pr: %w", err)
+	}
+
+	if err := recordAssignments(ctx, m.historyRepo, m.outboxRepo, pr, nil, pr.ReviewerIDs, prs.ReasonInitialPick); err != nil {
+		return nil, fmt.Errorf("recording assignments: %w", err)
+	}
+
+	if err := txHandle.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commiting tx: %w", err)
+	}
+
+	return viewWithFallbackReviewers(pr, team), nil
+}
+
+// viewWithFallbackReviewers marks reviewers which were picked outside of PR's team.
+func viewWithFallbackReviewers(pr *prs.PullRequest, team *teams.Team) *prs.PullRequestView {
+	view := pr.ToView()
+	view.FallbackReviewerIDs = pr.OutsideReviewerIDs(team.MemberIDs)
+	return view
+}
+
+// resolveAuthorTeam returns the requested team of the author, or the author's only team, or its primary team.
+func (m *PullRequestServiceImpl) resolveAuthorTeam(ctx context.Context, authorID, teamName string) (*teams.Team, error) {
+	if teamName != "" {
+		team, err := m.teamRepo.GetByName(ctx, teamName)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving team: %w", err)
+		}
+		if !team.HasMember(authorID) {
+			return nil, errorsx.ErrNotTeamMember
+		}
+		return team, nil
+	}
+
+	authorTeams, err := m.teamRepo.GetManyByMemberID(ctx, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving author teams: %w", err)
+	}
+
+	switch len(authorTeams) {
+	case 0:
+		return nil, errorsx.ErrNotFound
+	case 1:
+		return authorTeams[0], nil
+	}
+
+	primary, err := m.teamRepo.GetPrimaryNameByMemberID(ctx, authorID)
+	if errors.Is(err, errorsx.ErrNotFound) {
+		return nil, errorsx.ErrAmbiguousTeam
+	}
+	if err != nil {
+		return nil, fmt.Errorf("retrieving primary team of author: %w", err)
+	}
+
+	idx := slices.IndexFunc(authorTeams, func(t *teams.Team) bool { return t.Name == primary })
+	if idx == -1 {
+		return nil, errorsx.ErrAmbiguousTeam
+	}
+
+	return authorTeams[idx], nil
+}
+
+// assignMissingReviewers picks reviewers among team members until PR has the required amount of them.
+// Lack of candidates is not an error, PR stays with fewer reviewers.
+func (m *PullRequestServiceImpl) assignMissingReviewers(ctx context.Context, pr *prs.PullRequest, team *teams.Team) error {
+	if pr.MissingReviewersCount() == 0 {
+		return nil
 	}
 
 	pickedIDs, err := m.rpicker.PickReviewersFromTeam(ctx, PickReviewersRequest{
-		UserIDsToExclude: []string{req.AuthorID},
+		UserIDsToExclude: append([]string{pr.AuthorID}, pr.ReviewerIDs...),
 		Team:             *team,
-		WantCount:        2,
+		WantCount:        pr.MissingReviewersCount(),
 	})
-	if err != nil && !errors.Is(err, errorsx.ErrNoCandidate) {
-		return nil, fmt.Errorf("picking reviewer: %w", err)
-	}
-	if !errors.Is(err, errorsx.ErrNoCandidate) {
-		for _, id := range pickedIDs {
-			if err := pr.AssignReviewer(id); err != nil {
-				return nil, fmt.Errorf("assigning reviewer: %w", err)
-			}
+	if errors.Is(err, errorsx.ErrNoCandidate) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("picking reviewer: %w", err)
+	}
+
+	for _, id := range pickedIDs {
+		if err := pr.AssignReviewer(id); err != nil {
+			return fmt.Errorf("assigning reviewer: %w", err)
 		}
+	}
+
+	return nil
+}
+
+// unassignUnavailableReviewers drops reviewers which are not available anymore, so they can be replaced.
+func (m *PullRequestServiceImpl) unassignUnavailableReviewers(ctx context.Context, pr *prs.PullRequest) error {
+	if len(pr.ReviewerIDs) == 0 {
+		return nil
+	}
+
+	reviewers, err := m.userRepo.GetMany(ctx, pr.ReviewerIDs...)
+	if err != nil {
+		return fmt.Errorf("retrieving reviewers: %w", err)
+	}
 
+	available := make(map[string]bool, len(reviewers))
+	for _, u := range reviewers {
+		available[u.ID] = u.Available()
 	}
 
-	if err := m.prRepo.Save(ctx, &pr); err != nil {
+	for _, id := range slices.Clone(pr.ReviewerIDs) {
+		if available[id] {
+			continue
+		}
+		if err := pr.UnassignReviewer(id); err != nil {
+			return fmt.Errorf("unassigning reviewer: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// transitionWithReviewers applies status transition after which PR is under review and assigns missing reviewers.
+// Kept reviewers which became unavailable meanwhile, e.g. while the PR was closed, are replaced.
+// All the reviewers of the PR are recorded as assigned with the given reason.
+func (m *PullRequestServiceImpl) transitionWithReviewers(
+	ctx context.Context,
+	id string,
+	transition func(pr *prs.PullRequest) error,
+	reason prs.AssignmentReason,
+) (*prs.PullRequestView, error) {
+	ctx, txHandle, err := m.txManager.WithTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting tx: %w", err)
+	}
+
+	defer txHandle.Rollback(ctx)
+
+	pr, err := m.prRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving pr by id: %w", err)
+	}
+
+	if err := authorizeTeam(ctx, pr.OriginalTeamName); err != nil {
+		return nil, err
+	}
+
+	if err := checkExpectedVersion(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	if err := transition(pr); err != nil {
+		return nil, err
+	}
+
+	if err := m.unassignUnavailableReviewers(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	team, err := m.teamRepo.GetByName(ctx, pr.OriginalTeamName)
+	if err != nil {
+		return nil, fmt.Errorf("getting original pr team: %w", err)
+	}
+
+	if err := m.assignMissingReviewers(ctx, pr, team); err != nil {
+		return nil, err
+	}
+
+	if err := m.prRepo.Save(ctx, pr); err != nil {
+		return nil, fmt.Errorf("saving pr: %w", err)
+	}
+
+	if err := recordAssignments(ctx, m.historyRepo, m.outboxRepo, pr, nil, pr.ReviewerIDs, reason); err != nil {
+		return nil, fmt.Errorf("recording assignments: %w", err)
+	}
+
+	if err := txHandle.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commiting tx: %w", err)
+	}
+
+	return viewWithFallbackReviewers(pr, team), nil
+}
+
+func (m *PullRequestServiceImpl) MarkReady(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	return m.transitionWithReviewers(ctx, id, (*prs.PullRequest).MarkReady, prs.ReasonInitialPick)
+}
+
+// Reopen moves closed PR back to review, available reviewers kept from before closing are preserved.
+func (m *PullRequestServiceImpl) Reopen(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	return m.transitionWithReviewers(ctx, id, (*prs.PullRequest).Reopen, prs.ReasonReopen)
+}
+
+// finish applies transition which ends PR's review, e.g. merge or close.
+// If the transition changed PR's status, all of its assignments are recorded as ended with the given reason.
+func (m *PullRequestServiceImpl) finish(
+	ctx context.Context,
+	id string,
+	transition func(ctx context.Context, pr *prs.PullRequest) error,
+	reason prs.AssignmentReason,
+) (*prs.PullRequestView, error) {
+	ctx, txHandle, err := m.txManager.WithTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting tx: %w", err)
+	}
+
+	defer txHandle.Rollback(ctx)
+
+	pr, err := m.prRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving pr by id: %w", err)
+	}
+
+	if err := authorizeTeam(ctx, pr.OriginalTeamName); err != nil {
+		return nil, err
+	}
+
+	if err := checkExpectedVersion(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	statusBefore := pr.Status
+	if err := transition(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	if err := m.prRepo.Save(ctx, pr); err != nil {
 		return nil, fmt.Errorf("saving pr: %w", err)
 	}
 
+	if pr.Status != statusBefore {
+		if err := recordAssignments(ctx, m.historyRepo, m.outboxRepo, pr, pr.ReviewerIDs, nil, reason); err != nil {
+			return nil, fmt.Errorf("recording assignments: %w", err)
+		}
+	}
+
+	if pr.Status != statusBefore && pr.Status == prs.StatusMerged {
+		event := webhooks.PullRequestMerged(pr, ActorFromContext(ctx))
+		if err := m.outboxRepo.Enqueue(ctx, event); err != nil {
+			return nil, fmt.Errorf("enqueueing webhook events: %w", err)
+		}
+	}
+
+	if err := txHandle.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commiting tx: %w", err)
+	}
+
 	return pr.ToView(), nil
 }
 
+func (m *PullRequestServiceImpl) Close(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	return m.finish(ctx, id, func(_ context.Context, pr *prs.PullRequest) error {
+		return pr.Close()
+	}, prs.ReasonClose)
+}
+
 func (m *PullRequestServiceImpl) ReassignReviewer(ctx context.Context, req ReassignReviewerRequest) (*ReassignReviewerResult, error) {
+	ctx, txHandle, err := m.txManager.WithTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting tx: %w", err)
+	}
+
+	defer txHandle.Rollback(ctx)
+
 	pr, err := m.prRepo.GetByID(ctx, req.PullRequestID)
 	if err != nil {
 		return nil, fmt.Errorf("retreiving pull request with specified id: %w", err)
 	}
 
+	if err := authorizeTeam(ctx, pr.OriginalTeamName); err != nil {
+		return nil, err
+	}
+
+	if err := checkExpectedVersion(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	reviewersBefore := slices.Clone(pr.ReviewerIDs)
+
 	if err := pr.UnassignReviewer(req.UserIDToReassign); err != nil {
 		return nil, fmt.Errorf("unassigning original reviewer: %w", err)
 	}
@@ -103,8 +551,11 @@ func (m *PullRequestServiceImpl) ReassignReviewer(ctx context.Context, req Reass
 		return nil, fmt.Errorf("getting original pr team: %w", err)
 	}
 
+	// the author and the remaining reviewers are excluded as well, so they are not picked
+	exclude := append([]string{req.UserIDToReassign, pr.AuthorID}, pr.ReviewerIDs...)
+
 	pickedIDs, err := m.rpicker.PickReviewersFromTeam(ctx, PickReviewersRequest{
-		UserIDsToExclude: []string{req.UserIDToReassign},
+		UserIDsToExclude: exclude,
 		WantCount:        1,
 		Team:             *team,
 	})
@@ -120,31 +571,159 @@ func (m *PullRequestServiceImpl) ReassignReviewer(ctx context.Context, req Reass
 		return nil, fmt.Errorf("saving pr: %w", err)
 	}
 
+	if err := recordAssignments(ctx, m.historyRepo, m.outboxRepo, pr, reviewersBefore, pr.ReviewerIDs, prs.ReasonManualReassign); err != nil {
+		return nil, fmt.Errorf("recording assignments: %w", err)
+	}
+
+	if err := txHandle.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commiting tx: %w", err)
+	}
+
 	return &ReassignReviewerResult{
-		PR:           pr.ToView(),
+		PR:           viewWithFallbackReviewers(pr, team),
 		ReplacedByID: pickedIDs[0],
 	}, nil
 }
 
 func (m *PullRequestServiceImpl) Merge(ctx context.Context, id string) (*prs.PullRequestView, error) {
-	pr, err := m.prRepo.GetByID(ctx, id)
+	return m.finish(ctx, id, func(ctx context.Context, pr *prs.PullRequest) error {
+		settings, err := getTeamSettings(ctx, m.settingsRepo, pr.OriginalTeamName)
+		if err != nil {
+			return fmt.Errorf("retrieving team settings: %w", err)
+		}
+
+		return pr.Merge(settings.Reviewers.RequiredApprovals)
+	}, prs.ReasonMerge)
+}
+
+func (m *PullRequestServiceImpl) RecordMerged(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	return m.finish(ctx, id, func(_ context.Context, pr *prs.PullRequest) error {
+		pr.RecordMerged()
+		return nil
+	}, prs.ReasonMerge)
+}
+
+func (m *PullRequestServiceImpl) SubmitReview(ctx context.Context, req SubmitReviewRequest) (*prs.PullRequestView, error) {
+	ctx, txHandle, err := m.txManager.WithTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting tx: %w", err)
+	}
+
+	defer txHandle.Rollback(ctx)
+
+	pr, err := m.prRepo.GetByID(ctx, req.PullRequestID)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving pr by id: %w", err)
 	}
 
-	pr.Merge()
+	if err := authorizeTeam(ctx, pr.OriginalTeamName); err != nil {
+		return nil, err
+	}
+
+	if err := checkExpectedVersion(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	if err := pr.SubmitReview(req.ReviewerID, prs.ReviewState(req.State)); err != nil {
+		return nil, err
+	}
 
 	if err := m.prRepo.Save(ctx, pr); err != nil {
 		return nil, fmt.Errorf("saving pr: %w", err)
 	}
 
+	if err := txHandle.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commiting tx: %w", err)
+	}
+
 	return pr.ToView(), nil
 }
 
-func NewPullRequestService(rpicker ReviewerPicker, prRepo prRepository, teamRepo teamRepository) *PullRequestServiceImpl {
+func (m *PullRequestServiceImpl) GetHistory(ctx context.Context, id string) ([]AssignmentEventView, error) {
+	if _, err := m.prRepo.GetByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("retrieving pr by id: %w", err)
+	}
+
+	events, err := m.historyRepo.GetByPullRequestID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving assignment history: %w", err)
+	}
+
+	views := make([]AssignmentEventView, len(events))
+	for i, e := range events {
+		views[i] = AssignmentEventView{
+			ReviewerID: e.ReviewerID,
+			Action:     string(e.Action),
+			Reason:     string(e.Reason),
+			Actor:      e.Actor,
+			At:         e.At,
+		}
+	}
+
+	return views, nil
+}
+
+func (m *PullRequestServiceImpl) List(ctx context.Context, req ListPullRequestsRequest) (*Page[*prs.PullRequestView], error) {
+	filter := req.Filter
+	if filter.Status != "" && !prs.Status(filter.Status).Valid() {
+		return nil, errorsx.ErrPRStatus
+	}
+	for _, period := range []StatsPeriod{
+		{From: filter.CreatedFrom, To: filter.CreatedTo},
+		{From: filter.MergedFrom, To: filter.MergedTo},
+	} {
+		if err := checkPeriod(period); err != nil {
+			return nil, err
+		}
+	}
+
+	limit, err := req.Page.limit()
+	if err != nil {
+		return nil, err
+	}
+
+	var after PullRequestCursor
+	ok, err := req.Page.decodeCursor(&after)
+	if err != nil {
+		return nil, err
+	}
+	if ok && (after.CreatedAt.IsZero() || after.ID == "") {
+		return nil, errorsx.ErrPageCursor
+	}
+
+	list, err := m.prRepo.List(ctx, filter, after, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("listing prs: %w", err)
+	}
+
+	views := make([]*prs.PullRequestView, len(list))
+	for i, pr := range list {
+		views[i] = pr.ToView()
+	}
+
+	return newPage(views, limit, func(last *prs.PullRequestView) any {
+		return PullRequestCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}), nil
+}
+
+func NewPullRequestService(
+	txManager TxManager,
+	rpicker ReviewerPicker,
+	prRepo prRepository,
+	teamRepo teamRepository,
+	userRepo userRepository,
+	settingsRepo teamSettingsRepository,
+	historyRepo historyRepository,
+	outboxRepo outboxRepository,
+) *PullRequestServiceImpl {
 	return &PullRequestServiceImpl{
-		rpicker:  rpicker,
-		prRepo:   prRepo,
-		teamRepo: teamRepo,
+		txManager:    txManager,
+		rpicker:      rpicker,
+		prRepo:       prRepo,
+		teamRepo:     teamRepo,
+		userRepo:     userRepo,
+		settingsRepo: settingsRepo,
+		historyRepo:  historyRepo,
+		outboxRepo:   outboxRepo,
 	}
 }
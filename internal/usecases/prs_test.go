@@ -3,6 +3,7 @@ package usecases_test
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/lezzercringe/avito-test-assignment/internal/prs"
 	"github.com/lezzercringe/avito-test-assignment/internal/teams"
 	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
 	"github.com/lezzercringe/avito-test-assignment/mocks"
 )
 
@@ -24,11 +26,42 @@ func setupPRTest(t *testing.T) (*usecases.PullRequestServiceImpl, *mocks.MockRev
 	prRepo := mocks.NewMockprRepository(ctrl)
 	teamRepo := mocks.NewMockteamRepository(ctrl)
 
-	service := usecases.NewPullRequestService(rpicker, prRepo, teamRepo)
+	// teams are not configured unless the test says otherwise
+	settingsRepo := mocks.NewMockteamSettingsRepository(ctrl)
+	settingsRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errorsx.ErrNotFound).AnyTimes()
+
+	service := usecases.NewPullRequestService(setupNoopTx(ctrl), rpicker, prRepo, teamRepo, mocks.NewMockuserRepository(ctrl), settingsRepo, setupNoopHistory(ctrl), setupNoopOutbox(ctrl))
 
 	return service, rpicker, prRepo, teamRepo
 }
 
+// setupNoopTx returns tx manager which passes the context through and accepts any commit or rollback.
+func setupNoopTx(ctrl *gomock.Controller) *mocks.MockTxManager {
+	txManager := mocks.NewMockTxManager(ctrl)
+	txHandle := mocks.NewMockTxHandle(ctrl)
+
+	txManager.EXPECT().WithTx(gomock.Any()).DoAndReturn(func(ctx context.Context) (context.Context, usecases.TxHandle, error) {
+		return ctx, txHandle, nil
+	}).AnyTimes()
+	txHandle.EXPECT().Commit(gomock.Any()).Return(nil).AnyTimes()
+	txHandle.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+
+	return txManager
+}
+
+// setupNoopHistory returns history repository which accepts any events.
+func setupNoopHistory(ctrl *gomock.Controller) *mocks.MockhistoryRepository {
+	historyRepo := mocks.NewMockhistoryRepository(ctrl)
+	historyRepo.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return historyRepo
+}
+
+func setupNoopOutbox(ctrl *gomock.Controller) *mocks.MockoutboxRepository {
+	outboxRepo := mocks.NewMockoutboxRepository(ctrl)
+	outboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return outboxRepo
+}
+
 func assertPRView(t *testing.T, result *prs.PullRequestView, expectedID, expectedName, expectedAuthorID string, expectedStatus string, expectedReviewerIDs []string) {
 	assert.Equal(t, expectedID, result.ID)
 	assert.Equal(t, expectedName, result.Name)
@@ -58,7 +91,7 @@ func TestPullRequestService_Create_Ok(t *testing.T) {
 			MemberIDs: []string{"author-1", "user-2", "user-3"},
 		}
 
-		teamRepo.EXPECT().GetByMemberID(ctx, req.AuthorID).Return(team, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, req.AuthorID).Return([]*teams.Team{team}, nil)
 		rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
 			UserIDsToExclude: []string{req.AuthorID},
 			Team:             *team,
@@ -84,7 +117,7 @@ func TestPullRequestService_Create_Ok(t *testing.T) {
 			MemberIDs: []string{"author-2"},
 		}
 
-		teamRepo.EXPECT().GetByMemberID(ctx, req.AuthorID).Return(team, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, req.AuthorID).Return([]*teams.Team{team}, nil)
 		rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
 			UserIDsToExclude: []string{req.AuthorID},
 			Team:             *team,
@@ -110,7 +143,7 @@ func TestPullRequestService_Create_Ok(t *testing.T) {
 			MemberIDs: []string{"author-3", "user-4"},
 		}
 
-		teamRepo.EXPECT().GetByMemberID(ctx, req.AuthorID).Return(team, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, req.AuthorID).Return([]*teams.Team{team}, nil)
 		rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
 			UserIDsToExclude: []string{req.AuthorID},
 			Team:             *team,
@@ -125,6 +158,110 @@ func TestPullRequestService_Create_Ok(t *testing.T) {
 	})
 }
 
+func TestPullRequestService_Create_TeamSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	rpicker := mocks.NewMockReviewerPicker(ctrl)
+	prRepo := mocks.NewMockprRepository(ctrl)
+	teamRepo := mocks.NewMockteamRepository(ctrl)
+	settingsRepo := mocks.NewMockteamSettingsRepository(ctrl)
+	service := usecases.NewPullRequestService(setupNoopTx(ctrl), rpicker, prRepo, teamRepo, mocks.NewMockuserRepository(ctrl), settingsRepo, setupNoopHistory(ctrl), setupNoopOutbox(ctrl))
+	ctx := context.Background()
+
+	req := usecases.CreateRequest{
+		ID:       "pr-123",
+		AuthorID: "author-1",
+		Name:     "Fix bug",
+	}
+
+	team := &teams.Team{
+		Name:      "team-alpha",
+		MemberIDs: []string{"author-1", "user-2", "user-3"},
+	}
+
+	teamRepo.EXPECT().GetManyByMemberID(ctx, req.AuthorID).Return([]*teams.Team{team}, nil)
+	settingsRepo.EXPECT().Get(ctx, team.Name).Return(&teams.Settings{
+		TeamName:  team.Name,
+		Strategy:  teams.StrategyRandom,
+		Reviewers: teams.ReviewersPolicy{Default: 1, Max: 3},
+	}, nil)
+	rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+		UserIDsToExclude: []string{req.AuthorID},
+		Team:             *team,
+		WantCount:        1,
+	}).Return([]string{"user-3"}, nil)
+	prRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+
+	result, err := service.Create(ctx, req)
+
+	require.NoError(t, err)
+	assertPRView(t, result, req.ID, req.Name, req.AuthorID, "OPEN", []string{"user-3"})
+}
+
+func TestPullRequestService_Create_ReviewersCount(t *testing.T) {
+	ctx := context.Background()
+	team := &teams.Team{
+		Name:      "team-alpha",
+		MemberIDs: []string{"author-1", "user-2", "user-3", "user-4"},
+	}
+	settings := &teams.Settings{
+		TeamName:  team.Name,
+		Strategy:  teams.StrategyRandom,
+		Reviewers: teams.ReviewersPolicy{Default: 1, Min: 1, Max: 3},
+	}
+
+	setup := func(t *testing.T) (*usecases.PullRequestServiceImpl, *mocks.MockReviewerPicker, *mocks.MockprRepository) {
+		ctrl := gomock.NewController(t)
+		rpicker := mocks.NewMockReviewerPicker(ctrl)
+		prRepo := mocks.NewMockprRepository(ctrl)
+		teamRepo := mocks.NewMockteamRepository(ctrl)
+		settingsRepo := mocks.NewMockteamSettingsRepository(ctrl)
+
+		teamRepo.EXPECT().GetManyByMemberID(ctx, "author-1").Return([]*teams.Team{team}, nil).AnyTimes()
+		settingsRepo.EXPECT().Get(ctx, team.Name).Return(settings, nil).AnyTimes()
+
+		return usecases.NewPullRequestService(setupNoopTx(ctrl), rpicker, prRepo, teamRepo, mocks.NewMockuserRepository(ctrl), settingsRepo, setupNoopHistory(ctrl), setupNoopOutbox(ctrl)), rpicker, prRepo
+	}
+
+	t.Run("requested count within policy", func(t *testing.T) {
+		service, rpicker, prRepo := setup(t)
+		count := 3
+
+		rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"author-1"},
+			Team:             *team,
+			WantCount:        3,
+		}).Return([]string{"user-2", "user-3", "user-4"}, nil)
+		prRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+
+		result, err := service.Create(ctx, usecases.CreateRequest{
+			ID:             "pr-123",
+			AuthorID:       "author-1",
+			Name:           "Risky change",
+			ReviewersCount: &count,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.ReviewersCount)
+		assert.Equal(t, []string{"user-2", "user-3", "user-4"}, result.ReviewerIDs)
+	})
+
+	t.Run("requested count outside policy", func(t *testing.T) {
+		service, _, _ := setup(t)
+
+		for _, count := range []int{0, 4} {
+			result, err := service.Create(ctx, usecases.CreateRequest{
+				ID:             "pr-123",
+				AuthorID:       "author-1",
+				Name:           "Docs",
+				ReviewersCount: &count,
+			})
+
+			assert.ErrorIs(t, err, errorsx.ErrReviewersCount)
+			assert.Nil(t, result)
+		}
+	})
+}
+
 func TestPullRequestService_Create_Error(t *testing.T) {
 	service, rpicker, prRepo, teamRepo := setupPRTest(t)
 	ctx := context.Background()
@@ -136,7 +273,7 @@ func TestPullRequestService_Create_Error(t *testing.T) {
 			Name:     "Fix bug",
 		}
 
-		teamRepo.EXPECT().GetByMemberID(ctx, req.AuthorID).Return(nil, errors.New("team not found"))
+		teamRepo.EXPECT().GetManyByMemberID(ctx, req.AuthorID).Return(nil, errors.New("team not found"))
 
 		result, err := service.Create(ctx, req)
 
@@ -157,7 +294,7 @@ func TestPullRequestService_Create_Error(t *testing.T) {
 			MemberIDs: []string{"author-2", "user-1"},
 		}
 
-		teamRepo.EXPECT().GetByMemberID(ctx, req.AuthorID).Return(team, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, req.AuthorID).Return([]*teams.Team{team}, nil)
 		rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
 			UserIDsToExclude: []string{req.AuthorID},
 			Team:             *team,
@@ -183,7 +320,7 @@ func TestPullRequestService_Create_Error(t *testing.T) {
 			MemberIDs: []string{"author-3", "user-4"},
 		}
 
-		teamRepo.EXPECT().GetByMemberID(ctx, req.AuthorID).Return(team, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, req.AuthorID).Return([]*teams.Team{team}, nil)
 		rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
 			UserIDsToExclude: []string{req.AuthorID},
 			Team:             *team,
@@ -216,6 +353,7 @@ func TestPullRequestService_ReassignReviewer_Ok(t *testing.T) {
 			OriginalTeamName: "team-alpha",
 			AuthorID:         "author-1",
 			ReviewerIDs:      []string{"old-reviewer", "another-reviewer"},
+			ReviewersCount:   2,
 		}
 
 		team := &teams.Team{
@@ -226,7 +364,7 @@ func TestPullRequestService_ReassignReviewer_Ok(t *testing.T) {
 		prRepo.EXPECT().GetByID(ctx, req.PullRequestID).Return(pr, nil)
 		teamRepo.EXPECT().GetByName(ctx, pr.OriginalTeamName).Return(team, nil)
 		rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
-			UserIDsToExclude: []string{req.UserIDToReassign},
+			UserIDsToExclude: []string{req.UserIDToReassign, "author-1", "another-reviewer"},
 			Team:             *team,
 			WantCount:        1,
 		}).Return([]string{"new-reviewer"}, nil)
@@ -236,14 +374,55 @@ func TestPullRequestService_ReassignReviewer_Ok(t *testing.T) {
 
 		require.NoError(t, err)
 		expectedPR := &prs.PullRequestView{
-			ID:          "pr-123",
-			Name:        "Fix bug",
-			AuthorID:    "author-1",
-			Status:      "OPEN",
-			ReviewerIDs: []string{"another-reviewer", "new-reviewer"},
+			ID:             "pr-123",
+			Name:           "Fix bug",
+			AuthorID:       "author-1",
+			Status:         "OPEN",
+			ReviewerIDs:    []string{"another-reviewer", "new-reviewer"},
+			ReviewersCount: 2,
 		}
 		assertReassignResult(t, result, expectedPR, "new-reviewer")
 	})
+
+	t.Run("author and remaining reviewer are not picked", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:               "pr-456",
+			Status:           prs.StatusOpen,
+			OriginalTeamName: "team-alpha",
+			AuthorID:         "author-1",
+			ReviewerIDs:      []string{"stale-reviewer", "second-reviewer"},
+			ReviewersCount:   2,
+		}
+
+		team := &teams.Team{
+			Name:      "team-alpha",
+			MemberIDs: []string{"author-1", "second-reviewer", "stale-reviewer", "fresh-reviewer"},
+		}
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+		teamRepo.EXPECT().GetByName(ctx, pr.OriginalTeamName).Return(team, nil)
+		// picker takes the first member which is not excluded
+		rpicker.EXPECT().PickReviewersFromTeam(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, req usecases.PickReviewersRequest) ([]string, error) {
+				for _, id := range req.Team.MemberIDs {
+					if !slices.Contains(req.UserIDsToExclude, id) {
+						return []string{id}, nil
+					}
+				}
+				return nil, errorsx.ErrNoCandidate
+			},
+		)
+		prRepo.EXPECT().Save(ctx, pr).Return(nil)
+
+		result, err := service.ReassignReviewer(ctx, usecases.ReassignReviewerRequest{
+			PullRequestID:    pr.ID,
+			UserIDToReassign: "stale-reviewer",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "fresh-reviewer", result.ReplacedByID)
+		assert.Equal(t, []string{"second-reviewer", "fresh-reviewer"}, result.PR.ReviewerIDs)
+	})
 }
 
 func TestPullRequestService_ReassignReviewer_Error(t *testing.T) {
@@ -278,6 +457,7 @@ func TestPullRequestService_ReassignReviewer_Error(t *testing.T) {
 			OriginalTeamName: "team-alpha",
 			AuthorID:         "author-1",
 			ReviewerIDs:      []string{"reviewer-1", "reviewer-2"},
+			ReviewersCount:   2,
 		}
 
 		prRepo.EXPECT().GetByID(ctx, req.PullRequestID).Return(pr, nil)
@@ -302,6 +482,7 @@ func TestPullRequestService_ReassignReviewer_Error(t *testing.T) {
 			OriginalTeamName: "team-missing",
 			AuthorID:         "author-1",
 			ReviewerIDs:      []string{"reviewer-1", "reviewer-2"},
+			ReviewersCount:   2,
 		}
 
 		prRepo.EXPECT().GetByID(ctx, req.PullRequestID).Return(pr, nil)
@@ -327,6 +508,7 @@ func TestPullRequestService_ReassignReviewer_Error(t *testing.T) {
 			OriginalTeamName: "team-alpha",
 			AuthorID:         "author-1",
 			ReviewerIDs:      []string{"reviewer-1", "reviewer-2"},
+			ReviewersCount:   2,
 		}
 
 		team := &teams.Team{
@@ -337,7 +519,7 @@ func TestPullRequestService_ReassignReviewer_Error(t *testing.T) {
 		prRepo.EXPECT().GetByID(ctx, req.PullRequestID).Return(pr, nil)
 		teamRepo.EXPECT().GetByName(ctx, pr.OriginalTeamName).Return(team, nil)
 		rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
-			UserIDsToExclude: []string{req.UserIDToReassign},
+			UserIDsToExclude: []string{req.UserIDToReassign, "author-1", "reviewer-2"},
 			Team:             *team,
 			WantCount:        1,
 		}).Return(nil, errors.New("picker error"))
@@ -362,6 +544,7 @@ func TestPullRequestService_ReassignReviewer_Error(t *testing.T) {
 			OriginalTeamName: "team-alpha",
 			AuthorID:         "author-1",
 			ReviewerIDs:      []string{"reviewer-1", "reviewer-2"},
+			ReviewersCount:   2,
 		}
 
 		team := &teams.Team{
@@ -372,7 +555,7 @@ func TestPullRequestService_ReassignReviewer_Error(t *testing.T) {
 		prRepo.EXPECT().GetByID(ctx, req.PullRequestID).Return(pr, nil)
 		teamRepo.EXPECT().GetByName(ctx, pr.OriginalTeamName).Return(team, nil)
 		rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
-			UserIDsToExclude: []string{req.UserIDToReassign},
+			UserIDsToExclude: []string{req.UserIDToReassign, "author-1", "reviewer-2"},
 			Team:             *team,
 			WantCount:        1,
 		}).Return([]string{"new-reviewer"}, nil)
@@ -400,6 +583,7 @@ func TestPullRequestService_Merge_Ok(t *testing.T) {
 			OriginalTeamName: "team-alpha",
 			AuthorID:         "author-1",
 			ReviewerIDs:      []string{"reviewer-1", "reviewer-2"},
+			ReviewersCount:   2,
 		}
 
 		prRepo.EXPECT().GetByID(ctx, prID).Return(pr, nil)
@@ -423,6 +607,7 @@ func TestPullRequestService_Merge_Ok(t *testing.T) {
 			OriginalTeamName: "team-beta",
 			AuthorID:         "author-2",
 			ReviewerIDs:      []string{"reviewer-3"},
+			ReviewersCount:   2,
 			MergedAt:         mergedAt,
 		}
 
@@ -437,6 +622,32 @@ func TestPullRequestService_Merge_Ok(t *testing.T) {
 	})
 }
 
+func TestPullRequestService_RecordMerged(t *testing.T) {
+	service, _, prRepo, _ := setupPRTest(t)
+	ctx := context.Background()
+
+	t.Run("merges PR without approvals", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:               "pr-123",
+			Name:             "Fix bug",
+			Status:           prs.StatusOpen,
+			OriginalTeamName: "team-alpha",
+			AuthorID:         "author-1",
+			ReviewerIDs:      []string{"reviewer-1", "reviewer-2"},
+			ReviewersCount:   2,
+		}
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+		prRepo.EXPECT().Save(ctx, pr).Return(nil)
+
+		result, err := service.RecordMerged(ctx, pr.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, pr.Approvals())
+		assertPRView(t, result, "pr-123", "Fix bug", "author-1", "MERGED", []string{"reviewer-1", "reviewer-2"})
+	})
+}
+
 func TestPullRequestService_Merge_Error(t *testing.T) {
 	service, _, prRepo, _ := setupPRTest(t)
 	ctx := context.Background()
@@ -463,6 +674,7 @@ func TestPullRequestService_Merge_Error(t *testing.T) {
 			OriginalTeamName: "team-alpha",
 			AuthorID:         "author-1",
 			ReviewerIDs:      []string{"reviewer-1"},
+			ReviewersCount:   2,
 		}
 
 		prRepo.EXPECT().GetByID(ctx, prID).Return(pr, nil)
@@ -474,4 +686,467 @@ func TestPullRequestService_Merge_Error(t *testing.T) {
 		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "saving pr")
 	})
+
+	t.Run("concurrent modification", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:      "pr-456",
+			Status:  prs.StatusOpen,
+			Version: 2,
+		}
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+		prRepo.EXPECT().Save(ctx, pr).Return(errorsx.ErrConcurrentModification)
+
+		result, err := service.Merge(ctx, pr.ID)
+
+		assert.ErrorIs(t, err, errorsx.ErrConcurrentModification)
+		assert.Nil(t, result)
+	})
+}
+
+func TestPullRequestService_Create_Draft(t *testing.T) {
+	service, _, prRepo, teamRepo := setupPRTest(t)
+	ctx := context.Background()
+
+	req := usecases.CreateRequest{
+		ID:       "pr-123",
+		AuthorID: "author-1",
+		Name:     "WIP: Fix bug",
+		Draft:    true,
+	}
+
+	team := &teams.Team{
+		Name:      "team-alpha",
+		MemberIDs: []string{"author-1", "user-2", "user-3"},
+	}
+
+	// picker is not expected to be called for drafts
+	teamRepo.EXPECT().GetManyByMemberID(ctx, req.AuthorID).Return([]*teams.Team{team}, nil)
+	prRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+
+	result, err := service.Create(ctx, req)
+
+	require.NoError(t, err)
+	assertPRView(t, result, req.ID, req.Name, req.AuthorID, "DRAFT", nil)
+	assert.Equal(t, 2, result.ReviewersCount)
+}
+
+func TestPullRequestService_MarkReady(t *testing.T) {
+	service, rpicker, prRepo, teamRepo := setupPRTest(t)
+	ctx := context.Background()
+
+	team := &teams.Team{
+		Name:      "team-alpha",
+		MemberIDs: []string{"author-1", "user-2", "user-3"},
+	}
+
+	t.Run("draft PR gets reviewers", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:               "pr-123",
+			Name:             "Fix bug",
+			Status:           prs.StatusDraft,
+			OriginalTeamName: team.Name,
+			AuthorID:         "author-1",
+			ReviewersCount:   2,
+		}
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+		teamRepo.EXPECT().GetByName(ctx, team.Name).Return(team, nil)
+		rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"author-1"},
+			Team:             *team,
+			WantCount:        2,
+		}).Return([]string{"user-2", "user-3"}, nil)
+		prRepo.EXPECT().Save(ctx, pr).Return(nil)
+
+		result, err := service.MarkReady(ctx, pr.ID)
+
+		require.NoError(t, err)
+		assertPRView(t, result, pr.ID, "Fix bug", "author-1", "OPEN", []string{"user-2", "user-3"})
+	})
+
+	t.Run("PR is not a draft", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:               "pr-456",
+			Status:           prs.StatusOpen,
+			OriginalTeamName: team.Name,
+			AuthorID:         "author-1",
+			ReviewersCount:   2,
+		}
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+
+		result, err := service.MarkReady(ctx, pr.ID)
+
+		assert.ErrorIs(t, err, errorsx.ErrNotDraftPR)
+		assert.Nil(t, result)
+	})
+}
+
+func TestPullRequestService_Close(t *testing.T) {
+	service, _, prRepo, _ := setupPRTest(t)
+	ctx := context.Background()
+
+	t.Run("close open PR", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:             "pr-123",
+			Name:           "Fix bug",
+			Status:         prs.StatusOpen,
+			AuthorID:       "author-1",
+			ReviewerIDs:    []string{"reviewer-1"},
+			ReviewersCount: 2,
+		}
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+		prRepo.EXPECT().Save(ctx, pr).Return(nil)
+
+		result, err := service.Close(ctx, pr.ID)
+
+		require.NoError(t, err)
+		assertPRView(t, result, pr.ID, "Fix bug", "author-1", "CLOSED", []string{"reviewer-1"})
+	})
+
+	t.Run("cannot close merged PR", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:     "pr-456",
+			Status: prs.StatusMerged,
+		}
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+
+		result, err := service.Close(ctx, pr.ID)
+
+		assert.ErrorIs(t, err, errorsx.ErrModifyMergedPR)
+		assert.Nil(t, result)
+	})
+
+	t.Run("expected version matches", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:      "pr-789",
+			Status:  prs.StatusOpen,
+			Version: 3,
+		}
+		ctx := usecases.WithExpectedVersion(ctx, 3)
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+		prRepo.EXPECT().Save(ctx, pr).Return(nil)
+
+		result, err := service.Close(ctx, pr.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "CLOSED", result.Status)
+	})
+
+	t.Run("expected version mismatch", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:      "pr-789",
+			Status:  prs.StatusOpen,
+			Version: 4,
+		}
+		ctx := usecases.WithExpectedVersion(ctx, 3)
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+
+		result, err := service.Close(ctx, pr.ID)
+
+		assert.ErrorIs(t, err, errorsx.ErrVersionMismatch)
+		assert.Nil(t, result)
+		assert.Equal(t, prs.StatusOpen, pr.Status)
+	})
+
+	t.Run("any of expected versions matches", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:      "pr-789",
+			Status:  prs.StatusOpen,
+			Version: 4,
+		}
+		ctx := usecases.WithExpectedVersion(ctx, 3, 4)
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+		prRepo.EXPECT().Save(ctx, pr).Return(nil)
+
+		result, err := service.Close(ctx, pr.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "CLOSED", result.Status)
+	})
+
+	t.Run("no expected versions", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:      "pr-789",
+			Status:  prs.StatusOpen,
+			Version: 1,
+		}
+		ctx := usecases.WithExpectedVersion(ctx)
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+
+		result, err := service.Close(ctx, pr.ID)
+
+		assert.ErrorIs(t, err, errorsx.ErrVersionMismatch)
+		assert.Nil(t, result)
+	})
+}
+
+func TestPullRequestService_Reopen(t *testing.T) {
+	ctx := context.Background()
+
+	team := &teams.Team{
+		Name:      "team-alpha",
+		MemberIDs: []string{"author-1", "user-2", "user-3", "user-4"},
+	}
+
+	t.Run("reopened PR keeps reviewers and gets missing ones", func(t *testing.T) {
+		service, m := setupHistoryTest(t)
+		pr := &prs.PullRequest{
+			ID:               "pr-123",
+			Name:             "Fix bug",
+			Status:           prs.StatusClosed,
+			OriginalTeamName: team.Name,
+			AuthorID:         "author-1",
+			ReviewerIDs:      []string{"user-2"},
+			ReviewersCount:   2,
+		}
+
+		m.prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+		m.userRepo.EXPECT().GetMany(ctx, "user-2").Return([]*users.User{{ID: "user-2", Active: true}}, nil)
+		m.teamRepo.EXPECT().GetByName(ctx, team.Name).Return(team, nil)
+		m.rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"author-1", "user-2"},
+			Team:             *team,
+			WantCount:        1,
+		}).Return([]string{"user-3"}, nil)
+		m.prRepo.EXPECT().Save(ctx, pr).Return(nil)
+		m.historyRepo.EXPECT().Append(ctx, gomock.Any()).Return(nil)
+
+		result, err := service.Reopen(ctx, pr.ID)
+
+		require.NoError(t, err)
+		assertPRView(t, result, pr.ID, "Fix bug", "author-1", "OPEN", []string{"user-2", "user-3"})
+	})
+
+	t.Run("reviewers which became unavailable while PR was closed are replaced", func(t *testing.T) {
+		service, m := setupHistoryTest(t)
+		pr := &prs.PullRequest{
+			ID:               "pr-123",
+			Name:             "Fix bug",
+			Status:           prs.StatusClosed,
+			OriginalTeamName: team.Name,
+			AuthorID:         "author-1",
+			ReviewerIDs:      []string{"user-2", "user-3"},
+			ReviewersCount:   2,
+		}
+
+		m.prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+		m.userRepo.EXPECT().GetMany(ctx, "user-2", "user-3").Return([]*users.User{
+			{ID: "user-2", Active: false},
+			{ID: "user-3", Active: true, OutOfOffice: true},
+		}, nil)
+		m.teamRepo.EXPECT().GetByName(ctx, team.Name).Return(team, nil)
+		m.rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"author-1"},
+			Team:             *team,
+			WantCount:        2,
+		}).Return([]string{"user-4"}, nil)
+		m.prRepo.EXPECT().Save(ctx, pr).Return(nil)
+		expectEvents(m.historyRepo, prs.AssignmentEvent{
+			PullRequestID: pr.ID, ReviewerID: "user-4", Action: prs.ActionAssigned, Reason: prs.ReasonReopen, Actor: usecases.SystemActor,
+		})
+
+		result, err := service.Reopen(ctx, pr.ID)
+
+		require.NoError(t, err)
+		assertPRView(t, result, pr.ID, "Fix bug", "author-1", "OPEN", []string{"user-4"})
+	})
+
+	t.Run("PR is not closed", func(t *testing.T) {
+		service, m := setupHistoryTest(t)
+		pr := &prs.PullRequest{
+			ID:     "pr-456",
+			Status: prs.StatusOpen,
+		}
+
+		m.prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+
+		result, err := service.Reopen(ctx, pr.ID)
+
+		assert.ErrorIs(t, err, errorsx.ErrNotClosedPR)
+		assert.Nil(t, result)
+	})
+}
+
+func TestPullRequestService_SubmitReview(t *testing.T) {
+	service, _, prRepo, _ := setupPRTest(t)
+	ctx := context.Background()
+
+	t.Run("reviewer approves PR", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:             "pr-123",
+			Name:           "Fix bug",
+			Status:         prs.StatusOpen,
+			AuthorID:       "author-1",
+			ReviewerIDs:    []string{"reviewer-1", "reviewer-2"},
+			ReviewersCount: 2,
+		}
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+		prRepo.EXPECT().Save(ctx, pr).Return(nil)
+
+		result, err := service.SubmitReview(ctx, usecases.SubmitReviewRequest{
+			PullRequestID: pr.ID,
+			ReviewerID:    "reviewer-2",
+			State:         "APPROVED",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Reviews, 2)
+		assert.Equal(t, "PENDING", result.Reviews[0].State)
+		assert.Equal(t, "APPROVED", result.Reviews[1].State)
+	})
+
+	t.Run("unknown state", func(t *testing.T) {
+		pr := &prs.PullRequest{
+			ID:          "pr-456",
+			Status:      prs.StatusOpen,
+			ReviewerIDs: []string{"reviewer-1"},
+		}
+
+		prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+
+		result, err := service.SubmitReview(ctx, usecases.SubmitReviewRequest{
+			PullRequestID: pr.ID,
+			ReviewerID:    "reviewer-1",
+			State:         "LGTM",
+		})
+
+		assert.ErrorIs(t, err, errorsx.ErrReviewState)
+		assert.Nil(t, result)
+	})
+}
+
+func TestPullRequestService_Merge_RequiredApprovals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	prRepo := mocks.NewMockprRepository(ctrl)
+	settingsRepo := mocks.NewMockteamSettingsRepository(ctrl)
+	service := usecases.NewPullRequestService(setupNoopTx(ctrl), mocks.NewMockReviewerPicker(ctrl), prRepo, mocks.NewMockteamRepository(ctrl), mocks.NewMockuserRepository(ctrl), settingsRepo, setupNoopHistory(ctrl), setupNoopOutbox(ctrl))
+	ctx := context.Background()
+
+	pr := &prs.PullRequest{
+		ID:               "pr-123",
+		Status:           prs.StatusOpen,
+		OriginalTeamName: "team-alpha",
+		ReviewerIDs:      []string{"reviewer-1", "reviewer-2"},
+		ReviewersCount:   2,
+		Reviews: map[string]prs.Review{
+			"reviewer-1": {State: prs.ReviewApproved},
+		},
+	}
+
+	prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+	settingsRepo.EXPECT().Get(ctx, "team-alpha").Return(&teams.Settings{
+		TeamName:  "team-alpha",
+		Strategy:  teams.StrategyDefault,
+		Reviewers: teams.ReviewersPolicy{Default: 2, Min: 2, Max: 2, RequiredApprovals: 2},
+	}, nil)
+
+	result, err := service.Merge(ctx, pr.ID)
+
+	assert.ErrorIs(t, err, errorsx.ErrNotEnoughApprovals)
+	assert.Nil(t, result)
+	assert.Equal(t, prs.StatusOpen, pr.Status)
+}
+
+func TestPullRequestService_Create_FallbackReviewers(t *testing.T) {
+	service, rpicker, prRepo, teamRepo := setupPRTest(t)
+	ctx := context.Background()
+
+	team := &teams.Team{Name: "backend", MemberIDs: []string{"author-1", "user-2"}}
+
+	teamRepo.EXPECT().GetManyByMemberID(ctx, "author-1").Return([]*teams.Team{team}, nil)
+	rpicker.EXPECT().PickReviewersFromTeam(ctx, gomock.Any()).Return([]string{"user-2", "platform-1"}, nil)
+	prRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+
+	result, err := service.Create(ctx, usecases.CreateRequest{ID: "pr-1", Name: "Fix", AuthorID: "author-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user-2", "platform-1"}, result.ReviewerIDs)
+	assert.Equal(t, []string{"platform-1"}, result.FallbackReviewerIDs)
+}
+
+func TestPullRequestService_Get(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	pr := &prs.PullRequest{
+		ID:               "pr-1",
+		Name:             "Add search",
+		Status:           prs.StatusOpen,
+		OriginalTeamName: "backend",
+		AuthorID:         "u1",
+		ReviewerIDs:      []string{"u2", "u3"},
+		ReviewersCount:   2,
+		Reviews: map[string]prs.Review{
+			"u2": {State: prs.ReviewPending, AssignedAt: at, UpdatedAt: at},
+			"u3": {State: prs.ReviewPending, AssignedAt: at.Add(time.Hour), UpdatedAt: at.Add(time.Hour)},
+		},
+	}
+
+	t.Run("enriches author and reviewers", func(t *testing.T) {
+		service, m := setupHistoryTest(t)
+
+		m.prRepo.EXPECT().GetByID(ctx, "pr-1").Return(pr, nil)
+		m.userRepo.EXPECT().GetMany(ctx, "u1", "u2", "u3").Return([]*users.User{
+			{ID: "u1", Name: "Alice", Active: true},
+			{ID: "u2", Name: "Bob", Active: true},
+			{ID: "u3", Name: "Carol", Active: false},
+		}, nil)
+
+		// author and the first reviewer are members of PR's team, the fallback reviewer has a primary team
+		m.teamRepo.EXPECT().GetManyByMemberID(ctx, "u1").Return([]*teams.Team{{Name: "backend"}}, nil)
+		m.teamRepo.EXPECT().GetManyByMemberID(ctx, "u2").Return([]*teams.Team{{Name: "api"}, {Name: "backend"}}, nil)
+		m.teamRepo.EXPECT().GetManyByMemberID(ctx, "u3").Return([]*teams.Team{{Name: "frontend"}, {Name: "mobile"}}, nil)
+		m.teamRepo.EXPECT().GetPrimaryNameByMemberID(ctx, "u3").Return("mobile", nil)
+
+		res, err := service.Get(ctx, "pr-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, pr.ToView(), res.PR)
+		assert.Equal(t, usecases.PullRequestUserView{ID: "u1", Name: "Alice", IsActive: true, TeamName: "backend"}, res.Author)
+		assert.Equal(t, []usecases.PullRequestReviewerView{
+			{
+				PullRequestUserView: usecases.PullRequestUserView{ID: "u2", Name: "Bob", IsActive: true, TeamName: "backend"},
+				AssignedAt:          at,
+			},
+			{
+				PullRequestUserView: usecases.PullRequestUserView{ID: "u3", Name: "Carol", IsActive: false, TeamName: "mobile"},
+				AssignedAt:          at.Add(time.Hour),
+			},
+		}, res.Reviewers)
+	})
+
+	t.Run("user without primary team gets the first team", func(t *testing.T) {
+		service, m := setupHistoryTest(t)
+		draft := &prs.PullRequest{ID: "pr-2", Status: prs.StatusDraft, OriginalTeamName: "backend", AuthorID: "u1"}
+
+		m.prRepo.EXPECT().GetByID(ctx, "pr-2").Return(draft, nil)
+		m.userRepo.EXPECT().GetMany(ctx, "u1").Return([]*users.User{{ID: "u1", Name: "Alice", Active: true}}, nil)
+		m.teamRepo.EXPECT().GetManyByMemberID(ctx, "u1").Return([]*teams.Team{{Name: "api"}, {Name: "frontend"}}, nil)
+		m.teamRepo.EXPECT().GetPrimaryNameByMemberID(ctx, "u1").Return("", errorsx.ErrNotFound)
+
+		res, err := service.Get(ctx, "pr-2")
+
+		require.NoError(t, err)
+		assert.Equal(t, "api", res.Author.TeamName)
+		assert.Empty(t, res.Reviewers)
+	})
+
+	t.Run("pr not found", func(t *testing.T) {
+		service, m := setupHistoryTest(t)
+
+		m.prRepo.EXPECT().GetByID(ctx, "missing").Return(nil, errorsx.ErrNotFound)
+
+		_, err := service.Get(ctx, "missing")
+
+		assert.ErrorIs(t, err, errorsx.ErrNotFound)
+	})
 }
@@ -33,7 +33,9 @@ func minInt(a, b int) int {
 	return a
 }
 
-func (r *RandomReviewerPicker) PickReviewersFromTeam(ctx context.Context, req PickReviewersRequest) ([]string, error) {
+// activeCandidates returns available team members which are not excluded by the request.
+// Returns errorsx.ErrNoCandidate if there are none.
+func activeCandidates(ctx context.Context, userRepo userRepository, req PickReviewersRequest) ([]*users.User, error) {
 	includedIDs := filter(
 		req.Team.MemberIDs,
 		func(id string) bool { return !slices.Contains(req.UserIDsToExclude, id) },
@@ -43,16 +45,25 @@ func (r *RandomReviewerPicker) PickReviewersFromTeam(ctx context.Context, req Pi
 		return nil, errorsx.ErrNoCandidate
 	}
 
-	included, err := r.userRepo.GetMany(ctx, includedIDs...)
+	included, err := userRepo.GetMany(ctx, includedIDs...)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving candidates: %w", err)
 	}
 
-	active := filter(included, func(u *users.User) bool { return u.Active })
+	active := filter(included, func(u *users.User) bool { return u.Available() })
 	if len(active) == 0 {
 		return nil, errorsx.ErrNoCandidate
 	}
 
+	return active, nil
+}
+
+func (r *RandomReviewerPicker) PickReviewersFromTeam(ctx context.Context, req PickReviewersRequest) ([]string, error) {
+	active, err := activeCandidates(ctx, r.userRepo, req)
+	if err != nil {
+		return nil, err
+	}
+
 	countToPick := minInt(req.WantCount, len(active))
 	pickedIDs := make([]string, 0, countToPick)
 
@@ -62,6 +62,28 @@ func TestRandomReviewerPicker_PickReviewersFromTeam(t *testing.T) {
 		assertPickedReviewers(t, result, 2, []string{"user1", "user3"})
 	})
 
+	t.Run("skips users who are out of office", func(t *testing.T) {
+		team := &teams.Team{
+			Name:      "test-team",
+			MemberIDs: []string{"user1", "user2", "user3"},
+		}
+		req := usecases.PickReviewersRequest{
+			Team:      *team,
+			WantCount: 2,
+		}
+
+		userRepo.EXPECT().GetMany(ctx, "user1", "user2", "user3").Return([]*users.User{
+			{ID: "user1", Name: "User 1", Active: true},
+			{ID: "user2", Name: "User 2", Active: true, OutOfOffice: true},
+			{ID: "user3", Name: "User 3", Active: true},
+		}, nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		require.NoError(t, err)
+		assertPickedReviewers(t, result, 2, []string{"user1", "user3"})
+	})
+
 	t.Run("returns error when all team members are excluded", func(t *testing.T) {
 		team := &teams.Team{
 			Name:      "test-team",
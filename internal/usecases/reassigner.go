@@ -0,0 +1,122 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+)
+
+// reviewerReassigner replaces reviewers who can not review anymore, e.g. deactivated users or users who left the team.
+type reviewerReassigner struct {
+	prRepo      prRepository
+	teamRepo    teamRepository
+	rpicker     ReviewerPicker
+	historyRepo historyRepository
+	outboxRepo  outboxRepository
+}
+
+func collectUniqueTeamNames(pullRequests []*PRWithMatchedReviewers) []string {
+	set := make(map[string]struct{})
+	names := make([]string, 0, len(pullRequests))
+
+	for _, entry := range pullRequests {
+		if _, ok := set[entry.PR.OriginalTeamName]; !ok {
+			set[entry.PR.OriginalTeamName] = struct{}{}
+			names = append(names, entry.PR.OriginalTeamName)
+		}
+	}
+
+	return names
+}
+
+// reassign finds all the PR's assigned to set of users, removes those users from their reviewers list
+// and picks replacements from PR's original team. Only PRs of the given team are affected unless teamName is empty.
+// Lack of candidates is not an error, PR stays with fewer reviewers.
+//
+// WARN: method must be called within a transaction.
+func (r *reviewerReassigner) reassign(ctx context.Context, reason prs.AssignmentReason, teamName string, reviewerIDs ...string) error {
+	result, err := r.prRepo.GetAllUnmergedWithAnyOfReviewers(ctx, reviewerIDs...)
+	if err != nil {
+		return fmt.Errorf("retrieving prs with reviewers: %w", err)
+	}
+
+	if teamName != "" {
+		result = filter(result, func(entry *PRWithMatchedReviewers) bool {
+			return entry.PR.OriginalTeamName == teamName
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	teams, err := r.teamRepo.GetManyByNames(ctx, collectUniqueTeamNames(result)...)
+	if err != nil {
+		return fmt.Errorf("retrieving teams: %w", err)
+	}
+
+	pullRequests := make([]*prs.PullRequest, len(result))
+	reviewersBefore := make([][]string, len(result))
+	for i, entry := range result {
+		reviewersBefore[i] = slices.Clone(entry.PR.ReviewerIDs)
+
+		for _, matchedID := range entry.MatchedReviewerIDs {
+			if err := entry.PR.UnassignReviewer(matchedID); err != nil {
+				return fmt.Errorf("unassigning reviewer: %w", err)
+			}
+		}
+
+		// remaining reviewers are excluded as well, so they are not picked twice
+		exclude := append(slices.Clone(entry.MatchedReviewerIDs), entry.PR.AuthorID)
+		exclude = append(exclude, entry.PR.ReviewerIDs...)
+
+		newReviewers, err := r.rpicker.PickReviewersFromTeam(ctx, PickReviewersRequest{
+			UserIDsToExclude: exclude,
+			WantCount:        len(entry.MatchedReviewerIDs),
+			Team:             teams[entry.PR.OriginalTeamName],
+		})
+		if err != nil && !errors.Is(err, errorsx.ErrNoCandidate) {
+			return fmt.Errorf("picking new reviewers: %w", err)
+		}
+
+		for _, reviewer := range newReviewers {
+			if err := entry.PR.AssignReviewer(reviewer); err != nil {
+				return fmt.Errorf("assigning new reviewers: %w", err)
+			}
+		}
+
+		pullRequests[i] = entry.PR
+	}
+
+	if err := r.prRepo.SaveMany(ctx, pullRequests...); err != nil {
+		return fmt.Errorf("saving prs: %w", err)
+	}
+
+	for i, pr := range pullRequests {
+		if err := recordAssignments(ctx, r.historyRepo, r.outboxRepo, pr, reviewersBefore[i], pr.ReviewerIDs, reason); err != nil {
+			return fmt.Errorf("recording assignments: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func newReviewerReassigner(
+	prRepo prRepository,
+	teamRepo teamRepository,
+	rpicker ReviewerPicker,
+	historyRepo historyRepository,
+	outboxRepo outboxRepository,
+) *reviewerReassigner {
+	return &reviewerReassigner{
+		prRepo:      prRepo,
+		teamRepo:    teamRepo,
+		rpicker:     rpicker,
+		historyRepo: historyRepo,
+		outboxRepo:  outboxRepo,
+	}
+}
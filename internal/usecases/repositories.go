@@ -2,19 +2,135 @@ package usecases
 
 import (
 	"context"
+	"time"
 
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/integrations"
 	"github.com/lezzercringe/avito-test-assignment/internal/prs"
 	"github.com/lezzercringe/avito-test-assignment/internal/teams"
 	"github.com/lezzercringe/avito-test-assignment/internal/users"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
 )
 
-//go:generate mockgen -typed -destination ../../mocks/repositories.go -package mocks . teamRepository,prRepository,userRepository
+//go:generate mockgen -typed -destination ../../mocks/repositories.go -package mocks . teamRepository,prRepository,userRepository,teamSettingsRepository,rotationRepository,historyRepository,statsRepository,tokenRepository,outOfOfficeRepository,webhookRepository,outboxRepository,gitAccountRepository,integrationDeliveryRepository,eventListener
 
 type teamRepository interface {
 	GetByName(ctx context.Context, name string) (*teams.Team, error)
 	GetManyByNames(ctx context.Context, names ...string) (map[string]teams.Team, error)
-	GetByMemberID(ctx context.Context, memberID string) (*teams.Team, error)
+	// GetManyByMemberID returns all teams of the user ordered by name.
+	GetManyByMemberID(ctx context.Context, memberID string) ([]*teams.Team, error)
+	// GetPrimaryNameByMemberID returns name of the user's primary team, ErrNotFound is returned if it is not set.
+	GetPrimaryNameByMemberID(ctx context.Context, memberID string) (string, error)
+	// SetPrimary makes the team primary for its member, ErrNotFound is returned if the user is not a member.
+	SetPrimary(ctx context.Context, memberID, teamName string) error
+	// Create inserts new team, ErrAlreadyExists is returned if the team exists.
+	Create(ctx context.Context, t *teams.Team) error
+	// Save upserts the team along with its current list of members.
 	Save(ctx context.Context, t *teams.Team) error
+	// Rename changes team's name along with everything which references the team,
+	// except pull requests and fallback chains of other teams.
+	Rename(ctx context.Context, name, newName string) error
+	// Delete removes the team which has no members.
+	Delete(ctx context.Context, name string) error
+	// GetAllMemberIDs returns ids of users which are members of any team.
+	GetAllMemberIDs(ctx context.Context) ([]string, error)
+	// List returns up to limit teams ordered by name which follow afterName.
+	// Only teams of the member are listed if memberID is not empty.
+	List(ctx context.Context, memberID, afterName string, limit int) ([]*teams.Team, error)
+}
+
+type teamSettingsRepository interface {
+	Get(ctx context.Context, teamName string) (*teams.Settings, error)
+	Save(ctx context.Context, s *teams.Settings) error
+	// RenameFallbackTeam and RemoveFallbackTeam keep fallback chains of all the teams
+	// in line with renamed and deleted teams.
+	RenameFallbackTeam(ctx context.Context, name, newName string) error
+	RemoveFallbackTeam(ctx context.Context, name string) error
+}
+
+// rotationRepository stores the last reviewer picked by round-robin rotation for each team.
+type rotationRepository interface {
+	// LockCursor returns the last picked reviewer and locks team's cursor until the transaction ends.
+	LockCursor(ctx context.Context, teamName string) (string, error)
+	SaveCursor(ctx context.Context, teamName, lastUserID string) error
+}
+
+// historyRepository stores append-only history of reviewer assignments.
+type historyRepository interface {
+	Append(ctx context.Context, events ...prs.AssignmentEvent) error
+	// GetByPullRequestID returns PR's assignment events in chronological order.
+	GetByPullRequestID(ctx context.Context, pullRequestID string) ([]prs.AssignmentEvent, error)
+}
+
+// statsRepository aggregates assignment history.
+type statsRepository interface {
+	GetReviewerStats(ctx context.Context, period StatsPeriod) ([]ReviewerStats, error)
+	GetTeamStats(ctx context.Context, period StatsPeriod) ([]TeamStats, error)
+	// GetTeamLeadTimes and GetReviewerLeadTimes aggregate pull requests by their merge time.
+	GetTeamLeadTimes(ctx context.Context, period StatsPeriod) ([]TeamLeadTime, error)
+	GetReviewerLeadTimes(ctx context.Context, period StatsPeriod) ([]ReviewerLeadTime, error)
+}
+
+// tokenRepository stores hashes of API tokens along with principals they authenticate.
+type tokenRepository interface {
+	GetByHash(ctx context.Context, hash string) (*auth.Principal, error)
+	Create(ctx context.Context, hash string, p *auth.Principal) error
+	Delete(ctx context.Context, name string) error
+}
+
+// webhookRepository stores webhook subscriptions.
+type webhookRepository interface {
+	// Create inserts the subscription and sets its ID.
+	Create(ctx context.Context, s *webhooks.Subscription) error
+	GetAll(ctx context.Context) ([]*webhooks.Subscription, error)
+	// Delete removes the subscription along with its deliveries.
+	Delete(ctx context.Context, id int64) error
+}
+
+// outboxRepository is a transactional outbox of webhook deliveries.
+type outboxRepository interface {
+	// Enqueue creates a delivery for every subscriber of each event's type.
+	// It must be called within the transaction which produced the events.
+	Enqueue(ctx context.Context, events ...webhooks.Event) error
+	// ClaimDue returns pending deliveries whose next attempt is due and postpones their next attempt by lease,
+	// so they are not claimed by others while being attempted.
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*webhooks.Delivery, error)
+	Save(ctx context.Context, d *webhooks.Delivery) error
+}
+
+// eventListener receives events enqueued to the outbox by committed transactions of any replica.
+type eventListener interface {
+	// Listen calls handle for every received event until ctx is done or listening fails.
+	Listen(ctx context.Context, handle func(webhooks.Event)) error
+}
+
+// gitAccountRepository maps logins on Git hosts to users.
+type gitAccountRepository interface {
+	Get(ctx context.Context, host integrations.Host, login string) (*integrations.Account, error)
+	// Save links the login to the user, replacing previous link of the login.
+	Save(ctx context.Context, a *integrations.Account) error
+	Delete(ctx context.Context, host integrations.Host, login string) error
+}
+
+// integrationDeliveryRepository remembers deliveries received from Git hosts.
+type integrationDeliveryRepository interface {
+	// Claim records the delivery and reports false if it has already been recorded.
+	// Claim of the delivery recorded by a concurrent transaction waits for that transaction to end.
+	Claim(ctx context.Context, host integrations.Host, deliveryID string) (bool, error)
+}
+
+// outOfOfficeRepository stores periods when users are not available for reviews.
+type outOfOfficeRepository interface {
+	// Create inserts the period and sets its ID.
+	Create(ctx context.Context, o *users.OutOfOffice) error
+	Get(ctx context.Context, id int64) (*users.OutOfOffice, error)
+	// GetManyByUserID returns user's periods which have not ended yet ordered by start.
+	GetManyByUserID(ctx context.Context, userID string) ([]*users.OutOfOffice, error)
+	Delete(ctx context.Context, id int64) error
+	// LockStartedPendingReassignment returns ongoing periods whose reviews are still to be reassigned
+	// and locks them until the transaction ends. Periods locked by others are skipped.
+	LockStartedPendingReassignment(ctx context.Context) ([]*users.OutOfOffice, error)
+	MarkReassigned(ctx context.Context, ids ...int64) error
 }
 
 type PRWithMatchedReviewers struct {
@@ -22,12 +138,32 @@ type PRWithMatchedReviewers struct {
 	MatchedReviewerIDs []string
 }
 
+// StaleAssignment is a review which stays pending longer than team's review SLA.
+type StaleAssignment struct {
+	PullRequestID string
+	ReviewerID    string
+}
+
 type prRepository interface {
 	GetByID(ctx context.Context, id string) (*prs.PullRequest, error)
 	GetManyByReviewerID(ctx context.Context, reviewerID string) ([]*prs.PullRequest, error)
 	GetAllUnmergedWithAnyOfReviewers(ctx context.Context, reviewerIDs ...string) ([]*PRWithMatchedReviewers, error)
+	// Save and SaveMany upsert pull requests and increment their versions.
+	// ErrConcurrentModification is returned if any of them was saved by someone else since it was read.
 	Save(ctx context.Context, pr *prs.PullRequest) error
 	SaveMany(ctx context.Context, el ...*prs.PullRequest) error
+	// CountOpenReviews returns number of open pull requests assigned to each of the reviewers.
+	// Reviewers without open assignments are omitted from the result.
+	CountOpenReviews(ctx context.Context, reviewerIDs ...string) (map[string]int, error)
+	// MoveUnmergedToTeam changes original team of not yet merged pull requests.
+	MoveUnmergedToTeam(ctx context.Context, fromTeamName, toTeamName string) error
+	CountUnmergedByTeam(ctx context.Context, teamName string) (int, error)
+	// GetStaleAssignments returns pending reviews of open pull requests which exceeded SLA of PR's team,
+	// longest pending go first.
+	GetStaleAssignments(ctx context.Context) ([]StaleAssignment, error)
+	// List returns up to limit pull requests matching the filter, newest first, which follow the cursor.
+	// Zero cursor starts from the newest pull request.
+	List(ctx context.Context, filter PullRequestFilter, after PullRequestCursor, limit int) ([]*prs.PullRequest, error)
 }
 
 type userRepository interface {
@@ -36,4 +172,6 @@ type userRepository interface {
 
 	SaveMany(ctx context.Context, u ...*users.User) error
 	GetMany(ctx context.Context, id ...string) ([]*users.User, error)
+	// List returns up to limit users matching the filter ordered by id which follow afterID.
+	List(ctx context.Context, filter UserFilter, afterID string, limit int) ([]*users.User, error)
 }
@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+var _ ReviewerPicker = &RoundRobinReviewerPicker{}
+
+// RoundRobinReviewerPicker picks active candidates in a fixed rotation order.
+// Rotation continues after the reviewer picked last time for the same team.
+type RoundRobinReviewerPicker struct {
+	userRepo     userRepository
+	rotationRepo rotationRepository
+}
+
+// PickReviewersFromTeam advances the team's rotation cursor unless the request keeps it.
+//
+// WARN: method must be called within a transaction, otherwise concurrent calls may pick the same reviewers.
+func (p *RoundRobinReviewerPicker) PickReviewersFromTeam(ctx context.Context, req PickReviewersRequest) ([]string, error) {
+	lastID, err := p.rotationRepo.LockCursor(ctx, req.Team.Name)
+	if err != nil {
+		return nil, fmt.Errorf("locking rotation cursor: %w", err)
+	}
+
+	active, err := activeCandidates(ctx, p.userRepo, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(active))
+	for i, u := range active {
+		ids[i] = u.ID
+	}
+	slices.Sort(ids)
+
+	start, found := slices.BinarySearch(ids, lastID)
+	if found {
+		start++
+	}
+
+	rotated := append(slices.Clone(ids[start:]), ids[:start]...)
+	picked := rotated[:minInt(req.WantCount, len(rotated))]
+
+	if len(picked) > 0 && !req.KeepRotation {
+		if err := p.rotationRepo.SaveCursor(ctx, req.Team.Name, picked[len(picked)-1]); err != nil {
+			return nil, fmt.Errorf("saving rotation cursor: %w", err)
+		}
+	}
+
+	return picked, nil
+}
+
+func NewRoundRobinReviewerPicker(userRepo userRepository, rotationRepo rotationRepository) *RoundRobinReviewerPicker {
+	return &RoundRobinReviewerPicker{
+		userRepo:     userRepo,
+		rotationRepo: rotationRepo,
+	}
+}
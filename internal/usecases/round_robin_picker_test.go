@@ -0,0 +1,142 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+func setupRoundRobinPickerTest(t *testing.T) (*usecases.RoundRobinReviewerPicker, *mocks.MockuserRepository, *mocks.MockrotationRepository) {
+	ctrl := gomock.NewController(t)
+	userRepo := mocks.NewMockuserRepository(ctrl)
+	rotationRepo := mocks.NewMockrotationRepository(ctrl)
+	picker := usecases.NewRoundRobinReviewerPicker(userRepo, rotationRepo)
+	return picker, userRepo, rotationRepo
+}
+
+func TestRoundRobinReviewerPicker_PickReviewersFromTeam(t *testing.T) {
+	picker, userRepo, rotationRepo := setupRoundRobinPickerTest(t)
+	ctx := context.Background()
+
+	team := teams.Team{
+		Name:      "test-team",
+		MemberIDs: []string{"user3", "user1", "user4", "user2"},
+	}
+	allActive := []*users.User{
+		{ID: "user3", Name: "User 3", Active: true},
+		{ID: "user1", Name: "User 1", Active: true},
+		{ID: "user4", Name: "User 4", Active: true},
+		{ID: "user2", Name: "User 2", Active: true},
+	}
+
+	t.Run("starts rotation from the beginning", func(t *testing.T) {
+		rotationRepo.EXPECT().LockCursor(ctx, team.Name).Return("", nil)
+		userRepo.EXPECT().GetMany(ctx, "user3", "user1", "user4", "user2").Return(allActive, nil)
+		rotationRepo.EXPECT().SaveCursor(ctx, team.Name, "user2").Return(nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			Team:      team,
+			WantCount: 2,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user1", "user2"}, result)
+	})
+
+	t.Run("continues after the last picked reviewer and wraps around", func(t *testing.T) {
+		rotationRepo.EXPECT().LockCursor(ctx, team.Name).Return("user3", nil)
+		userRepo.EXPECT().GetMany(ctx, "user3", "user1", "user4", "user2").Return(allActive, nil)
+		rotationRepo.EXPECT().SaveCursor(ctx, team.Name, "user1").Return(nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			Team:      team,
+			WantCount: 2,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user4", "user1"}, result)
+	})
+
+	t.Run("skips inactive and excluded members", func(t *testing.T) {
+		rotationRepo.EXPECT().LockCursor(ctx, team.Name).Return("user1", nil)
+		userRepo.EXPECT().GetMany(ctx, "user3", "user1", "user4").Return([]*users.User{
+			{ID: "user3", Name: "User 3", Active: false},
+			{ID: "user1", Name: "User 1", Active: true},
+			{ID: "user4", Name: "User 4", Active: true},
+		}, nil)
+		rotationRepo.EXPECT().SaveCursor(ctx, team.Name, "user4").Return(nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"user2"},
+			Team:             team,
+			WantCount:        1,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user4"}, result)
+	})
+
+	t.Run("continues rotation when last picked reviewer left the team", func(t *testing.T) {
+		rotationRepo.EXPECT().LockCursor(ctx, team.Name).Return("user25", nil)
+		userRepo.EXPECT().GetMany(ctx, "user3", "user1", "user4", "user2").Return(allActive, nil)
+		rotationRepo.EXPECT().SaveCursor(ctx, team.Name, "user3").Return(nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			Team:      team,
+			WantCount: 1,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user3"}, result)
+	})
+
+	t.Run("does not advance rotation when request keeps it", func(t *testing.T) {
+		rotationRepo.EXPECT().LockCursor(ctx, team.Name).Return("user1", nil)
+		userRepo.EXPECT().GetMany(ctx, "user3", "user1", "user4", "user2").Return(allActive, nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			Team:         team,
+			WantCount:    1,
+			KeepRotation: true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user2"}, result)
+	})
+
+	t.Run("returns error when there are no active candidates", func(t *testing.T) {
+		rotationRepo.EXPECT().LockCursor(ctx, team.Name).Return("", nil)
+		userRepo.EXPECT().GetMany(ctx, "user3", "user1", "user4", "user2").Return([]*users.User{}, nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			Team:      team,
+			WantCount: 1,
+		})
+
+		assert.Equal(t, errorsx.ErrNoCandidate, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("propagates cursor lock error", func(t *testing.T) {
+		expectedErr := errors.New("lock timeout")
+		rotationRepo.EXPECT().LockCursor(ctx, team.Name).Return("", expectedErr)
+
+		result, err := picker.PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			Team:      team,
+			WantCount: 1,
+		})
+
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, result)
+	})
+}
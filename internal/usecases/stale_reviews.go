@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+)
+
+// StaleReviewActor is recorded as an actor of reassignments made because of exceeded review SLA.
+const StaleReviewActor = "stale-review-scheduler"
+
+type StaleReviewService interface {
+	// ReassignStale replaces reviewers whose reviews stay pending longer than review SLA of PR's team.
+	// It is meant to be run periodically.
+	ReassignStale(ctx context.Context) error
+}
+
+var _ StaleReviewService = &StaleReviewServiceImpl{}
+
+type StaleReviewServiceImpl struct {
+	prRepo    prRepository
+	prService PullRequestService
+}
+
+// ReassignStale reassigns every stale review separately, so failure of one does not prevent the others.
+// Reviews which can not be reassigned, e.g. because the team lacks candidates, are skipped until the next run.
+func (s *StaleReviewServiceImpl) ReassignStale(ctx context.Context) error {
+	stale, err := s.prRepo.GetStaleAssignments(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving stale assignments: %w", err)
+	}
+
+	ctx = WithActor(ctx, StaleReviewActor)
+
+	var errs []error
+	for _, a := range stale {
+		_, err := s.prService.ReassignReviewer(ctx, ReassignReviewerRequest{
+			PullRequestID:    a.PullRequestID,
+			UserIDToReassign: a.ReviewerID,
+		})
+		if err == nil || isStaleReassignmentSkipped(err) {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("reassigning %s on %s: %w", a.ReviewerID, a.PullRequestID, err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// isStaleReassignmentSkipped reports whether the error means the review can not be reassigned right now,
+// including the case when the PR has changed since stale assignments were retrieved.
+func isStaleReassignmentSkipped(err error) bool {
+	return errors.Is(err, errorsx.ErrNoCandidate) ||
+		errors.Is(err, errorsx.ErrNotPreviouslyAssigned) ||
+		errors.Is(err, errorsx.ErrModifyMergedPR) ||
+		errors.Is(err, errorsx.ErrModifyClosedPR) ||
+		errors.Is(err, errorsx.ErrNotFound)
+}
+
+func NewStaleReviewService(prRepo prRepository, prService PullRequestService) *StaleReviewServiceImpl {
+	return &StaleReviewServiceImpl{
+		prRepo:    prRepo,
+		prService: prService,
+	}
+}
@@ -0,0 +1,94 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+func setupStaleReviewTest(t *testing.T) (*usecases.StaleReviewServiceImpl, *mocks.MockprRepository, *mocks.MockPullRequestService) {
+	ctrl := gomock.NewController(t)
+	prRepo := mocks.NewMockprRepository(ctrl)
+	prService := mocks.NewMockPullRequestService(ctrl)
+
+	return usecases.NewStaleReviewService(prRepo, prService), prRepo, prService
+}
+
+func TestStaleReviewService_ReassignStale(t *testing.T) {
+	t.Run("reassigns every stale review on behalf of the scheduler", func(t *testing.T) {
+		service, prRepo, prService := setupStaleReviewTest(t)
+		ctx := context.Background()
+
+		prRepo.EXPECT().GetStaleAssignments(ctx).Return([]usecases.StaleAssignment{
+			{PullRequestID: "pr-1", ReviewerID: "u1"},
+			{PullRequestID: "pr-2", ReviewerID: "u2"},
+		}, nil)
+
+		var reassigned []usecases.ReassignReviewerRequest
+		prService.EXPECT().ReassignReviewer(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, req usecases.ReassignReviewerRequest) (*usecases.ReassignReviewerResult, error) {
+				assert.Equal(t, usecases.StaleReviewActor, usecases.ActorFromContext(ctx))
+				reassigned = append(reassigned, req)
+				return &usecases.ReassignReviewerResult{}, nil
+			},
+		).Times(2)
+
+		require.NoError(t, service.ReassignStale(ctx))
+		assert.Equal(t, []usecases.ReassignReviewerRequest{
+			{PullRequestID: "pr-1", UserIDToReassign: "u1"},
+			{PullRequestID: "pr-2", UserIDToReassign: "u2"},
+		}, reassigned)
+	})
+
+	t.Run("reviews without candidates are skipped", func(t *testing.T) {
+		service, prRepo, prService := setupStaleReviewTest(t)
+		ctx := context.Background()
+
+		prRepo.EXPECT().GetStaleAssignments(ctx).Return([]usecases.StaleAssignment{
+			{PullRequestID: "pr-1", ReviewerID: "u1"},
+			{PullRequestID: "pr-2", ReviewerID: "u2"},
+		}, nil)
+		prService.EXPECT().ReassignReviewer(gomock.Any(), usecases.ReassignReviewerRequest{PullRequestID: "pr-1", UserIDToReassign: "u1"}).
+			Return(nil, fmt.Errorf("picking new reviewer: %w", errorsx.ErrNoCandidate))
+		prService.EXPECT().ReassignReviewer(gomock.Any(), usecases.ReassignReviewerRequest{PullRequestID: "pr-2", UserIDToReassign: "u2"}).
+			Return(&usecases.ReassignReviewerResult{}, nil)
+
+		require.NoError(t, service.ReassignStale(ctx))
+	})
+
+	t.Run("failure does not stop other reassignments", func(t *testing.T) {
+		service, prRepo, prService := setupStaleReviewTest(t)
+		ctx := context.Background()
+
+		prRepo.EXPECT().GetStaleAssignments(ctx).Return([]usecases.StaleAssignment{
+			{PullRequestID: "pr-1", ReviewerID: "u1"},
+			{PullRequestID: "pr-2", ReviewerID: "u2"},
+		}, nil)
+		prService.EXPECT().ReassignReviewer(gomock.Any(), usecases.ReassignReviewerRequest{PullRequestID: "pr-1", UserIDToReassign: "u1"}).
+			Return(nil, errors.New("db error"))
+		prService.EXPECT().ReassignReviewer(gomock.Any(), usecases.ReassignReviewerRequest{PullRequestID: "pr-2", UserIDToReassign: "u2"}).
+			Return(&usecases.ReassignReviewerResult{}, nil)
+
+		err := service.ReassignStale(ctx)
+
+		assert.ErrorContains(t, err, "db error")
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		service, prRepo, _ := setupStaleReviewTest(t)
+		ctx := context.Background()
+
+		prRepo.EXPECT().GetStaleAssignments(ctx).Return(nil, errors.New("db error"))
+
+		assert.Error(t, service.ReassignStale(ctx))
+	})
+}
@@ -0,0 +1,131 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+)
+
+// StatsPeriod bounds assignment events taken into account, zero bound means unbounded.
+type StatsPeriod struct {
+	From time.Time
+	To   time.Time
+}
+
+// ReviewerStats counts assignment events of a single reviewer.
+type ReviewerStats struct {
+	UserID   string
+	Username string
+	Assigned int
+	// Open is number of pull requests under review which are still assigned to the reviewer.
+	Open           int
+	Merged         int
+	ReassignedAway int
+}
+
+// TeamStats counts assignment events on pull requests of a team.
+type TeamStats struct {
+	TeamName       string
+	PullRequests   int
+	Assigned       int
+	Open           int
+	Merged         int
+	ReassignedAway int
+}
+
+// LeadTime summarizes time from creation to merge of merged pull requests.
+type LeadTime struct {
+	Merged int
+	P50    time.Duration
+	P90    time.Duration
+}
+
+type TeamLeadTime struct {
+	TeamName string
+	LeadTime
+}
+
+// ReviewerLeadTime takes into account pull requests which were merged with the reviewer assigned.
+type ReviewerLeadTime struct {
+	UserID   string
+	Username string
+	LeadTime
+}
+
+type LeadTimes struct {
+	Teams     []TeamLeadTime
+	Reviewers []ReviewerLeadTime
+}
+
+type StatsService interface {
+	GetReviewerStats(ctx context.Context, period StatsPeriod) ([]ReviewerStats, error)
+	GetTeamStats(ctx context.Context, period StatsPeriod) ([]TeamStats, error)
+	// GetLeadTimes reports time to merge of pull requests merged within the period.
+	GetLeadTimes(ctx context.Context, period StatsPeriod) (*LeadTimes, error)
+}
+
+var _ StatsService = &StatsServiceImpl{}
+
+type StatsServiceImpl struct {
+	statsRepo statsRepository
+}
+
+func checkPeriod(period StatsPeriod) error {
+	if !period.From.IsZero() && !period.To.IsZero() && period.To.Before(period.From) {
+		return errorsx.ErrPeriod
+	}
+	return nil
+}
+
+func (s *StatsServiceImpl) GetReviewerStats(ctx context.Context, period StatsPeriod) ([]ReviewerStats, error) {
+	if err := checkPeriod(period); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsRepo.GetReviewerStats(ctx, period)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving reviewer stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (s *StatsServiceImpl) GetTeamStats(ctx context.Context, period StatsPeriod) ([]TeamStats, error) {
+	if err := checkPeriod(period); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsRepo.GetTeamStats(ctx, period)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving team stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (s *StatsServiceImpl) GetLeadTimes(ctx context.Context, period StatsPeriod) (*LeadTimes, error) {
+	if err := checkPeriod(period); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.statsRepo.GetTeamLeadTimes(ctx, period)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving team lead times: %w", err)
+	}
+
+	reviewers, err := s.statsRepo.GetReviewerLeadTimes(ctx, period)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving reviewer lead times: %w", err)
+	}
+
+	return &LeadTimes{
+		Teams:     teams,
+		Reviewers: reviewers,
+	}, nil
+}
+
+func NewStatsService(statsRepo statsRepository) *StatsServiceImpl {
+	return &StatsServiceImpl{statsRepo: statsRepo}
+}
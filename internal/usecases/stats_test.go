@@ -0,0 +1,116 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+func setupStatsTest(t *testing.T) (*usecases.StatsServiceImpl, *mocks.MockstatsRepository) {
+	ctrl := gomock.NewController(t)
+	statsRepo := mocks.NewMockstatsRepository(ctrl)
+	return usecases.NewStatsService(statsRepo), statsRepo
+}
+
+func TestStatsService_GetReviewerStats(t *testing.T) {
+	service, statsRepo := setupStatsTest(t)
+	ctx := context.Background()
+
+	t.Run("unbounded period", func(t *testing.T) {
+		want := []usecases.ReviewerStats{
+			{UserID: "u1", Username: "Alice", Assigned: 3, Open: 1, Merged: 1, ReassignedAway: 1},
+		}
+		statsRepo.EXPECT().GetReviewerStats(ctx, usecases.StatsPeriod{}).Return(want, nil)
+
+		result, err := service.GetReviewerStats(ctx, usecases.StatsPeriod{})
+
+		require.NoError(t, err)
+		assert.Equal(t, want, result)
+	})
+
+	t.Run("period ends before it starts", func(t *testing.T) {
+		now := time.Now()
+
+		result, err := service.GetReviewerStats(ctx, usecases.StatsPeriod{From: now, To: now.Add(-time.Hour)})
+
+		assert.ErrorIs(t, err, errorsx.ErrPeriod)
+		assert.Nil(t, result)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		statsRepo.EXPECT().GetReviewerStats(ctx, gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := service.GetReviewerStats(ctx, usecases.StatsPeriod{})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "retrieving reviewer stats")
+	})
+}
+
+func TestStatsService_GetTeamStats(t *testing.T) {
+	service, statsRepo := setupStatsTest(t)
+	ctx := context.Background()
+
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	period := usecases.StatsPeriod{From: from}
+	want := []usecases.TeamStats{
+		{TeamName: "backend", PullRequests: 2, Assigned: 4, Open: 2, Merged: 2},
+	}
+	statsRepo.EXPECT().GetTeamStats(ctx, period).Return(want, nil)
+
+	result, err := service.GetTeamStats(ctx, period)
+
+	require.NoError(t, err)
+	assert.Equal(t, want, result)
+}
+
+func TestStatsService_GetLeadTimes(t *testing.T) {
+	service, statsRepo := setupStatsTest(t)
+	ctx := context.Background()
+
+	t.Run("teams and reviewers", func(t *testing.T) {
+		period := usecases.StatsPeriod{From: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)}
+		teams := []usecases.TeamLeadTime{
+			{TeamName: "backend", LeadTime: usecases.LeadTime{Merged: 2, P50: time.Hour, P90: 3 * time.Hour}},
+		}
+		reviewers := []usecases.ReviewerLeadTime{
+			{UserID: "u1", Username: "Alice", LeadTime: usecases.LeadTime{Merged: 1, P50: time.Hour, P90: time.Hour}},
+		}
+		statsRepo.EXPECT().GetTeamLeadTimes(ctx, period).Return(teams, nil)
+		statsRepo.EXPECT().GetReviewerLeadTimes(ctx, period).Return(reviewers, nil)
+
+		result, err := service.GetLeadTimes(ctx, period)
+
+		require.NoError(t, err)
+		assert.Equal(t, &usecases.LeadTimes{Teams: teams, Reviewers: reviewers}, result)
+	})
+
+	t.Run("period ends before it starts", func(t *testing.T) {
+		now := time.Now()
+
+		result, err := service.GetLeadTimes(ctx, usecases.StatsPeriod{From: now, To: now.Add(-time.Hour)})
+
+		assert.ErrorIs(t, err, errorsx.ErrPeriod)
+		assert.Nil(t, result)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		statsRepo.EXPECT().GetTeamLeadTimes(ctx, gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := service.GetLeadTimes(ctx, usecases.StatsPeriod{})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "retrieving team lead times")
+	})
+}
@@ -0,0 +1,126 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+)
+
+var _ ReviewerPicker = &StrategyReviewerPicker{}
+
+// StrategyReviewerPicker delegates picking to the picker configured in team settings.
+// Missing reviewers are picked among members of team's fallback teams in order,
+// and then among members of other teams if the team allows it.
+type StrategyReviewerPicker struct {
+	settingsRepo  teamSettingsRepository
+	teamRepo      teamRepository
+	defaultPicker ReviewerPicker
+	pickers       map[teams.Strategy]ReviewerPicker
+}
+
+func (p *StrategyReviewerPicker) pickerFor(strategy teams.Strategy) ReviewerPicker {
+	if picker, ok := p.pickers[strategy]; ok {
+		return picker
+	}
+	return p.defaultPicker
+}
+
+func (p *StrategyReviewerPicker) PickReviewersFromTeam(ctx context.Context, req PickReviewersRequest) ([]string, error) {
+	settings, err := getTeamSettings(ctx, p.settingsRepo, req.Team.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving team settings: %w", err)
+	}
+
+	picker := p.pickerFor(settings.Strategy)
+
+	picked, err := picker.PickReviewersFromTeam(ctx, req)
+	if err != nil && !errors.Is(err, errorsx.ErrNoCandidate) {
+		return nil, err
+	}
+
+	if len(picked) >= req.WantCount || (len(settings.FallbackTeamNames) == 0 && !settings.AllowOtherTeams) {
+		return picked, err
+	}
+
+	// fallback candidates are picked with their own team's name, so rotation of that team continues over them,
+	// while candidates from the rest of the teams do not affect any rotation
+	pickFrom := func(teamName string, memberIDs []string, keepRotation bool) error {
+		candidateIDs := filter(memberIDs, func(id string) bool {
+			return !slices.Contains(picked, id)
+		})
+
+		extra, err := picker.PickReviewersFromTeam(ctx, PickReviewersRequest{
+			UserIDsToExclude: req.UserIDsToExclude,
+			WantCount:        req.WantCount - len(picked),
+			Team:             teams.Team{Name: teamName, MemberIDs: candidateIDs},
+			KeepRotation:     keepRotation,
+		})
+		if err != nil && !errors.Is(err, errorsx.ErrNoCandidate) {
+			return err
+		}
+		picked = append(picked, extra...)
+		return nil
+	}
+
+	if len(settings.FallbackTeamNames) > 0 {
+		fallbackTeams, err := p.teamRepo.GetManyByNames(ctx, settings.FallbackTeamNames...)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving fallback teams: %w", err)
+		}
+
+		for _, name := range settings.FallbackTeamNames {
+			if len(picked) >= req.WantCount {
+				break
+			}
+
+			team, ok := fallbackTeams[name]
+			if !ok {
+				continue
+			}
+
+			if err := pickFrom(team.Name, team.MemberIDs, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if settings.AllowOtherTeams && len(picked) < req.WantCount {
+		allMemberIDs, err := p.teamRepo.GetAllMemberIDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving members of other teams: %w", err)
+		}
+
+		otherIDs := filter(allMemberIDs, func(id string) bool {
+			return !slices.Contains(req.Team.MemberIDs, id)
+		})
+
+		if err := pickFrom(req.Team.Name, otherIDs, true); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(picked) == 0 {
+		return nil, errorsx.ErrNoCandidate
+	}
+
+	return picked, nil
+}
+
+// NewStrategyReviewerPicker creates picker which uses defaultPicker for teams with default or unregistered strategy.
+func NewStrategyReviewerPicker(
+	settingsRepo teamSettingsRepository,
+	teamRepo teamRepository,
+	defaultPicker ReviewerPicker,
+	pickers map[teams.Strategy]ReviewerPicker,
+) *StrategyReviewerPicker {
+	return &StrategyReviewerPicker{
+		settingsRepo:  settingsRepo,
+		teamRepo:      teamRepo,
+		defaultPicker: defaultPicker,
+		pickers:       pickers,
+	}
+}
@@ -0,0 +1,225 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+type strategyPickerMocks struct {
+	settingsRepo *mocks.MockteamSettingsRepository
+	teamRepo     *mocks.MockteamRepository
+	random       *mocks.MockReviewerPicker
+	leastLoaded  *mocks.MockReviewerPicker
+}
+
+func setupStrategyPickerTest(t *testing.T) (*usecases.StrategyReviewerPicker, strategyPickerMocks) {
+	ctrl := gomock.NewController(t)
+
+	m := strategyPickerMocks{
+		settingsRepo: mocks.NewMockteamSettingsRepository(ctrl),
+		teamRepo:     mocks.NewMockteamRepository(ctrl),
+		random:       mocks.NewMockReviewerPicker(ctrl),
+		leastLoaded:  mocks.NewMockReviewerPicker(ctrl),
+	}
+
+	picker := usecases.NewStrategyReviewerPicker(m.settingsRepo, m.teamRepo, m.random, map[teams.Strategy]usecases.ReviewerPicker{
+		teams.StrategyRandom:      m.random,
+		teams.StrategyLeastLoaded: m.leastLoaded,
+	})
+
+	return picker, m
+}
+
+func TestStrategyReviewerPicker_PickReviewersFromTeam(t *testing.T) {
+	picker, m := setupStrategyPickerTest(t)
+	ctx := context.Background()
+
+	team := teams.Team{
+		Name:      "test-team",
+		MemberIDs: []string{"author", "user1", "user2"},
+	}
+	req := usecases.PickReviewersRequest{
+		UserIDsToExclude: []string{"author"},
+		Team:             team,
+		WantCount:        2,
+	}
+
+	t.Run("uses default picker for not configured team", func(t *testing.T) {
+		m.settingsRepo.EXPECT().Get(ctx, team.Name).Return(nil, errorsx.ErrNotFound)
+		m.random.EXPECT().PickReviewersFromTeam(ctx, req).Return([]string{"user1", "user2"}, nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user1", "user2"}, result)
+	})
+
+	t.Run("uses picker configured for team", func(t *testing.T) {
+		m.settingsRepo.EXPECT().Get(ctx, team.Name).Return(&teams.Settings{
+			TeamName:  team.Name,
+			Strategy:  teams.StrategyLeastLoaded,
+			Reviewers: teams.ReviewersPolicy{Default: 2, Max: 2},
+		}, nil)
+		m.leastLoaded.EXPECT().PickReviewersFromTeam(ctx, req).Return([]string{"user2", "user1"}, nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user2", "user1"}, result)
+	})
+
+	t.Run("does not look into other teams unless allowed", func(t *testing.T) {
+		m.settingsRepo.EXPECT().Get(ctx, team.Name).Return(teams.DefaultSettings(team.Name), nil)
+		m.random.EXPECT().PickReviewersFromTeam(ctx, req).Return(nil, errorsx.ErrNoCandidate)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		assert.Equal(t, errorsx.ErrNoCandidate, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("tops up reviewers from other teams when allowed", func(t *testing.T) {
+		m.settingsRepo.EXPECT().Get(ctx, team.Name).Return(&teams.Settings{
+			TeamName:        team.Name,
+			Strategy:        teams.StrategyRandom,
+			Reviewers:       teams.ReviewersPolicy{Default: 2, Max: 2},
+			AllowOtherTeams: true,
+		}, nil)
+		m.random.EXPECT().PickReviewersFromTeam(ctx, req).Return([]string{"user1"}, nil)
+		m.teamRepo.EXPECT().GetAllMemberIDs(ctx).Return([]string{"author", "outsider", "user1", "user2"}, nil)
+		m.random.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"author"},
+			WantCount:        1,
+			Team:             teams.Team{Name: team.Name, MemberIDs: []string{"outsider"}},
+			KeepRotation:     true,
+		}).Return([]string{"outsider"}, nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user1", "outsider"}, result)
+	})
+
+	t.Run("returns error when nobody is found in any team", func(t *testing.T) {
+		m.settingsRepo.EXPECT().Get(ctx, team.Name).Return(&teams.Settings{
+			TeamName:        team.Name,
+			Strategy:        teams.StrategyRandom,
+			Reviewers:       teams.ReviewersPolicy{Default: 2, Max: 2},
+			AllowOtherTeams: true,
+		}, nil)
+		m.random.EXPECT().PickReviewersFromTeam(ctx, req).Return(nil, errorsx.ErrNoCandidate)
+		m.teamRepo.EXPECT().GetAllMemberIDs(ctx).Return([]string{"author", "user1", "user2"}, nil)
+		m.random.EXPECT().PickReviewersFromTeam(ctx, gomock.Any()).Return(nil, errorsx.ErrNoCandidate)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		assert.Equal(t, errorsx.ErrNoCandidate, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestStrategyReviewerPicker_FallbackTeams(t *testing.T) {
+	ctx := context.Background()
+
+	team := teams.Team{Name: "backend", MemberIDs: []string{"author", "user1"}}
+	req := usecases.PickReviewersRequest{
+		UserIDsToExclude: []string{"author"},
+		Team:             team,
+		WantCount:        3,
+	}
+	settings := &teams.Settings{
+		TeamName:          team.Name,
+		Strategy:          teams.StrategyRandom,
+		Reviewers:         teams.ReviewersPolicy{Default: 3, Max: 3},
+		FallbackTeamNames: []string{"platform", "sre"},
+	}
+	fallbackTeams := map[string]teams.Team{
+		"platform": {Name: "platform", MemberIDs: []string{"user1", "platform1"}},
+		"sre":      {Name: "sre", MemberIDs: []string{"sre1", "sre2"}},
+	}
+
+	t.Run("walks the chain until wanted count is met", func(t *testing.T) {
+		picker, m := setupStrategyPickerTest(t)
+
+		m.settingsRepo.EXPECT().Get(ctx, team.Name).Return(settings, nil)
+		m.teamRepo.EXPECT().GetManyByNames(ctx, "platform", "sre").Return(fallbackTeams, nil)
+		gomock.InOrder(
+			m.random.EXPECT().PickReviewersFromTeam(ctx, req).Return([]string{"user1"}, nil),
+			m.random.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+				UserIDsToExclude: []string{"author"},
+				WantCount:        2,
+				Team:             teams.Team{Name: "platform", MemberIDs: []string{"platform1"}},
+			}).Return([]string{"platform1"}, nil),
+			m.random.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+				UserIDsToExclude: []string{"author"},
+				WantCount:        1,
+				Team:             teams.Team{Name: "sre", MemberIDs: []string{"sre1", "sre2"}},
+			}).Return([]string{"sre2"}, nil),
+		)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user1", "platform1", "sre2"}, result)
+	})
+
+	t.Run("team itself has no candidates", func(t *testing.T) {
+		picker, m := setupStrategyPickerTest(t)
+
+		m.settingsRepo.EXPECT().Get(ctx, team.Name).Return(settings, nil)
+		m.teamRepo.EXPECT().GetManyByNames(ctx, "platform", "sre").Return(fallbackTeams, nil)
+		m.random.EXPECT().PickReviewersFromTeam(ctx, req).Return(nil, errorsx.ErrNoCandidate)
+		m.random.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"author"},
+			WantCount:        3,
+			Team:             teams.Team{Name: "platform", MemberIDs: []string{"user1", "platform1"}},
+		}).Return([]string{"user1", "platform1"}, nil)
+		m.random.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"author"},
+			WantCount:        1,
+			Team:             teams.Team{Name: "sre", MemberIDs: []string{"sre1", "sre2"}},
+		}).Return([]string{"sre1"}, nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user1", "platform1", "sre1"}, result)
+	})
+
+	t.Run("falls back to other teams after the chain", func(t *testing.T) {
+		picker, m := setupStrategyPickerTest(t)
+		withOthers := *settings
+		withOthers.FallbackTeamNames = []string{"platform"}
+		withOthers.AllowOtherTeams = true
+
+		m.settingsRepo.EXPECT().Get(ctx, team.Name).Return(&withOthers, nil)
+		m.teamRepo.EXPECT().GetManyByNames(ctx, "platform").Return(fallbackTeams, nil)
+		m.random.EXPECT().PickReviewersFromTeam(ctx, req).Return([]string{"user1"}, nil)
+		m.random.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"author"},
+			WantCount:        2,
+			Team:             teams.Team{Name: "platform", MemberIDs: []string{"platform1"}},
+		}).Return([]string{"platform1"}, nil)
+		m.teamRepo.EXPECT().GetAllMemberIDs(ctx).Return([]string{"author", "outsider", "platform1", "user1"}, nil)
+		m.random.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
+			UserIDsToExclude: []string{"author"},
+			WantCount:        1,
+			Team:             teams.Team{Name: team.Name, MemberIDs: []string{"outsider"}},
+			KeepRotation:     true,
+		}).Return([]string{"outsider"}, nil)
+
+		result, err := picker.PickReviewersFromTeam(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user1", "platform1", "outsider"}, result)
+	})
+}
@@ -0,0 +1,134 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+)
+
+func TestTeamService_RenameTeam(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rename", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha", MemberIDs: []string{"u1"}}, nil)
+		m.teamRepo.EXPECT().Rename(ctx, "alpha", "omega").Return(nil)
+		m.settingsRepo.EXPECT().RenameFallbackTeam(ctx, "alpha", "omega").Return(nil)
+		m.prRepo.EXPECT().MoveUnmergedToTeam(ctx, "alpha", "omega").Return(nil)
+		m.userRepo.EXPECT().GetMany(ctx, "u1").Return([]*users.User{{ID: "u1", Name: "Alice", Active: true}}, nil)
+
+		result, err := service.RenameTeam(ctx, usecases.RenameTeamRequest{Name: "alpha", NewName: "omega"})
+
+		require.NoError(t, err)
+		assertTeamView(t, result, "omega", []usecases.TeamMemberView{{ID: "u1", Name: "Alice", Active: true}})
+	})
+
+	t.Run("name is taken", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha"}, nil)
+		m.teamRepo.EXPECT().Rename(ctx, "alpha", "beta").Return(errorsx.ErrAlreadyExists)
+
+		result, err := service.RenameTeam(ctx, usecases.RenameTeamRequest{Name: "alpha", NewName: "beta"})
+
+		assert.ErrorIs(t, err, errorsx.ErrAlreadyExists)
+		assert.Nil(t, result)
+	})
+
+	t.Run("empty new name", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha"}, nil)
+
+		result, err := service.RenameTeam(ctx, usecases.RenameTeamRequest{Name: "alpha", NewName: ""})
+
+		assert.ErrorIs(t, err, errorsx.ErrTeamName)
+		assert.Nil(t, result)
+	})
+
+	t.Run("team lead of another team", func(t *testing.T) {
+		service, _ := setupMembershipTest(t)
+
+		result, err := service.RenameTeam(withPrincipal(auth.RoleTeamLead, "beta"), usecases.RenameTeamRequest{Name: "alpha", NewName: "omega"})
+
+		assert.ErrorIs(t, err, errorsx.ErrForbidden)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTeamService_DeleteTeam(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty team", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha"}, nil)
+		m.prRepo.EXPECT().CountUnmergedByTeam(ctx, "alpha").Return(0, nil)
+		m.teamRepo.EXPECT().Delete(ctx, "alpha").Return(nil)
+		m.settingsRepo.EXPECT().RemoveFallbackTeam(ctx, "alpha").Return(nil)
+
+		require.NoError(t, service.DeleteTeam(ctx, usecases.DeleteTeamRequest{Name: "alpha"}))
+	})
+
+	t.Run("team with members", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha", MemberIDs: []string{"u1"}}, nil)
+
+		err := service.DeleteTeam(ctx, usecases.DeleteTeamRequest{Name: "alpha"})
+
+		assert.ErrorIs(t, err, errorsx.ErrTeamNotEmpty)
+	})
+
+	t.Run("team with unmerged pull requests", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha"}, nil)
+		m.prRepo.EXPECT().CountUnmergedByTeam(ctx, "alpha").Return(2, nil)
+
+		err := service.DeleteTeam(ctx, usecases.DeleteTeamRequest{Name: "alpha"})
+
+		assert.ErrorIs(t, err, errorsx.ErrTeamNotEmpty)
+	})
+
+	t.Run("members moved to target team", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha", MemberIDs: []string{"u1", "u2"}}, nil)
+		m.teamRepo.EXPECT().GetByName(ctx, "beta").Return(&teams.Team{Name: "beta", MemberIDs: []string{"u3"}}, nil)
+		m.teamRepo.EXPECT().Save(ctx, &teams.Team{Name: "alpha", MemberIDs: []string{}}).Return(nil)
+		m.teamRepo.EXPECT().Save(ctx, &teams.Team{Name: "beta", MemberIDs: []string{"u3", "u1", "u2"}}).Return(nil)
+		m.prRepo.EXPECT().MoveUnmergedToTeam(ctx, "alpha", "beta").Return(nil)
+		m.teamRepo.EXPECT().Delete(ctx, "alpha").Return(nil)
+		m.settingsRepo.EXPECT().RemoveFallbackTeam(ctx, "alpha").Return(nil)
+
+		require.NoError(t, service.DeleteTeam(ctx, usecases.DeleteTeamRequest{Name: "alpha", TargetTeamName: "beta"}))
+	})
+
+	t.Run("target is the same team", func(t *testing.T) {
+		service, m := setupMembershipTest(t)
+
+		m.teamRepo.EXPECT().GetByName(ctx, "alpha").Return(&teams.Team{Name: "alpha"}, nil).Times(2)
+
+		err := service.DeleteTeam(ctx, usecases.DeleteTeamRequest{Name: "alpha", TargetTeamName: "alpha"})
+
+		assert.ErrorIs(t, err, errorsx.ErrSameTeam)
+	})
+
+	t.Run("team lead", func(t *testing.T) {
+		service, _ := setupMembershipTest(t)
+
+		err := service.DeleteTeam(withPrincipal(auth.RoleTeamLead, "alpha"), usecases.DeleteTeamRequest{Name: "alpha"})
+
+		assert.ErrorIs(t, err, errorsx.ErrForbidden)
+	})
+}
@@ -2,8 +2,12 @@ package usecases
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
 	"github.com/lezzercringe/avito-test-assignment/internal/teams"
 	"github.com/lezzercringe/avito-test-assignment/internal/users"
 )
@@ -19,20 +23,113 @@ type TeamView struct {
 	Members []TeamMemberView
 }
 
+type TeamSettingsView struct {
+	TeamName          string
+	Strategy          string
+	ReviewersCount    int
+	MinReviewersCount int
+	MaxReviewersCount int
+	RequiredApprovals int
+	AllowOtherTeams   bool
+	FallbackTeamNames []string
+	ReviewSLA         time.Duration
+}
+
+type AddMemberRequest struct {
+	TeamName string
+	Member   TeamMemberView
+}
+
+type RemoveMemberRequest struct {
+	TeamName string
+	UserID   string
+}
+
+type MoveMemberRequest struct {
+	UserID       string
+	FromTeamName string
+	ToTeamName   string
+}
+
+type RenameTeamRequest struct {
+	Name    string
+	NewName string
+}
+
+type DeleteTeamRequest struct {
+	Name string
+	// TargetTeamName receives members and unmerged pull requests of the deleted team.
+	// If empty, the team must have neither.
+	TargetTeamName string
+}
+
+type ListTeamsRequest struct {
+	// MemberID lists only teams of the user when set.
+	MemberID string
+	Page     PageRequest
+}
+
+// teamCursor is the sort key of the last listed team.
+type teamCursor struct {
+	Name string `json:"name"`
+}
+
 type TeamService interface {
 	GetTeam(ctx context.Context, name string) (*TeamView, error)
+	// ListTeams returns teams with their members ordered by name.
+	ListTeams(ctx context.Context, req ListTeamsRequest) (*Page[*TeamView], error)
 	AddTeam(ctx context.Context, req TeamView) (*TeamView, error)
+	AddMember(ctx context.Context, req AddMemberRequest) (*TeamView, error)
+	RemoveMember(ctx context.Context, req RemoveMemberRequest) (*TeamView, error)
+	MoveMember(ctx context.Context, req MoveMemberRequest) (*TeamView, error)
+	RenameTeam(ctx context.Context, req RenameTeamRequest) (*TeamView, error)
+	DeleteTeam(ctx context.Context, req DeleteTeamRequest) error
+	GetSettings(ctx context.Context, teamName string) (*TeamSettingsView, error)
+	SetSettings(ctx context.Context, req TeamSettingsView) (*TeamSettingsView, error)
 }
 
 var _ TeamService = &TeamServiceImpl{}
 
 type TeamServiceImpl struct {
-	teamRepo  teamRepository
-	userRepo  userRepository
-	txManager TxManager
+	teamRepo     teamRepository
+	userRepo     userRepository
+	settingsRepo teamSettingsRepository
+	prRepo       prRepository
+	txManager    TxManager
+	reassigner   *reviewerReassigner
+}
+
+// getTeamSettings returns stored team settings or defaults if the team was never configured.
+func getTeamSettings(ctx context.Context, settingsRepo teamSettingsRepository, teamName string) (*teams.Settings, error) {
+	settings, err := settingsRepo.Get(ctx, teamName)
+	if errors.Is(err, errorsx.ErrNotFound) {
+		return teams.DefaultSettings(teamName), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func settingsIntoView(s *teams.Settings) *TeamSettingsView {
+	return &TeamSettingsView{
+		TeamName:          s.TeamName,
+		Strategy:          string(s.Strategy),
+		ReviewersCount:    s.Reviewers.Default,
+		MinReviewersCount: s.Reviewers.Min,
+		MaxReviewersCount: s.Reviewers.Max,
+		RequiredApprovals: s.Reviewers.RequiredApprovals,
+		AllowOtherTeams:   s.AllowOtherTeams,
+		FallbackTeamNames: s.FallbackTeamNames,
+		ReviewSLA:         s.ReviewSLA,
+	}
 }
 
 func (s *TeamServiceImpl) AddTeam(ctx context.Context, req TeamView) (*TeamView, error) {
+	if err := authorizeTeam(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
 	var memberIDs []string
 	for _, member := range req.Members {
 		memberIDs = append(memberIDs, member.ID)
@@ -63,15 +160,288 @@ func (s *TeamServiceImpl) AddTeam(ctx context.Context, req TeamView) (*TeamView,
 		return nil, err
 	}
 
+	if err := s.teamRepo.Create(ctx, team); err != nil {
+		return nil, fmt.Errorf("creating team: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commiting tx: %w", err)
+	}
+
+	return &req, nil
+}
+
+// AddMember adds the user to the team, creating the user if it does not exist.
+// Users may be members of several teams.
+func (s *TeamServiceImpl) AddMember(ctx context.Context, req AddMemberRequest) (*TeamView, error) {
+	if err := authorizeTeam(ctx, req.TeamName); err != nil {
+		return nil, err
+	}
+
+	user, err := users.New(req.Member.ID, req.Member.Name, req.Member.Active)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.txManager.WithTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting tx: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	team, err := s.teamRepo.GetByName(ctx, req.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving team: %w", err)
+	}
+
+	if err := team.AddMember(user.ID); err != nil {
+		return nil, err
+	}
+
+	// existing user is kept as is, it may be added only by a manager of one of its teams
+	existing, err := s.userRepo.Get(ctx, user.ID)
+	switch {
+	case err == nil:
+		if err := authorizeMembers(ctx, s.teamRepo, existing.ID); err != nil {
+			return nil, err
+		}
+	case errors.Is(err, errorsx.ErrNotFound):
+		if err := s.userRepo.Save(ctx, user); err != nil {
+			return nil, fmt.Errorf("saving user: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("retrieving user: %w", err)
+	}
+
 	if err := s.teamRepo.Save(ctx, team); err != nil {
 		return nil, fmt.Errorf("saving team: %w", err)
 	}
 
+	view, err := s.teamIntoView(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, fmt.Errorf("commiting tx: %w", err)
 	}
 
-	return &req, nil
+	return view, nil
+}
+
+// RemoveMember removes the user from the team and reassigns its open reviews of the team's pull requests.
+func (s *TeamServiceImpl) RemoveMember(ctx context.Context, req RemoveMemberRequest) (*TeamView, error) {
+	if err := authorizeTeam(ctx, req.TeamName); err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.txManager.WithTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting tx: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	team, err := s.teamRepo.GetByName(ctx, req.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving team: %w", err)
+	}
+
+	if err := team.RemoveMember(req.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := s.teamRepo.Save(ctx, team); err != nil {
+		return nil, fmt.Errorf("saving team: %w", err)
+	}
+
+	if err := s.reassigner.reassign(ctx, prs.ReasonTeamChange, team.Name, req.UserID); err != nil {
+		return nil, fmt.Errorf("reassigning reviewed prs: %w", err)
+	}
+
+	view, err := s.teamIntoView(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commiting tx: %w", err)
+	}
+
+	return view, nil
+}
+
+// MoveMember moves the user to another team and reassigns its open reviews of the former team's pull requests.
+// Destination team is returned.
+func (s *TeamServiceImpl) MoveMember(ctx context.Context, req MoveMemberRequest) (*TeamView, error) {
+	if err := authorizeTeam(ctx, req.FromTeamName); err != nil {
+		return nil, err
+	}
+	if err := authorizeTeam(ctx, req.ToTeamName); err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.txManager.WithTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting tx: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	from, err := s.teamRepo.GetByName(ctx, req.FromTeamName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving source team: %w", err)
+	}
+
+	to, err := s.teamRepo.GetByName(ctx, req.ToTeamName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving destination team: %w", err)
+	}
+
+	if err := teams.MoveMember(from, to, req.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := s.teamRepo.Save(ctx, from); err != nil {
+		return nil, fmt.Errorf("saving source team: %w", err)
+	}
+
+	if err := s.teamRepo.Save(ctx, to); err != nil {
+		return nil, fmt.Errorf("saving destination team: %w", err)
+	}
+
+	if err := s.reassigner.reassign(ctx, prs.ReasonTeamChange, from.Name, req.UserID); err != nil {
+		return nil, fmt.Errorf("reassigning reviewed prs: %w", err)
+	}
+
+	view, err := s.teamIntoView(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commiting tx: %w", err)
+	}
+
+	return view, nil
+}
+
+// RenameTeam renames the team, unmerged pull requests of the team are moved along with it,
+// merged ones keep the name they were reviewed under.
+func (s *TeamServiceImpl) RenameTeam(ctx context.Context, req RenameTeamRequest) (*TeamView, error) {
+	if err := authorizeTeam(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.txManager.WithTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting tx: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	team, err := s.teamRepo.GetByName(ctx, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving team: %w", err)
+	}
+
+	if err := team.Rename(req.NewName); err != nil {
+		return nil, err
+	}
+
+	if err := s.teamRepo.Rename(ctx, req.Name, team.Name); err != nil {
+		return nil, fmt.Errorf("renaming team: %w", err)
+	}
+
+	if err := s.settingsRepo.RenameFallbackTeam(ctx, req.Name, team.Name); err != nil {
+		return nil, fmt.Errorf("renaming team in fallback chains: %w", err)
+	}
+
+	if err := s.prRepo.MoveUnmergedToTeam(ctx, req.Name, team.Name); err != nil {
+		return nil, fmt.Errorf("moving pull requests: %w", err)
+	}
+
+	view, err := s.teamIntoView(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commiting tx: %w", err)
+	}
+
+	return view, nil
+}
+
+// DeleteTeam deletes the team along with its settings and api tokens.
+// Members and unmerged pull requests are moved to the target team if one is given,
+// their reviewers stay assigned since they are moved as well.
+func (s *TeamServiceImpl) DeleteTeam(ctx context.Context, req DeleteTeamRequest) error {
+	if err := authorizeAdmin(ctx); err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.txManager.WithTx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting tx: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	team, err := s.teamRepo.GetByName(ctx, req.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving team: %w", err)
+	}
+
+	if req.TargetTeamName == "" {
+		if len(team.MemberIDs) > 0 {
+			return errorsx.ErrTeamNotEmpty
+		}
+
+		unmerged, err := s.prRepo.CountUnmergedByTeam(ctx, team.Name)
+		if err != nil {
+			return fmt.Errorf("counting pull requests: %w", err)
+		}
+		if unmerged > 0 {
+			return errorsx.ErrTeamNotEmpty
+		}
+	} else {
+		target, err := s.teamRepo.GetByName(ctx, req.TargetTeamName)
+		if err != nil {
+			return fmt.Errorf("retrieving target team: %w", err)
+		}
+
+		if err := teams.MoveAllMembers(team, target); err != nil {
+			return err
+		}
+
+		if err := s.teamRepo.Save(ctx, team); err != nil {
+			return fmt.Errorf("saving team: %w", err)
+		}
+
+		if err := s.teamRepo.Save(ctx, target); err != nil {
+			return fmt.Errorf("saving target team: %w", err)
+		}
+
+		if err := s.prRepo.MoveUnmergedToTeam(ctx, team.Name, target.Name); err != nil {
+			return fmt.Errorf("moving pull requests: %w", err)
+		}
+	}
+
+	if err := s.teamRepo.Delete(ctx, team.Name); err != nil {
+		return fmt.Errorf("deleting team: %w", err)
+	}
+
+	if err := s.settingsRepo.RemoveFallbackTeam(ctx, team.Name); err != nil {
+		return fmt.Errorf("removing team from fallback chains: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commiting tx: %w", err)
+	}
+
+	return nil
 }
 
 func (s *TeamServiceImpl) GetTeam(ctx context.Context, name string) (*TeamView, error) {
@@ -80,6 +450,68 @@ func (s *TeamServiceImpl) GetTeam(ctx context.Context, name string) (*TeamView,
 		return nil, err
 	}
 
+	return s.teamIntoView(ctx, team)
+}
+
+// ListTeams retrieves members of all the listed teams at once.
+func (s *TeamServiceImpl) ListTeams(ctx context.Context, req ListTeamsRequest) (*Page[*TeamView], error) {
+	limit, err := req.Page.limit()
+	if err != nil {
+		return nil, err
+	}
+
+	var after teamCursor
+	ok, err := req.Page.decodeCursor(&after)
+	if err != nil {
+		return nil, err
+	}
+	if ok && after.Name == "" {
+		return nil, errorsx.ErrPageCursor
+	}
+
+	list, err := s.teamRepo.List(ctx, req.MemberID, after.Name, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("listing teams: %w", err)
+	}
+
+	var memberIDs []string
+	for _, team := range list {
+		memberIDs = append(memberIDs, team.MemberIDs...)
+	}
+
+	members, err := s.userRepo.GetMany(ctx, memberIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving members: %w", err)
+	}
+
+	membersByID := make(map[string]*users.User, len(members))
+	for _, m := range members {
+		membersByID[m.ID] = m
+	}
+
+	views := make([]*TeamView, len(list))
+	for i, team := range list {
+		views[i] = &TeamView{
+			Name:    team.Name,
+			Members: make([]TeamMemberView, 0, len(team.MemberIDs)),
+		}
+		for _, id := range team.MemberIDs {
+			if m, ok := membersByID[id]; ok {
+				views[i].Members = append(views[i].Members, TeamMemberView{
+					ID:     m.ID,
+					Name:   m.Name,
+					Active: m.Active,
+				})
+			}
+		}
+	}
+
+	return newPage(views, limit, func(last *TeamView) any {
+		return teamCursor{Name: last.Name}
+	}), nil
+}
+
+func (s *TeamServiceImpl) teamIntoView(ctx context.Context, team *teams.Team) (*TeamView, error) {
 	members, err := s.userRepo.GetMany(ctx, team.MemberIDs...)
 	if err != nil {
 		return nil, err
@@ -95,15 +527,78 @@ func (s *TeamServiceImpl) GetTeam(ctx context.Context, name string) (*TeamView,
 	}
 
 	return &TeamView{
-		Name:    name,
+		Name:    team.Name,
 		Members: views,
 	}, nil
 }
 
-func NewTeamService(txManager TxManager, teamRepo teamRepository, userRepo userRepository) *TeamServiceImpl {
+func (s *TeamServiceImpl) GetSettings(ctx context.Context, teamName string) (*TeamSettingsView, error) {
+	if _, err := s.teamRepo.GetByName(ctx, teamName); err != nil {
+		return nil, fmt.Errorf("retrieving team: %w", err)
+	}
+
+	settings, err := getTeamSettings(ctx, s.settingsRepo, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving team settings: %w", err)
+	}
+
+	return settingsIntoView(settings), nil
+}
+
+func (s *TeamServiceImpl) SetSettings(ctx context.Context, req TeamSettingsView) (*TeamSettingsView, error) {
+	if err := authorizeTeam(ctx, req.TeamName); err != nil {
+		return nil, err
+	}
+
+	reviewers := teams.ReviewersPolicy{
+		Default:           req.ReviewersCount,
+		Min:               req.MinReviewersCount,
+		Max:               req.MaxReviewersCount,
+		RequiredApprovals: req.RequiredApprovals,
+	}
+
+	settings, err := teams.NewSettings(req.TeamName, teams.Strategy(req.Strategy), reviewers, req.FallbackTeamNames, req.AllowOtherTeams, req.ReviewSLA)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.teamRepo.GetByName(ctx, req.TeamName); err != nil {
+		return nil, fmt.Errorf("retrieving team: %w", err)
+	}
+
+	if len(settings.FallbackTeamNames) > 0 {
+		fallbackTeams, err := s.teamRepo.GetManyByNames(ctx, settings.FallbackTeamNames...)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving fallback teams: %w", err)
+		}
+		if len(fallbackTeams) != len(settings.FallbackTeamNames) {
+			return nil, fmt.Errorf("retrieving fallback teams: %w", errorsx.ErrNotFound)
+		}
+	}
+
+	if err := s.settingsRepo.Save(ctx, settings); err != nil {
+		return nil, fmt.Errorf("saving team settings: %w", err)
+	}
+
+	return settingsIntoView(settings), nil
+}
+
+func NewTeamService(
+	txManager TxManager,
+	teamRepo teamRepository,
+	userRepo userRepository,
+	settingsRepo teamSettingsRepository,
+	prRepo prRepository,
+	rpicker ReviewerPicker,
+	historyRepo historyRepository,
+	outboxRepo outboxRepository,
+) *TeamServiceImpl {
 	return &TeamServiceImpl{
-		teamRepo:  teamRepo,
-		userRepo:  userRepo,
-		txManager: txManager,
+		teamRepo:     teamRepo,
+		userRepo:     userRepo,
+		settingsRepo: settingsRepo,
+		prRepo:       prRepo,
+		txManager:    txManager,
+		reassigner:   newReviewerReassigner(prRepo, teamRepo, rpicker, historyRepo, outboxRepo),
 	}
 }
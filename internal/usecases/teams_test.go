@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
 	"github.com/lezzercringe/avito-test-assignment/internal/teams"
 	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
 	"github.com/lezzercringe/avito-test-assignment/internal/users"
@@ -16,16 +17,25 @@ import (
 )
 
 func setupTest(t *testing.T) (*usecases.TeamServiceImpl, *mocks.MockteamRepository, *mocks.MockuserRepository, *mocks.MockTxManager, *mocks.MockTxHandle) {
+	service, teamRepo, userRepo, _, txManager, txHandle := setupTeamSettingsTest(t)
+	return service, teamRepo, userRepo, txManager, txHandle
+}
+
+func setupTeamSettingsTest(t *testing.T) (*usecases.TeamServiceImpl, *mocks.MockteamRepository, *mocks.MockuserRepository, *mocks.MockteamSettingsRepository, *mocks.MockTxManager, *mocks.MockTxHandle) {
 	ctrl := gomock.NewController(t)
 
 	teamRepo := mocks.NewMockteamRepository(ctrl)
 	userRepo := mocks.NewMockuserRepository(ctrl)
+	settingsRepo := mocks.NewMockteamSettingsRepository(ctrl)
 	txManager := mocks.NewMockTxManager(ctrl)
 	txHandle := mocks.NewMockTxHandle(ctrl)
 
-	service := usecases.NewTeamService(txManager, teamRepo, userRepo)
+	service := usecases.NewTeamService(
+		txManager, teamRepo, userRepo, settingsRepo,
+		mocks.NewMockprRepository(ctrl), mocks.NewMockReviewerPicker(ctrl), setupNoopHistory(ctrl), setupNoopOutbox(ctrl),
+	)
 
-	return service, teamRepo, userRepo, txManager, txHandle
+	return service, teamRepo, userRepo, settingsRepo, txManager, txHandle
 }
 
 func assertTeamView(t *testing.T, result *usecases.TeamView, expectedName string, expectedMembers []usecases.TeamMemberView) {
@@ -54,7 +64,7 @@ func TestTeamService_AddTeam_Ok(t *testing.T) {
 		txManager.EXPECT().WithTx(ctx).Return(ctx, txHandle, nil)
 		txHandle.EXPECT().Rollback(ctx).Return(nil)
 		userRepo.EXPECT().SaveMany(ctx, gomock.Any()).Return(nil)
-		teamRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+		teamRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
 		txHandle.EXPECT().Commit(ctx).Return(nil)
 
 		result, err := service.AddTeam(ctx, req)
@@ -72,7 +82,7 @@ func TestTeamService_AddTeam_Ok(t *testing.T) {
 		txManager.EXPECT().WithTx(ctx).Return(ctx, txHandle, nil)
 		txHandle.EXPECT().Rollback(ctx).Return(nil)
 		userRepo.EXPECT().SaveMany(ctx).Return(nil)
-		teamRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+		teamRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
 		txHandle.EXPECT().Commit(ctx).Return(nil)
 
 		result, err := service.AddTeam(ctx, req)
@@ -92,7 +102,7 @@ func TestTeamService_AddTeam_Ok(t *testing.T) {
 		txManager.EXPECT().WithTx(ctx).Return(ctx, txHandle, nil)
 		txHandle.EXPECT().Rollback(ctx).Return(nil)
 		userRepo.EXPECT().SaveMany(ctx, gomock.Any()).Return(nil)
-		teamRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+		teamRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
 		txHandle.EXPECT().Commit(ctx).Return(nil)
 
 		result, err := service.AddTeam(ctx, req)
@@ -137,7 +147,7 @@ func TestTeamService_AddTeam_Error(t *testing.T) {
 		assert.Contains(t, err.Error(), "save error")
 	})
 
-	t.Run("team repo save error", func(t *testing.T) {
+	t.Run("team repo create error", func(t *testing.T) {
 		req := usecases.TeamView{
 			Name: "test-team",
 			Members: []usecases.TeamMemberView{
@@ -148,13 +158,13 @@ func TestTeamService_AddTeam_Error(t *testing.T) {
 		txManager.EXPECT().WithTx(ctx).Return(ctx, txHandle, nil)
 		txHandle.EXPECT().Rollback(ctx).Return(nil)
 		userRepo.EXPECT().SaveMany(ctx, gomock.Any()).Return(nil)
-		teamRepo.EXPECT().Save(ctx, gomock.Any()).Return(errors.New("team save error"))
+		teamRepo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("team save error"))
 
 		result, err := service.AddTeam(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "saving team")
+		assert.Contains(t, err.Error(), "creating team")
 	})
 
 	t.Run("commit error", func(t *testing.T) {
@@ -168,7 +178,7 @@ func TestTeamService_AddTeam_Error(t *testing.T) {
 		txManager.EXPECT().WithTx(ctx).Return(ctx, txHandle, nil)
 		txHandle.EXPECT().Rollback(ctx).Return(nil)
 		userRepo.EXPECT().SaveMany(ctx, gomock.Any()).Return(nil)
-		teamRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+		teamRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
 		txHandle.EXPECT().Commit(ctx).Return(errors.New("commit error"))
 
 		result, err := service.AddTeam(ctx, req)
@@ -288,3 +298,166 @@ func TestTeamService_GetTeam_Error(t *testing.T) {
 		assert.Contains(t, err.Error(), "user fetch error")
 	})
 }
+
+func TestTeamService_GetSettings(t *testing.T) {
+	service, teamRepo, _, settingsRepo, _, _ := setupTeamSettingsTest(t)
+	ctx := context.Background()
+
+	t.Run("configured team", func(t *testing.T) {
+		teamName := "test-team"
+
+		teamRepo.EXPECT().GetByName(ctx, teamName).Return(&teams.Team{Name: teamName}, nil)
+		settingsRepo.EXPECT().Get(ctx, teamName).Return(&teams.Settings{
+			TeamName:        teamName,
+			Strategy:        teams.StrategyLeastLoaded,
+			Reviewers:       teams.ReviewersPolicy{Default: 1, Min: 1, Max: 3},
+			AllowOtherTeams: true,
+		}, nil)
+
+		result, err := service.GetSettings(ctx, teamName)
+
+		require.NoError(t, err)
+		assert.Equal(t, &usecases.TeamSettingsView{
+			TeamName:          teamName,
+			Strategy:          "least_loaded",
+			ReviewersCount:    1,
+			MinReviewersCount: 1,
+			MaxReviewersCount: 3,
+			AllowOtherTeams:   true,
+		}, result)
+	})
+
+	t.Run("not configured team falls back to defaults", func(t *testing.T) {
+		teamName := "fresh-team"
+
+		teamRepo.EXPECT().GetByName(ctx, teamName).Return(&teams.Team{Name: teamName}, nil)
+		settingsRepo.EXPECT().Get(ctx, teamName).Return(nil, errorsx.ErrNotFound)
+
+		result, err := service.GetSettings(ctx, teamName)
+
+		require.NoError(t, err)
+		assert.Equal(t, &usecases.TeamSettingsView{
+			TeamName:          teamName,
+			Strategy:          "default",
+			ReviewersCount:    teams.DefaultReviewersCount,
+			MaxReviewersCount: teams.DefaultReviewersCount,
+		}, result)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		teamName := "nonexistent-team"
+
+		teamRepo.EXPECT().GetByName(ctx, teamName).Return(nil, errorsx.ErrNotFound)
+
+		result, err := service.GetSettings(ctx, teamName)
+
+		assert.ErrorIs(t, err, errorsx.ErrNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTeamService_SetSettings(t *testing.T) {
+	service, teamRepo, _, settingsRepo, _, _ := setupTeamSettingsTest(t)
+	ctx := context.Background()
+
+	t.Run("valid settings", func(t *testing.T) {
+		req := usecases.TeamSettingsView{
+			TeamName:          "test-team",
+			Strategy:          "least_loaded",
+			ReviewersCount:    2,
+			MinReviewersCount: 1,
+			MaxReviewersCount: 3,
+			AllowOtherTeams:   true,
+		}
+
+		teamRepo.EXPECT().GetByName(ctx, req.TeamName).Return(&teams.Team{Name: req.TeamName}, nil)
+		settingsRepo.EXPECT().Save(ctx, &teams.Settings{
+			TeamName:        req.TeamName,
+			Strategy:        teams.StrategyLeastLoaded,
+			Reviewers:       teams.ReviewersPolicy{Default: 2, Min: 1, Max: 3},
+			AllowOtherTeams: true,
+		}).Return(nil)
+
+		result, err := service.SetSettings(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, &req, result)
+	})
+
+	t.Run("invalid strategy", func(t *testing.T) {
+		req := usecases.TeamSettingsView{
+			TeamName:          "test-team",
+			Strategy:          "fastest",
+			ReviewersCount:    1,
+			MaxReviewersCount: 1,
+		}
+
+		result, err := service.SetSettings(ctx, req)
+
+		assert.ErrorIs(t, err, errorsx.ErrUnknownStrategy)
+		assert.Nil(t, result)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		req := usecases.TeamSettingsView{
+			TeamName:          "nonexistent-team",
+			Strategy:          "random",
+			ReviewersCount:    1,
+			MaxReviewersCount: 1,
+		}
+
+		teamRepo.EXPECT().GetByName(ctx, req.TeamName).Return(nil, errorsx.ErrNotFound)
+
+		result, err := service.SetSettings(ctx, req)
+
+		assert.ErrorIs(t, err, errorsx.ErrNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("fallback teams", func(t *testing.T) {
+		req := usecases.TeamSettingsView{
+			TeamName:          "test-team",
+			Strategy:          "random",
+			ReviewersCount:    1,
+			MaxReviewersCount: 1,
+			FallbackTeamNames: []string{"platform", "sre"},
+		}
+
+		teamRepo.EXPECT().GetByName(ctx, req.TeamName).Return(&teams.Team{Name: req.TeamName}, nil)
+		teamRepo.EXPECT().GetManyByNames(ctx, "platform", "sre").Return(map[string]teams.Team{
+			"platform": {Name: "platform"},
+			"sre":      {Name: "sre"},
+		}, nil)
+		settingsRepo.EXPECT().Save(ctx, &teams.Settings{
+			TeamName:          req.TeamName,
+			Strategy:          teams.StrategyRandom,
+			Reviewers:         teams.ReviewersPolicy{Default: 1, Max: 1},
+			FallbackTeamNames: []string{"platform", "sre"},
+		}).Return(nil)
+
+		result, err := service.SetSettings(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, &req, result)
+	})
+
+	t.Run("unknown fallback team", func(t *testing.T) {
+		req := usecases.TeamSettingsView{
+			TeamName:          "test-team",
+			Strategy:          "random",
+			ReviewersCount:    1,
+			MaxReviewersCount: 1,
+			FallbackTeamNames: []string{"platform", "nonexistent"},
+		}
+
+		teamRepo.EXPECT().GetByName(ctx, req.TeamName).Return(&teams.Team{Name: req.TeamName}, nil)
+		teamRepo.EXPECT().GetManyByNames(ctx, "platform", "nonexistent").Return(map[string]teams.Team{
+			"platform": {Name: "platform"},
+		}, nil)
+
+		result, err := service.SetSettings(ctx, req)
+
+		assert.ErrorIs(t, err, errorsx.ErrNotFound)
+		assert.Nil(t, result)
+	})
+}
@@ -10,5 +10,40 @@ type TxHandle interface {
 }
 
 type TxManager interface {
+	// WithTx begins a transaction, transaction begun within another one is nested into it.
+	// Implementations collect functions registered by AfterCommit with WithCommitHooks.
 	WithTx(parent context.Context) (context.Context, TxHandle, error)
 }
+
+type commitHooksKey struct{}
+
+type commitHooks struct {
+	hooks []func()
+}
+
+// WithCommitHooks returns context collecting functions registered by AfterCommit within the transaction.
+// Calling commit once the transaction commits runs them, or passes them to the enclosing transaction if there is one.
+// Functions are dropped if the transaction is rolled back and commit is not called.
+func WithCommitHooks(ctx context.Context) (_ context.Context, commit func()) {
+	parent, _ := ctx.Value(commitHooksKey{}).(*commitHooks)
+	h := &commitHooks{}
+
+	return context.WithValue(ctx, commitHooksKey{}, h), func() {
+		if parent != nil {
+			parent.hooks = append(parent.hooks, h.hooks...)
+			return
+		}
+		for _, f := range h.hooks {
+			f()
+		}
+	}
+}
+
+// AfterCommit defers f until the transaction of the context commits, f is called at once outside of transactions.
+func AfterCommit(ctx context.Context, f func()) {
+	if h, ok := ctx.Value(commitHooksKey{}).(*commitHooks); ok {
+		h.hooks = append(h.hooks, f)
+		return
+	}
+	f()
+}
@@ -0,0 +1,46 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+)
+
+func TestAfterCommit(t *testing.T) {
+	t.Run("runs at once outside of transactions", func(t *testing.T) {
+		called := false
+
+		usecases.AfterCommit(context.Background(), func() { called = true })
+
+		assert.True(t, called)
+	})
+
+	t.Run("runs once transaction commits", func(t *testing.T) {
+		ctx, commit := usecases.WithCommitHooks(context.Background())
+		called := false
+
+		usecases.AfterCommit(ctx, func() { called = true })
+		assert.False(t, called)
+
+		commit()
+		assert.True(t, called)
+	})
+
+	t.Run("nested transaction passes hooks to the enclosing one", func(t *testing.T) {
+		ctx, commit := usecases.WithCommitHooks(context.Background())
+		nestedCtx, commitNested := usecases.WithCommitHooks(ctx)
+		var calls []string
+
+		usecases.AfterCommit(nestedCtx, func() { calls = append(calls, "nested") })
+		usecases.AfterCommit(ctx, func() { calls = append(calls, "outer") })
+
+		commitNested()
+		assert.Empty(t, calls)
+
+		commit()
+		assert.Equal(t, []string{"outer", "nested"}, calls)
+	})
+}
@@ -4,17 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"slices"
+	"time"
 
 	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
 	"github.com/lezzercringe/avito-test-assignment/internal/prs"
 	"github.com/lezzercringe/avito-test-assignment/internal/users"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
 )
 
-type UserWithTeamView struct {
-	ID, Name string
-	IsActive bool
-	TeamName string
+type UserWithTeamsView struct {
+	ID, Name  string
+	IsActive  bool
+	TeamNames []string
+	// PrimaryTeamName is empty if the primary team is not set.
+	PrimaryTeamName string
 }
 
 type UserView struct {
@@ -28,20 +31,40 @@ type ReviewedPullRequestView struct {
 	AuthorID string
 }
 
+// UserFilter narrows listing of users, zero fields match any user.
+type UserFilter struct {
+	TeamName string
+	Active   *bool
+}
+
+type ListUsersRequest struct {
+	Filter UserFilter
+	Page   PageRequest
+}
+
+// userCursor is the sort key of the last listed user.
+type userCursor struct {
+	ID string `json:"id"`
+}
+
 type UserService interface {
-	SetIsActive(ctx context.Context, id string, isActive bool) (*UserWithTeamView, error)
+	SetIsActive(ctx context.Context, id string, isActive bool) (*UserWithTeamsView, error)
+	SetPrimaryTeam(ctx context.Context, id, teamName string) (*UserWithTeamsView, error)
 	GetReview(ctx context.Context, id string) ([]*ReviewedPullRequestView, error)
 	Deactivate(ctx context.Context, ids ...string) ([]*UserView, error)
+	// ListUsers returns users ordered by id.
+	ListUsers(ctx context.Context, req ListUsersRequest) (*Page[*UserView], error)
 }
 
 var _ UserService = &UserServiceImpl{}
 
 type UserServiceImpl struct {
-	teamRepo  teamRepository
-	txManager TxManager
-	userRepo  userRepository
-	prRepo    prRepository
-	rpicker   ReviewerPicker
+	teamRepo   teamRepository
+	txManager  TxManager
+	userRepo   userRepository
+	prRepo     prRepository
+	outboxRepo outboxRepository
+	reassigner *reviewerReassigner
 }
 
 func userIntoView(user *users.User) *UserView {
@@ -80,93 +103,142 @@ func (s *UserServiceImpl) GetReview(ctx context.Context, id string) ([]*Reviewed
 	return views, nil
 }
 
-func (s *UserServiceImpl) SetIsActive(ctx context.Context, id string, isActive bool) (*UserWithTeamView, error) {
+func (s *UserServiceImpl) ListUsers(ctx context.Context, req ListUsersRequest) (*Page[*UserView], error) {
+	limit, err := req.Page.limit()
+	if err != nil {
+		return nil, err
+	}
+
+	var after userCursor
+	ok, err := req.Page.decodeCursor(&after)
+	if err != nil {
+		return nil, err
+	}
+	if ok && after.ID == "" {
+		return nil, errorsx.ErrPageCursor
+	}
+
+	list, err := s.userRepo.List(ctx, req.Filter, after.ID, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("listing users: %w", err)
+	}
+
+	return newPage(usersIntoViews(list), limit, func(last *UserView) any {
+		return userCursor{ID: last.ID}
+	}), nil
+}
+
+func (s *UserServiceImpl) SetIsActive(ctx context.Context, id string, isActive bool) (*UserWithTeamsView, error) {
+	ctx, txHandle, err := s.txManager.WithTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting tx: %w", err)
+	}
+
+	defer txHandle.Rollback(ctx)
+
 	user, err := s.userRepo.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := authorizeMembers(ctx, s.teamRepo, id); err != nil {
+		return nil, err
+	}
+
+	deactivated := user.Active && !isActive
 	user.Active = isActive
 
 	if err := s.userRepo.Save(ctx, user); err != nil {
 		return nil, err
 	}
 
-	team, err := s.teamRepo.GetByMemberID(ctx, id)
+	view, err := s.userWithTeamsIntoView(ctx, user)
 	if err != nil {
-		return nil, fmt.Errorf("getting team: %w", err)
+		return nil, err
 	}
 
-	return &UserWithTeamView{
-		ID:       user.ID,
-		Name:     user.Name,
-		IsActive: isActive,
-		TeamName: team.Name,
-	}, nil
+	if deactivated {
+		event := webhooks.UserDeactivated(user.ID, view.TeamNames, ActorFromContext(ctx), time.Now())
+		if err := s.outboxRepo.Enqueue(ctx, event); err != nil {
+			return nil, fmt.Errorf("enqueueing webhook events: %w", err)
+		}
+	}
+
+	if err := txHandle.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commiting tx: %w", err)
+	}
+
+	return view, nil
 }
 
-func collectUniqueTeamNames(pullRequests []*PRWithMatchedReviewers) []string {
-	set := make(map[string]struct{})
-	names := make([]string, 0, len(pullRequests))
+// SetPrimaryTeam sets the team used for user's pull requests when the team is not specified explicitly.
+func (s *UserServiceImpl) SetPrimaryTeam(ctx context.Context, id, teamName string) (*UserWithTeamsView, error) {
+	if err := authorizeTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
 
-	for _, entry := range pullRequests {
-		if _, ok := set[entry.PR.OriginalTeamName]; !ok {
-			set[entry.PR.OriginalTeamName] = struct{}{}
-			names = append(names, entry.PR.OriginalTeamName)
-		}
+	ctx, txHandle, err := s.txManager.WithTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting tx: %w", err)
 	}
 
-	return names
-}
+	defer txHandle.Rollback(ctx)
 
-// reassignAllPRsWithReviewers finds all the PR's assigned to set of users, removes those users from their reviewers list.
-//
-// WARN: method must be called within a transaction.
-func (s *UserServiceImpl) reassignAllPRsWithReviewers(ctx context.Context, reviewerIDs ...string) error {
-	result, err := s.prRepo.GetAllUnmergedWithAnyOfReviewers(ctx, reviewerIDs...)
+	user, err := s.userRepo.Get(ctx, id)
 	if err != nil {
-		return fmt.Errorf("retrieving prs with reviewers: %w", err)
+		return nil, fmt.Errorf("retrieving user: %w", err)
 	}
 
-	if len(result) == 0 {
-		return nil
+	err = s.teamRepo.SetPrimary(ctx, id, teamName)
+	if errors.Is(err, errorsx.ErrNotFound) {
+		return nil, errorsx.ErrNotTeamMember
+	}
+	if err != nil {
+		return nil, fmt.Errorf("setting primary team: %w", err)
 	}
 
-	teams, err := s.teamRepo.GetManyByNames(ctx, collectUniqueTeamNames(result)...)
+	view, err := s.userWithTeamsIntoView(ctx, user)
 	if err != nil {
-		return fmt.Errorf("retrieving teams: %w", err)
+		return nil, err
 	}
 
-	pullRequests := make([]*prs.PullRequest, len(result))
-	for i, entry := range result {
-		for _, matchedID := range entry.MatchedReviewerIDs {
-			if err := entry.PR.UnassignReviewer(matchedID); err != nil {
-				return fmt.Errorf("unassigning reviewer: %w", err)
-			}
-		}
+	if err := txHandle.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commiting tx: %w", err)
+	}
 
-		newReviewers, err := s.rpicker.PickReviewersFromTeam(ctx, PickReviewersRequest{
-			UserIDsToExclude: append(slices.Clone(entry.MatchedReviewerIDs), entry.PR.AuthorID),
-			WantCount:        len(entry.MatchedReviewerIDs),
-			Team:             teams[entry.PR.OriginalTeamName],
-		})
-		if err != nil && !errors.Is(err, errorsx.ErrNoCandidate) {
-			return fmt.Errorf("picking new reviewers: %w", err)
-		}
+	return view, nil
+}
 
-		for _, reviewer := range newReviewers {
-			if err := entry.PR.AssignReviewer(reviewer); err != nil {
-				return fmt.Errorf("assigning new reviewers: %w", err)
-			}
-		}
+func (s *UserServiceImpl) userWithTeamsIntoView(ctx context.Context, user *users.User) (*UserWithTeamsView, error) {
+	userTeams, err := s.teamRepo.GetManyByMemberID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("getting teams: %w", err)
+	}
 
-		pullRequests[i] = entry.PR
+	teamNames := make([]string, len(userTeams))
+	for i, t := range userTeams {
+		teamNames[i] = t.Name
 	}
 
-	return s.prRepo.SaveMany(ctx, pullRequests...)
+	primary, err := s.teamRepo.GetPrimaryNameByMemberID(ctx, user.ID)
+	if err != nil && !errors.Is(err, errorsx.ErrNotFound) {
+		return nil, fmt.Errorf("getting primary team: %w", err)
+	}
+
+	return &UserWithTeamsView{
+		ID:              user.ID,
+		Name:            user.Name,
+		IsActive:        user.Active,
+		TeamNames:       teamNames,
+		PrimaryTeamName: primary,
+	}, nil
 }
 
 func (s *UserServiceImpl) Deactivate(ctx context.Context, idsToDeactivate ...string) ([]*UserView, error) {
+	if err := authorizeMembers(ctx, s.teamRepo, idsToDeactivate...); err != nil {
+		return nil, err
+	}
+
 	ctx, txHandle, err := s.txManager.WithTx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("starting tx: %w", err)
@@ -174,7 +246,7 @@ func (s *UserServiceImpl) Deactivate(ctx context.Context, idsToDeactivate ...str
 
 	defer txHandle.Rollback(ctx)
 
-	if err := s.reassignAllPRsWithReviewers(ctx, idsToDeactivate...); err != nil {
+	if err := s.reassigner.reassign(ctx, prs.ReasonDeactivation, "", idsToDeactivate...); err != nil {
 		return nil, fmt.Errorf("reassigning reviewed prs: %w", err)
 	}
 
@@ -183,7 +255,16 @@ func (s *UserServiceImpl) Deactivate(ctx context.Context, idsToDeactivate ...str
 		return nil, fmt.Errorf("retrieving users: %w", err)
 	}
 
+	var events []webhooks.Event
 	for _, u := range users {
+		if u.Active {
+			event, err := s.deactivatedEvent(ctx, u)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+
 		u.Active = false
 	}
 
@@ -191,6 +272,10 @@ func (s *UserServiceImpl) Deactivate(ctx context.Context, idsToDeactivate ...str
 		return nil, fmt.Errorf("saving users: %w", err)
 	}
 
+	if err := s.outboxRepo.Enqueue(ctx, events...); err != nil {
+		return nil, fmt.Errorf("enqueueing webhook events: %w", err)
+	}
+
 	if err := txHandle.Commit(ctx); err != nil {
 		return nil, fmt.Errorf("commiting tx: %w", err)
 	}
@@ -198,18 +283,35 @@ func (s *UserServiceImpl) Deactivate(ctx context.Context, idsToDeactivate ...str
 	return usersIntoViews(users), nil
 }
 
+func (s *UserServiceImpl) deactivatedEvent(ctx context.Context, user *users.User) (webhooks.Event, error) {
+	userTeams, err := s.teamRepo.GetManyByMemberID(ctx, user.ID)
+	if err != nil {
+		return webhooks.Event{}, fmt.Errorf("getting teams of user %s: %w", user.ID, err)
+	}
+
+	teamNames := make([]string, len(userTeams))
+	for i, t := range userTeams {
+		teamNames[i] = t.Name
+	}
+
+	return webhooks.UserDeactivated(user.ID, teamNames, ActorFromContext(ctx), time.Now()), nil
+}
+
 func NewUserService(
 	txManager TxManager,
 	userRepo userRepository,
 	teamRepo teamRepository,
 	prRepo prRepository,
 	rpicker ReviewerPicker,
+	historyRepo historyRepository,
+	outboxRepo outboxRepository,
 ) *UserServiceImpl {
 	return &UserServiceImpl{
-		teamRepo:  teamRepo,
-		userRepo:  userRepo,
-		prRepo:    prRepo,
-		txManager: txManager,
-		rpicker:   rpicker,
+		teamRepo:   teamRepo,
+		userRepo:   userRepo,
+		prRepo:     prRepo,
+		outboxRepo: outboxRepo,
+		txManager:  txManager,
+		reassigner: newReviewerReassigner(prRepo, teamRepo, rpicker, historyRepo, outboxRepo),
 	}
 }
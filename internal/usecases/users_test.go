@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
 	"github.com/lezzercringe/avito-test-assignment/internal/prs"
 	"github.com/lezzercringe/avito-test-assignment/internal/teams"
 	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
@@ -27,7 +28,7 @@ func setupUserTest(t *testing.T) (*usecases.UserServiceImpl, *mocks.MockteamRepo
 	txManager := mocks.NewMockTxManager(ctrl)
 	txHandle := mocks.NewMockTxHandle(ctrl)
 
-	service := usecases.NewUserService(txManager, userRepo, teamRepo, prRepo, rpicker)
+	service := usecases.NewUserService(txManager, userRepo, teamRepo, prRepo, rpicker, setupNoopHistory(ctrl), setupNoopOutbox(ctrl))
 
 	return service, teamRepo, userRepo, prRepo, rpicker, txManager, txHandle
 }
@@ -38,10 +39,10 @@ func assertUserView(t *testing.T, result *usecases.UserView, expectedID, expecte
 	assert.Equal(t, expectedActive, result.IsActive)
 }
 
-func assertUserWithTeamView(t *testing.T, result *usecases.UserWithTeamView, expectedID, expectedName, expectedTeamName string, expectedActive bool) {
+func assertUserWithTeamsView(t *testing.T, result *usecases.UserWithTeamsView, expectedID, expectedName string, expectedTeamNames []string, expectedActive bool) {
 	assert.Equal(t, expectedID, result.ID)
 	assert.Equal(t, expectedName, result.Name)
-	assert.Equal(t, expectedTeamName, result.TeamName)
+	assert.Equal(t, expectedTeamNames, result.TeamNames)
 	assert.Equal(t, expectedActive, result.IsActive)
 }
 
@@ -110,9 +111,13 @@ func TestUserService_GetReview(t *testing.T) {
 }
 
 func TestUserService_SetIsActive(t *testing.T) {
-	service, teamRepo, userRepo, _, _, _, _ := setupUserTest(t)
+	service, teamRepo, userRepo, _, _, txManager, txHandle := setupUserTest(t)
 	ctx := context.Background()
 
+	txManager.EXPECT().WithTx(ctx).Return(ctx, txHandle, nil).AnyTimes()
+	txHandle.EXPECT().Rollback(ctx).Return(nil).AnyTimes()
+	txHandle.EXPECT().Commit(ctx).Return(nil).AnyTimes()
+
 	t.Run("activate user", func(t *testing.T) {
 		userID := "user-1"
 
@@ -128,12 +133,14 @@ func TestUserService_SetIsActive(t *testing.T) {
 
 		userRepo.EXPECT().Get(ctx, userID).Return(user, nil)
 		userRepo.EXPECT().Save(ctx, user).Return(nil)
-		teamRepo.EXPECT().GetByMemberID(ctx, userID).Return(team, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, userID).Return([]*teams.Team{team}, nil)
+		teamRepo.EXPECT().GetPrimaryNameByMemberID(ctx, userID).Return("", errorsx.ErrNotFound)
 
 		result, err := service.SetIsActive(ctx, userID, true)
 
 		require.NoError(t, err)
-		assertUserWithTeamView(t, result, userID, "John Doe", "team-alpha", true)
+		assertUserWithTeamsView(t, result, userID, "John Doe", []string{"team-alpha"}, true)
+		assert.Empty(t, result.PrimaryTeamName)
 		assert.True(t, user.Active)
 	})
 
@@ -146,18 +153,18 @@ func TestUserService_SetIsActive(t *testing.T) {
 			Active: true,
 		}
 
-		team := &teams.Team{
-			Name: "team-beta",
-		}
+		userTeams := []*teams.Team{{Name: "team-beta"}, {Name: "team-gamma"}}
 
 		userRepo.EXPECT().Get(ctx, userID).Return(user, nil)
 		userRepo.EXPECT().Save(ctx, user).Return(nil)
-		teamRepo.EXPECT().GetByMemberID(ctx, userID).Return(team, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, userID).Return(userTeams, nil)
+		teamRepo.EXPECT().GetPrimaryNameByMemberID(ctx, userID).Return("team-gamma", nil)
 
 		result, err := service.SetIsActive(ctx, userID, false)
 
 		require.NoError(t, err)
-		assertUserWithTeamView(t, result, userID, "Jane Smith", "team-beta", false)
+		assertUserWithTeamsView(t, result, userID, "Jane Smith", []string{"team-beta", "team-gamma"}, false)
+		assert.Equal(t, "team-gamma", result.PrimaryTeamName)
 		assert.False(t, user.Active)
 	})
 
@@ -192,7 +199,7 @@ func TestUserService_SetIsActive(t *testing.T) {
 		assert.Contains(t, err.Error(), "save error")
 	})
 
-	t.Run("team not found", func(t *testing.T) {
+	t.Run("teams retrieval error", func(t *testing.T) {
 		userID := "user-4"
 
 		user := &users.User{
@@ -203,13 +210,13 @@ func TestUserService_SetIsActive(t *testing.T) {
 
 		userRepo.EXPECT().Get(ctx, userID).Return(user, nil)
 		userRepo.EXPECT().Save(ctx, user).Return(nil)
-		teamRepo.EXPECT().GetByMemberID(ctx, userID).Return(nil, errors.New("team not found"))
+		teamRepo.EXPECT().GetManyByMemberID(ctx, userID).Return(nil, errors.New("connection lost"))
 
 		result, err := service.SetIsActive(ctx, userID, true)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "getting team")
+		assert.Contains(t, err.Error(), "getting teams")
 	})
 }
 
@@ -230,6 +237,7 @@ func TestUserService_Deactivate(t *testing.T) {
 		txHandle.EXPECT().Rollback(ctx).Return(nil)
 		prRepo.EXPECT().GetAllUnmergedWithAnyOfReviewers(ctx, userID).Return([]*usecases.PRWithMatchedReviewers{}, nil)
 		userRepo.EXPECT().GetMany(ctx, userID).Return([]*users.User{user}, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, userID).Return([]*teams.Team{{Name: "team-alpha"}}, nil)
 		userRepo.EXPECT().SaveMany(ctx, user).Return(nil)
 		txHandle.EXPECT().Commit(ctx).Return(nil)
 
@@ -253,6 +261,8 @@ func TestUserService_Deactivate(t *testing.T) {
 		txHandle.EXPECT().Rollback(ctx).Return(nil)
 		prRepo.EXPECT().GetAllUnmergedWithAnyOfReviewers(ctx, "user-1", "user-2").Return([]*usecases.PRWithMatchedReviewers{}, nil)
 		userRepo.EXPECT().GetMany(ctx, "user-1", "user-2").Return(users, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, "user-1").Return([]*teams.Team{{Name: "team-alpha"}}, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, "user-2").Return([]*teams.Team{{Name: "team-alpha"}}, nil)
 		userRepo.EXPECT().SaveMany(ctx, users[0], users[1]).Return(nil)
 		txHandle.EXPECT().Commit(ctx).Return(nil)
 
@@ -282,6 +292,7 @@ func TestUserService_Deactivate(t *testing.T) {
 			OriginalTeamName: "team-alpha",
 			AuthorID:         "author-1",
 			ReviewerIDs:      []string{userID, "reviewer-2"},
+			ReviewersCount:   2,
 		}
 
 		team := teams.Team{
@@ -301,12 +312,13 @@ func TestUserService_Deactivate(t *testing.T) {
 		prRepo.EXPECT().GetAllUnmergedWithAnyOfReviewers(ctx, userID).Return(prWithReviewers, nil)
 		teamRepo.EXPECT().GetManyByNames(ctx, "team-alpha").Return(map[string]teams.Team{"team-alpha": team}, nil)
 		rpicker.EXPECT().PickReviewersFromTeam(ctx, usecases.PickReviewersRequest{
-			UserIDsToExclude: []string{userID, "author-1"},
+			UserIDsToExclude: []string{userID, "author-1", "reviewer-2"},
 			WantCount:        1,
 			Team:             team,
 		}).Return([]string{"new-reviewer"}, nil)
 		prRepo.EXPECT().SaveMany(ctx, pr).Return(nil)
 		userRepo.EXPECT().GetMany(ctx, userID).Return([]*users.User{user}, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, userID).Return([]*teams.Team{{Name: "team-alpha"}}, nil)
 		userRepo.EXPECT().SaveMany(ctx, user).Return(nil)
 		txHandle.EXPECT().Commit(ctx).Return(nil)
 
@@ -318,6 +330,7 @@ func TestUserService_Deactivate(t *testing.T) {
 		assert.False(t, user.Active)
 		assert.Contains(t, pr.ReviewerIDs, "new-reviewer")
 		assert.NotContains(t, pr.ReviewerIDs, userID)
+		assert.Contains(t, pr.ReviewerIDs, "reviewer-2")
 	})
 
 	t.Run("tx manager error", func(t *testing.T) {
@@ -374,6 +387,7 @@ func TestUserService_Deactivate(t *testing.T) {
 		txHandle.EXPECT().Rollback(ctx).Return(nil)
 		prRepo.EXPECT().GetAllUnmergedWithAnyOfReviewers(ctx, userID).Return([]*usecases.PRWithMatchedReviewers{}, nil)
 		userRepo.EXPECT().GetMany(ctx, userID).Return([]*users.User{user}, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, userID).Return([]*teams.Team{{Name: "team-alpha"}}, nil)
 		userRepo.EXPECT().SaveMany(ctx, user).Return(errors.New("save error"))
 
 		result, err := service.Deactivate(ctx, userID)
@@ -396,6 +410,7 @@ func TestUserService_Deactivate(t *testing.T) {
 		txHandle.EXPECT().Rollback(ctx).Return(nil)
 		prRepo.EXPECT().GetAllUnmergedWithAnyOfReviewers(ctx, userID).Return([]*usecases.PRWithMatchedReviewers{}, nil)
 		userRepo.EXPECT().GetMany(ctx, userID).Return([]*users.User{user}, nil)
+		teamRepo.EXPECT().GetManyByMemberID(ctx, userID).Return([]*teams.Team{{Name: "team-alpha"}}, nil)
 		userRepo.EXPECT().SaveMany(ctx, user).Return(nil)
 		txHandle.EXPECT().Commit(ctx).Return(errors.New("commit error"))
 
@@ -406,3 +421,43 @@ func TestUserService_Deactivate(t *testing.T) {
 		assert.Contains(t, err.Error(), "commiting tx")
 	})
 }
+
+func TestUserService_Deactivate_History(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	teamRepo := mocks.NewMockteamRepository(ctrl)
+	userRepo := mocks.NewMockuserRepository(ctrl)
+	prRepo := mocks.NewMockprRepository(ctrl)
+	rpicker := mocks.NewMockReviewerPicker(ctrl)
+	historyRepo := mocks.NewMockhistoryRepository(ctrl)
+	service := usecases.NewUserService(setupNoopTx(ctrl), userRepo, teamRepo, prRepo, rpicker, historyRepo, setupNoopOutbox(ctrl))
+	ctx := context.Background()
+
+	user := &users.User{ID: "u1", Name: "John Doe", Active: true}
+	team := teams.Team{Name: "team-alpha", MemberIDs: []string{"author-1", "u1", "u2", "u3"}}
+	pr := &prs.PullRequest{
+		ID:               "pr-1",
+		Status:           prs.StatusOpen,
+		OriginalTeamName: team.Name,
+		AuthorID:         "author-1",
+		ReviewerIDs:      []string{"u1", "u2"},
+		ReviewersCount:   2,
+	}
+
+	prRepo.EXPECT().GetAllUnmergedWithAnyOfReviewers(ctx, "u1").Return([]*usecases.PRWithMatchedReviewers{
+		{PR: pr, MatchedReviewerIDs: []string{"u1"}},
+	}, nil)
+	teamRepo.EXPECT().GetManyByNames(ctx, team.Name).Return(map[string]teams.Team{team.Name: team}, nil)
+	rpicker.EXPECT().PickReviewersFromTeam(ctx, gomock.Any()).Return([]string{"u3"}, nil)
+	prRepo.EXPECT().SaveMany(ctx, pr).Return(nil)
+	expectEvents(historyRepo,
+		prs.AssignmentEvent{PullRequestID: "pr-1", ReviewerID: "u1", Action: prs.ActionUnassigned, Reason: prs.ReasonDeactivation, Actor: usecases.SystemActor},
+		prs.AssignmentEvent{PullRequestID: "pr-1", ReviewerID: "u3", Action: prs.ActionAssigned, Reason: prs.ReasonDeactivation, Actor: usecases.SystemActor},
+	)
+	userRepo.EXPECT().GetMany(ctx, "u1").Return([]*users.User{user}, nil)
+	teamRepo.EXPECT().GetManyByMemberID(ctx, "u1").Return([]*teams.Team{&team}, nil)
+	userRepo.EXPECT().SaveMany(ctx, user).Return(nil)
+
+	_, err := service.Deactivate(ctx, "u1")
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,155 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+)
+
+//go:generate mockgen -typed -destination ../../mocks/webhook_sender.go -package mocks . WebhookSender
+
+const (
+	// deliveryBatchSize limits number of deliveries attempted by a single DeliverDue call.
+	deliveryBatchSize = 50
+	// deliveryLease postpones claimed deliveries, it must exceed the time needed to attempt the whole batch.
+	deliveryLease = 10 * time.Minute
+)
+
+// WebhookSender performs a single delivery attempt, any error means the attempt has failed.
+type WebhookSender interface {
+	Send(ctx context.Context, d *webhooks.Delivery) error
+}
+
+type WebhookView struct {
+	ID         int64
+	URL        string
+	EventTypes []string
+}
+
+type CreateWebhookRequest struct {
+	URL        string
+	Secret     string
+	EventTypes []string
+}
+
+type WebhookService interface {
+	CreateSubscription(ctx context.Context, req CreateWebhookRequest) (*WebhookView, error)
+	ListSubscriptions(ctx context.Context) ([]*WebhookView, error)
+	DeleteSubscription(ctx context.Context, id int64) error
+	// DeliverDue attempts pending deliveries whose next attempt is due. It is meant to be run periodically.
+	DeliverDue(ctx context.Context) error
+}
+
+var _ WebhookService = &WebhookServiceImpl{}
+
+type WebhookServiceImpl struct {
+	webhookRepo webhookRepository
+	outboxRepo  outboxRepository
+	sender      WebhookSender
+}
+
+// webhookIntoView omits the secret, it is never returned once the subscription is created.
+func webhookIntoView(s *webhooks.Subscription) *WebhookView {
+	eventTypes := make([]string, len(s.EventTypes))
+	for i, t := range s.EventTypes {
+		eventTypes[i] = string(t)
+	}
+
+	return &WebhookView{
+		ID:         s.ID,
+		URL:        s.URL,
+		EventTypes: eventTypes,
+	}
+}
+
+func (s *WebhookServiceImpl) CreateSubscription(ctx context.Context, req CreateWebhookRequest) (*WebhookView, error) {
+	if err := authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	eventTypes := make([]webhooks.EventType, len(req.EventTypes))
+	for i, t := range req.EventTypes {
+		eventTypes[i] = webhooks.EventType(t)
+	}
+
+	subscription, err := webhooks.NewSubscription(req.URL, req.Secret, eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.Create(ctx, subscription); err != nil {
+		return nil, fmt.Errorf("creating subscription: %w", err)
+	}
+
+	return webhookIntoView(subscription), nil
+}
+
+func (s *WebhookServiceImpl) ListSubscriptions(ctx context.Context) ([]*WebhookView, error) {
+	if err := authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.webhookRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving subscriptions: %w", err)
+	}
+
+	views := make([]*WebhookView, len(subscriptions))
+	for i, sub := range subscriptions {
+		views[i] = webhookIntoView(sub)
+	}
+
+	return views, nil
+}
+
+func (s *WebhookServiceImpl) DeleteSubscription(ctx context.Context, id int64) error {
+	if err := authorizeAdmin(ctx); err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("deleting subscription: %w", err)
+	}
+
+	return nil
+}
+
+// DeliverDue claims the deliveries for the lease, so concurrent replicas do not deliver them twice,
+// and saves outcome of each attempt separately. Deliveries whose outcome was not saved are retried once the lease ends.
+// Failed attempts are rescheduled with backoff until the delivery moves to the dead-letter state.
+func (s *WebhookServiceImpl) DeliverDue(ctx context.Context) error {
+	deliveries, err := s.outboxRepo.ClaimDue(ctx, deliveryBatchSize, deliveryLease)
+	if err != nil {
+		return fmt.Errorf("claiming due deliveries: %w", err)
+	}
+
+	var errs []error
+	for _, d := range deliveries {
+		if err := s.sender.Send(ctx, d); err != nil {
+			d.Fail(err, time.Now())
+		} else {
+			d.Succeed()
+		}
+
+		if err := s.outboxRepo.Save(ctx, d); err != nil {
+			errs = append(errs, fmt.Errorf("saving delivery %d: %w", d.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func NewWebhookService(
+	webhookRepo webhookRepository,
+	outboxRepo outboxRepository,
+	sender WebhookSender,
+) *WebhookServiceImpl {
+	return &WebhookServiceImpl{
+		webhookRepo: webhookRepo,
+		outboxRepo:  outboxRepo,
+		sender:      sender,
+	}
+}
@@ -0,0 +1,235 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/auth"
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/teams"
+	"github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+	"github.com/lezzercringe/avito-test-assignment/mocks"
+)
+
+func setupWebhookTest(t *testing.T) (*usecases.WebhookServiceImpl, *mocks.MockwebhookRepository, *mocks.MockoutboxRepository, *mocks.MockWebhookSender) {
+	ctrl := gomock.NewController(t)
+
+	webhookRepo := mocks.NewMockwebhookRepository(ctrl)
+	outboxRepo := mocks.NewMockoutboxRepository(ctrl)
+	sender := mocks.NewMockWebhookSender(ctrl)
+
+	service := usecases.NewWebhookService(webhookRepo, outboxRepo, sender)
+
+	return service, webhookRepo, outboxRepo, sender
+}
+
+func TestWebhookService_CreateSubscription(t *testing.T) {
+	req := usecases.CreateWebhookRequest{
+		URL:        "https://hooks.example.com/reviews",
+		Secret:     "s3cret",
+		EventTypes: []string{"REVIEWER_ASSIGNED", "PR_MERGED"},
+	}
+
+	t.Run("admin creates subscription", func(t *testing.T) {
+		service, webhookRepo, _, _ := setupWebhookTest(t)
+		ctx := withPrincipal(auth.RoleAdmin, "")
+
+		webhookRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, s *webhooks.Subscription) error {
+			assert.Equal(t, "s3cret", s.Secret)
+			s.ID = 3
+			return nil
+		})
+
+		res, err := service.CreateSubscription(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, &usecases.WebhookView{
+			ID:         3,
+			URL:        "https://hooks.example.com/reviews",
+			EventTypes: []string{"REVIEWER_ASSIGNED", "PR_MERGED"},
+		}, res)
+	})
+
+	t.Run("team lead is forbidden", func(t *testing.T) {
+		service, _, _, _ := setupWebhookTest(t)
+
+		res, err := service.CreateSubscription(withPrincipal(auth.RoleTeamLead, "backend"), req)
+
+		assert.ErrorIs(t, err, errorsx.ErrForbidden)
+		assert.Nil(t, res)
+	})
+
+	t.Run("unknown event type", func(t *testing.T) {
+		service, _, _, _ := setupWebhookTest(t)
+		req := req
+		req.EventTypes = []string{"PR_CREATED"}
+
+		res, err := service.CreateSubscription(withPrincipal(auth.RoleAdmin, ""), req)
+
+		assert.ErrorIs(t, err, errorsx.ErrWebhookEventTypes)
+		assert.Nil(t, res)
+	})
+}
+
+func TestWebhookService_DeliverDue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful delivery", func(t *testing.T) {
+		service, _, outboxRepo, sender := setupWebhookTest(t)
+		d := &webhooks.Delivery{ID: 1, Status: webhooks.DeliveryPending}
+
+		outboxRepo.EXPECT().ClaimDue(ctx, gomock.Any(), gomock.Any()).Return([]*webhooks.Delivery{d}, nil)
+		sender.EXPECT().Send(ctx, d).Return(nil)
+		outboxRepo.EXPECT().Save(ctx, d).DoAndReturn(func(_ context.Context, d *webhooks.Delivery) error {
+			assert.Equal(t, webhooks.DeliveryDelivered, d.Status)
+			assert.Equal(t, 1, d.Attempts)
+			return nil
+		})
+
+		require.NoError(t, service.DeliverDue(ctx))
+	})
+
+	t.Run("failed delivery is rescheduled", func(t *testing.T) {
+		service, _, outboxRepo, sender := setupWebhookTest(t)
+		d := &webhooks.Delivery{ID: 1, Status: webhooks.DeliveryPending}
+		before := time.Now()
+
+		outboxRepo.EXPECT().ClaimDue(ctx, gomock.Any(), gomock.Any()).Return([]*webhooks.Delivery{d}, nil)
+		sender.EXPECT().Send(ctx, d).Return(errors.New("unexpected response status 503"))
+		outboxRepo.EXPECT().Save(ctx, d).DoAndReturn(func(_ context.Context, d *webhooks.Delivery) error {
+			assert.Equal(t, webhooks.DeliveryPending, d.Status)
+			assert.Equal(t, 1, d.Attempts)
+			assert.Equal(t, "unexpected response status 503", d.LastError)
+			assert.False(t, d.NextAttemptAt.Before(before.Add(webhooks.Backoff(1))))
+			return nil
+		})
+
+		require.NoError(t, service.DeliverDue(ctx))
+	})
+
+	t.Run("nothing is due", func(t *testing.T) {
+		service, _, outboxRepo, _ := setupWebhookTest(t)
+
+		outboxRepo.EXPECT().ClaimDue(ctx, gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		require.NoError(t, service.DeliverDue(ctx))
+	})
+
+	t.Run("save error does not stop other deliveries", func(t *testing.T) {
+		service, _, outboxRepo, sender := setupWebhookTest(t)
+		d1 := &webhooks.Delivery{ID: 1, Status: webhooks.DeliveryPending}
+		d2 := &webhooks.Delivery{ID: 2, Status: webhooks.DeliveryPending}
+
+		outboxRepo.EXPECT().ClaimDue(ctx, gomock.Any(), gomock.Any()).Return([]*webhooks.Delivery{d1, d2}, nil)
+		sender.EXPECT().Send(ctx, d1).Return(nil)
+		outboxRepo.EXPECT().Save(ctx, d1).Return(errors.New("db error"))
+		sender.EXPECT().Send(ctx, d2).Return(nil)
+		outboxRepo.EXPECT().Save(ctx, d2).Return(nil)
+
+		err := service.DeliverDue(ctx)
+
+		assert.ErrorContains(t, err, "saving delivery 1")
+		assert.Equal(t, webhooks.DeliveryDelivered, d2.Status)
+	})
+}
+
+func TestPullRequestService_EnqueuesWebhookEvents(t *testing.T) {
+	setup := func(t *testing.T) (*usecases.PullRequestServiceImpl, *mocks.MockReviewerPicker, *mocks.MockprRepository, *mocks.MockteamRepository, *mocks.MockoutboxRepository) {
+		ctrl := gomock.NewController(t)
+
+		rpicker := mocks.NewMockReviewerPicker(ctrl)
+		prRepo := mocks.NewMockprRepository(ctrl)
+		teamRepo := mocks.NewMockteamRepository(ctrl)
+		outboxRepo := mocks.NewMockoutboxRepository(ctrl)
+
+		settingsRepo := mocks.NewMockteamSettingsRepository(ctrl)
+		settingsRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errorsx.ErrNotFound).AnyTimes()
+
+		service := usecases.NewPullRequestService(setupNoopTx(ctrl), rpicker, prRepo, teamRepo, mocks.NewMockuserRepository(ctrl), settingsRepo, setupNoopHistory(ctrl), outboxRepo)
+
+		return service, rpicker, prRepo, teamRepo, outboxRepo
+	}
+
+	stripAt := func(events []webhooks.Event) []webhooks.Event {
+		for i := range events {
+			events[i].At = time.Time{}
+		}
+		return events
+	}
+
+	t.Run("create enqueues assignments", func(t *testing.T) {
+		service, rpicker, prRepo, teamRepo, outboxRepo := setup(t)
+		ctx := usecases.WithActor(context.Background(), "alice")
+		team := &teams.Team{Name: "backend", MemberIDs: []string{"author", "u1"}}
+
+		teamRepo.EXPECT().GetManyByMemberID(ctx, "author").Return([]*teams.Team{team}, nil)
+		rpicker.EXPECT().PickReviewersFromTeam(ctx, gomock.Any()).Return([]string{"u1"}, nil)
+		prRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+		outboxRepo.EXPECT().Enqueue(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events ...webhooks.Event) error {
+			assert.Equal(t, []webhooks.Event{
+				{Type: webhooks.EventReviewerAssigned, PullRequestID: "pr-1", ReviewerID: "u1", TeamNames: []string{"backend"}, Reason: "INITIAL_PICK", Actor: "alice"},
+			}, stripAt(events))
+			return nil
+		})
+
+		_, err := service.Create(ctx, usecases.CreateRequest{ID: "pr-1", Name: "Fix", AuthorID: "author"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("merge enqueues merged event", func(t *testing.T) {
+		service, _, prRepo, _, outboxRepo := setup(t)
+		ctx := usecases.WithActor(context.Background(), "alice")
+		pr := &prs.PullRequest{ID: "pr-1", Status: prs.StatusOpen, AuthorID: "author", ReviewersCount: 2}
+
+		prRepo.EXPECT().GetByID(ctx, "pr-1").Return(pr, nil)
+		prRepo.EXPECT().Save(ctx, pr).Return(nil)
+		outboxRepo.EXPECT().Enqueue(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events ...webhooks.Event) error {
+			require.Len(t, events, 1)
+			assert.Equal(t, webhooks.EventPullRequestMerged, events[0].Type)
+			assert.Equal(t, "pr-1", events[0].PullRequestID)
+			assert.Equal(t, "alice", events[0].Actor)
+			assert.Equal(t, pr.MergedAt, events[0].At)
+			return nil
+		})
+
+		_, err := service.Merge(ctx, "pr-1")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("merging merged PR enqueues nothing", func(t *testing.T) {
+		service, _, prRepo, _, _ := setup(t)
+		ctx := context.Background()
+		pr := &prs.PullRequest{ID: "pr-1", Status: prs.StatusMerged, MergedAt: time.Now()}
+
+		prRepo.EXPECT().GetByID(ctx, "pr-1").Return(pr, nil)
+		prRepo.EXPECT().Save(ctx, pr).Return(nil)
+
+		_, err := service.Merge(ctx, "pr-1")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("enqueue error aborts merge", func(t *testing.T) {
+		service, _, prRepo, _, outboxRepo := setup(t)
+		ctx := context.Background()
+		pr := &prs.PullRequest{ID: "pr-1", Status: prs.StatusOpen}
+
+		prRepo.EXPECT().GetByID(ctx, "pr-1").Return(pr, nil)
+		prRepo.EXPECT().Save(ctx, pr).Return(nil)
+		outboxRepo.EXPECT().Enqueue(ctx, gomock.Any()).Return(errors.New("db error"))
+
+		_, err := service.Merge(ctx, "pr-1")
+
+		assert.ErrorContains(t, err, "enqueueing webhook events")
+	})
+}
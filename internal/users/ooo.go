@@ -0,0 +1,41 @@
+package users
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+)
+
+// OutOfOffice is a period when the user is not available for reviews, while staying active.
+type OutOfOffice struct {
+	ID       int64
+	UserID   string
+	StartsAt time.Time
+	EndsAt   time.Time
+	Reason   string
+	// ReassignReviews requests reassignment of user's open reviews once the period starts.
+	ReassignReviews bool
+}
+
+func NewOutOfOffice(userID string, startsAt, endsAt time.Time, reason string, reassignReviews bool) (*OutOfOffice, error) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, errorsx.ErrUserID
+	}
+	if startsAt.IsZero() || !endsAt.After(startsAt) {
+		return nil, errorsx.ErrPeriod
+	}
+
+	return &OutOfOffice{
+		UserID:          userID,
+		StartsAt:        startsAt,
+		EndsAt:          endsAt,
+		Reason:          strings.TrimSpace(reason),
+		ReassignReviews: reassignReviews,
+	}, nil
+}
+
+// Covers reports whether the moment is inside the period, start is inclusive and end is exclusive.
+func (o *OutOfOffice) Covers(t time.Time) bool {
+	return !t.Before(o.StartsAt) && t.Before(o.EndsAt)
+}
@@ -0,0 +1,64 @@
+package users_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/users"
+)
+
+func TestNewOutOfOffice(t *testing.T) {
+	start := time.Date(2025, 12, 22, 0, 0, 0, 0, time.UTC)
+	end := start.Add(7 * 24 * time.Hour)
+
+	t.Run("valid period", func(t *testing.T) {
+		ooo, err := users.NewOutOfOffice("u1", start, end, "  vacation ", true)
+
+		require.NoError(t, err)
+		assert.Equal(t, "u1", ooo.UserID)
+		assert.Equal(t, start, ooo.StartsAt)
+		assert.Equal(t, end, ooo.EndsAt)
+		assert.Equal(t, "vacation", ooo.Reason)
+		assert.True(t, ooo.ReassignReviews)
+	})
+
+	t.Run("empty user id", func(t *testing.T) {
+		_, err := users.NewOutOfOffice(" ", start, end, "", false)
+
+		assert.ErrorIs(t, err, errorsx.ErrUserID)
+	})
+
+	t.Run("end before start", func(t *testing.T) {
+		_, err := users.NewOutOfOffice("u1", end, start, "", false)
+
+		assert.ErrorIs(t, err, errorsx.ErrPeriod)
+	})
+
+	t.Run("empty period", func(t *testing.T) {
+		_, err := users.NewOutOfOffice("u1", start, start, "", false)
+
+		assert.ErrorIs(t, err, errorsx.ErrPeriod)
+	})
+
+	t.Run("missing start", func(t *testing.T) {
+		_, err := users.NewOutOfOffice("u1", time.Time{}, end, "", false)
+
+		assert.ErrorIs(t, err, errorsx.ErrPeriod)
+	})
+}
+
+func TestOutOfOffice_Covers(t *testing.T) {
+	start := time.Date(2025, 12, 22, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	ooo, err := users.NewOutOfOffice("u1", start, end, "", false)
+	require.NoError(t, err)
+
+	assert.False(t, ooo.Covers(start.Add(-time.Second)))
+	assert.True(t, ooo.Covers(start))
+	assert.True(t, ooo.Covers(start.Add(time.Hour)))
+	assert.False(t, ooo.Covers(end))
+}
@@ -10,6 +10,8 @@ type User struct {
 	ID     string
 	Name   string
 	Active bool
+	// OutOfOffice is set while the user is inside one of the out-of-office periods, it is not persisted with the user.
+	OutOfOffice bool
 }
 
 func New(id, name string, active bool) (*User, error) {
@@ -26,3 +28,8 @@ func New(id, name string, active bool) (*User, error) {
 		Active: active,
 	}, nil
 }
+
+// Available reports whether the user may be picked as a reviewer right now.
+func (u *User) Available() bool {
+	return u.Active && !u.OutOfOffice
+}
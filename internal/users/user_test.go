@@ -52,3 +52,23 @@ func TestNewUser(t *testing.T) {
 		assert.Equal(t, errorsx.ErrUserID, err)
 	})
 }
+
+func TestUser_Available(t *testing.T) {
+	t.Run("active user", func(t *testing.T) {
+		user := &users.User{ID: "u1", Name: "Alice", Active: true}
+
+		assert.True(t, user.Available())
+	})
+
+	t.Run("inactive user", func(t *testing.T) {
+		user := &users.User{ID: "u1", Name: "Alice", Active: false}
+
+		assert.False(t, user.Available())
+	})
+
+	t.Run("active user out of office", func(t *testing.T) {
+		user := &users.User{ID: "u1", Name: "Alice", Active: true, OutOfOffice: true}
+
+		assert.False(t, user.Available())
+	})
+}
@@ -0,0 +1,77 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "PENDING"
+	DeliveryDelivered DeliveryStatus = "DELIVERED"
+	// DeliveryDead is the dead-letter state of deliveries which failed MaxAttempts times.
+	DeliveryDead DeliveryStatus = "DEAD"
+)
+
+const (
+	MaxAttempts = 10
+
+	backoffBase = 30 * time.Second
+	backoffMax  = time.Hour
+)
+
+// Delivery is an event enqueued for a single subscriber.
+type Delivery struct {
+	ID             int64
+	SubscriptionID int64
+	URL            string
+	Secret         string
+	EventType      EventType
+	Payload        []byte
+	Status         DeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      string
+}
+
+// Signature returns hex-encoded HMAC-SHA256 of the payload keyed with subscription's secret, prefixed with "sha256=".
+func (d *Delivery) Signature() string {
+	return Sign(d.Secret, d.Payload)
+}
+
+func (d *Delivery) Succeed() {
+	d.Attempts++
+	d.Status = DeliveryDelivered
+	d.LastError = ""
+}
+
+// Fail schedules the next attempt with exponential backoff or moves the delivery to the dead-letter state.
+func (d *Delivery) Fail(err error, now time.Time) {
+	d.Attempts++
+	d.LastError = err.Error()
+
+	if d.Attempts >= MaxAttempts {
+		d.Status = DeliveryDead
+		return
+	}
+
+	d.NextAttemptAt = now.Add(Backoff(d.Attempts))
+}
+
+// Backoff returns delay before the next attempt after the given number of failed ones.
+func Backoff(attempts int) time.Duration {
+	delay := backoffBase
+	for i := 1; i < attempts && delay < backoffMax; i++ {
+		delay *= 2
+	}
+	return min(delay, backoffMax)
+}
+
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,66 @@
+package webhooks_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+)
+
+func TestSign(t *testing.T) {
+	payload := []byte(`{"event_type":"PR_MERGED"}`)
+
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(payload)
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.Equal(t, want, webhooks.Sign("s3cret", payload))
+	assert.NotEqual(t, want, webhooks.Sign("other", payload))
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, webhooks.Backoff(1))
+	assert.Equal(t, time.Minute, webhooks.Backoff(2))
+	assert.Equal(t, 4*time.Minute, webhooks.Backoff(4))
+	assert.Equal(t, time.Hour, webhooks.Backoff(9))
+}
+
+func TestDelivery_Fail(t *testing.T) {
+	now := time.Date(2025, 12, 25, 12, 0, 0, 0, time.UTC)
+
+	t.Run("schedules retry", func(t *testing.T) {
+		d := &webhooks.Delivery{Status: webhooks.DeliveryPending, Attempts: 1}
+
+		d.Fail(errors.New("connection refused"), now)
+
+		assert.Equal(t, webhooks.DeliveryPending, d.Status)
+		assert.Equal(t, 2, d.Attempts)
+		assert.Equal(t, now.Add(time.Minute), d.NextAttemptAt)
+		assert.Equal(t, "connection refused", d.LastError)
+	})
+
+	t.Run("moves to dead-letter state after the last attempt", func(t *testing.T) {
+		d := &webhooks.Delivery{Status: webhooks.DeliveryPending, Attempts: webhooks.MaxAttempts - 1}
+
+		d.Fail(errors.New("unexpected response status 500"), now)
+
+		assert.Equal(t, webhooks.DeliveryDead, d.Status)
+		assert.Equal(t, webhooks.MaxAttempts, d.Attempts)
+	})
+}
+
+func TestDelivery_Succeed(t *testing.T) {
+	d := &webhooks.Delivery{Status: webhooks.DeliveryPending, Attempts: 2, LastError: "timeout"}
+
+	d.Succeed()
+
+	assert.Equal(t, webhooks.DeliveryDelivered, d.Status)
+	assert.Equal(t, 3, d.Attempts)
+	assert.Empty(t, d.LastError)
+}
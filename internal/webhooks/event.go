@@ -0,0 +1,70 @@
+package webhooks
+
+import (
+	"encoding/json/v2"
+	"time"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+)
+
+// Event is sent to subscribers as a JSON body of the delivery.
+// Reassignment produces a pair of REVIEWER_UNASSIGNED and REVIEWER_ASSIGNED events with the same reason.
+type Event struct {
+	Type          EventType `json:"event_type"`
+	PullRequestID string    `json:"pull_request_id,omitempty"`
+	ReviewerID    string    `json:"reviewer_id,omitempty"`
+	AuthorID      string    `json:"author_id,omitempty"`
+	UserID        string    `json:"user_id,omitempty"` // deactivated user
+	// TeamNames are the team of the PR or teams of the deactivated user.
+	TeamNames []string  `json:"team_names,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	Actor     string    `json:"actor"`
+	At        time.Time `json:"at"`
+}
+
+// EventsFromAssignments converts assignment history entries of the PR into reviewer events.
+func EventsFromAssignments(pr *prs.PullRequest, assignments []prs.AssignmentEvent) []Event {
+	events := make([]Event, len(assignments))
+	for i, a := range assignments {
+		eventType := EventReviewerAssigned
+		if a.Action == prs.ActionUnassigned {
+			eventType = EventReviewerUnassigned
+		}
+
+		events[i] = Event{
+			Type:          eventType,
+			PullRequestID: a.PullRequestID,
+			ReviewerID:    a.ReviewerID,
+			TeamNames:     []string{pr.OriginalTeamName},
+			Reason:        string(a.Reason),
+			Actor:         a.Actor,
+			At:            a.At,
+		}
+	}
+	return events
+}
+
+func PullRequestMerged(pr *prs.PullRequest, actor string) Event {
+	return Event{
+		Type:          EventPullRequestMerged,
+		PullRequestID: pr.ID,
+		AuthorID:      pr.AuthorID,
+		TeamNames:     []string{pr.OriginalTeamName},
+		Actor:         actor,
+		At:            pr.MergedAt,
+	}
+}
+
+func UserDeactivated(userID string, teamNames []string, actor string, at time.Time) Event {
+	return Event{
+		Type:      EventUserDeactivated,
+		UserID:    userID,
+		TeamNames: teamNames,
+		Actor:     actor,
+		At:        at,
+	}
+}
+
+func (e Event) Payload() ([]byte, error) {
+	return json.Marshal(e)
+}
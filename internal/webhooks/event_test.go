@@ -0,0 +1,39 @@
+package webhooks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/prs"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+)
+
+func TestEventsFromAssignments(t *testing.T) {
+	at := time.Date(2025, 12, 25, 12, 0, 0, 0, time.UTC)
+
+	pr := &prs.PullRequest{ID: "pr-1", OriginalTeamName: "backend", AuthorID: "u2"}
+
+	events := webhooks.EventsFromAssignments(pr, []prs.AssignmentEvent{
+		{PullRequestID: "pr-1", ReviewerID: "u1", Action: prs.ActionUnassigned, Reason: prs.ReasonManualReassign, Actor: "alice", At: at},
+		{PullRequestID: "pr-1", ReviewerID: "u3", Action: prs.ActionAssigned, Reason: prs.ReasonManualReassign, Actor: "alice", At: at},
+	})
+
+	assert.Equal(t, []webhooks.Event{
+		{Type: webhooks.EventReviewerUnassigned, PullRequestID: "pr-1", ReviewerID: "u1", TeamNames: []string{"backend"}, Reason: "MANUAL_REASSIGN", Actor: "alice", At: at},
+		{Type: webhooks.EventReviewerAssigned, PullRequestID: "pr-1", ReviewerID: "u3", TeamNames: []string{"backend"}, Reason: "MANUAL_REASSIGN", Actor: "alice", At: at},
+	}, events)
+}
+
+func TestEvent_Payload(t *testing.T) {
+	at := time.Date(2025, 12, 25, 12, 0, 0, 0, time.UTC)
+
+	pr := &prs.PullRequest{ID: "pr-1", OriginalTeamName: "backend", AuthorID: "u2", Status: prs.StatusMerged, MergedAt: at}
+
+	payload, err := webhooks.PullRequestMerged(pr, "system").Payload()
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"event_type":"PR_MERGED","pull_request_id":"pr-1","author_id":"u2","team_names":["backend"],"actor":"system","at":"2025-12-25T12:00:00Z"}`, string(payload))
+}
@@ -0,0 +1,59 @@
+package webhooks
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+)
+
+type EventType string
+
+const (
+	EventReviewerAssigned   EventType = "REVIEWER_ASSIGNED"
+	EventReviewerUnassigned EventType = "REVIEWER_UNASSIGNED"
+	EventPullRequestMerged  EventType = "PR_MERGED"
+	EventUserDeactivated    EventType = "USER_DEACTIVATED"
+)
+
+var eventTypes = []EventType{
+	EventReviewerAssigned,
+	EventReviewerUnassigned,
+	EventPullRequestMerged,
+	EventUserDeactivated,
+}
+
+// Subscription receives events of the given types, every delivery is signed with the secret.
+type Subscription struct {
+	ID         int64
+	URL        string
+	Secret     string
+	EventTypes []EventType
+}
+
+func NewSubscription(rawURL, secret string, types []EventType) (*Subscription, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errorsx.ErrWebhookURL
+	}
+
+	if strings.TrimSpace(secret) == "" {
+		return nil, errorsx.ErrWebhookSecret
+	}
+
+	if len(types) == 0 {
+		return nil, errorsx.ErrWebhookEventTypes
+	}
+	for i, t := range types {
+		if !slices.Contains(eventTypes, t) || slices.Contains(types[:i], t) {
+			return nil, errorsx.ErrWebhookEventTypes
+		}
+	}
+
+	return &Subscription{
+		URL:        rawURL,
+		Secret:     secret,
+		EventTypes: types,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package webhooks_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lezzercringe/avito-test-assignment/internal/errorsx"
+	"github.com/lezzercringe/avito-test-assignment/internal/webhooks"
+)
+
+func TestNewSubscription(t *testing.T) {
+	types := []webhooks.EventType{webhooks.EventReviewerAssigned, webhooks.EventPullRequestMerged}
+
+	t.Run("valid subscription", func(t *testing.T) {
+		s, err := webhooks.NewSubscription("https://hooks.example.com/reviews", "s3cret", types)
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://hooks.example.com/reviews", s.URL)
+		assert.Equal(t, "s3cret", s.Secret)
+		assert.Equal(t, types, s.EventTypes)
+	})
+
+	t.Run("invalid url", func(t *testing.T) {
+		for _, raw := range []string{"", "hooks.example.com", "ftp://hooks.example.com", "https://", "://bad"} {
+			_, err := webhooks.NewSubscription(raw, "s3cret", types)
+
+			assert.Equal(t, errorsx.ErrWebhookURL, err, raw)
+		}
+	})
+
+	t.Run("empty secret", func(t *testing.T) {
+		_, err := webhooks.NewSubscription("https://hooks.example.com", "  ", types)
+
+		assert.Equal(t, errorsx.ErrWebhookSecret, err)
+	})
+
+	t.Run("invalid event types", func(t *testing.T) {
+		invalid := [][]webhooks.EventType{
+			nil,
+			{"PR_CREATED"},
+			{webhooks.EventReviewerAssigned, webhooks.EventReviewerAssigned},
+		}
+		for _, types := range invalid {
+			_, err := webhooks.NewSubscription("https://hooks.example.com", "s3cret", types)
+
+			assert.Equal(t, errorsx.ErrWebhookEventTypes, err)
+		}
+	})
+}
@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS team_settings (
+    team_name VARCHAR(255) PRIMARY KEY REFERENCES teams(name),
+    strategy VARCHAR(255) NOT NULL,
+    reviewers_count INT NOT NULL,
+    allow_other_teams BOOLEAN NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS team_settings;
@@ -0,0 +1,8 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS review_rotation_cursors (
+    team_name VARCHAR(255) PRIMARY KEY REFERENCES teams(name),
+    last_user_id VARCHAR(255) NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS review_rotation_cursors;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS reviewers_count INT NOT NULL DEFAULT 2;
+
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS min_reviewers_count INT NOT NULL DEFAULT 0;
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS max_reviewers_count INT NOT NULL DEFAULT 2;
+
+-- +goose Down
+ALTER TABLE team_settings DROP COLUMN IF EXISTS max_reviewers_count;
+ALTER TABLE team_settings DROP COLUMN IF EXISTS min_reviewers_count;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS reviewers_count;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE reviewers ADD COLUMN IF NOT EXISTS state VARCHAR(255) NOT NULL DEFAULT 'PENDING';
+ALTER TABLE reviewers ADD COLUMN IF NOT EXISTS state_updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS required_approvals INT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE team_settings DROP COLUMN IF EXISTS required_approvals;
+
+ALTER TABLE reviewers DROP COLUMN IF EXISTS state_updated_at;
+ALTER TABLE reviewers DROP COLUMN IF EXISTS state;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS review_assignments_history (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) REFERENCES pull_requests(id) NOT NULL,
+    user_id VARCHAR(255) REFERENCES users(id) NOT NULL,
+    action VARCHAR(255) NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_assignments_history_pull_request_id ON review_assignments_history(pull_request_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_review_assignments_history_pull_request_id;
+
+DROP TABLE IF EXISTS review_assignments_history;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- reviewers assigned before the history was recorded
+INSERT INTO review_assignments_history (pull_request_id, user_id, action, reason, actor, created_at)
+SELECT r.pull_request_id, r.user_id, 'ASSIGNED', 'INITIAL_PICK', 'system', r.state_updated_at
+FROM reviewers r
+WHERE NOT EXISTS (
+    SELECT 1 FROM review_assignments_history h WHERE h.pull_request_id = r.pull_request_id
+);
+
+INSERT INTO review_assignments_history (pull_request_id, user_id, action, reason, actor, created_at)
+SELECT r.pull_request_id, r.user_id, 'UNASSIGNED', 'MERGE', 'system', COALESCE(pr.merged_at, now())
+FROM reviewers r
+JOIN pull_requests pr ON pr.id = r.pull_request_id
+WHERE pr.status = 'MERGED'
+  AND NOT EXISTS (
+    SELECT 1 FROM review_assignments_history h
+    WHERE h.pull_request_id = r.pull_request_id AND h.action = 'UNASSIGNED'
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_assignments_history_created_at ON review_assignments_history(created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_review_assignments_history_created_at;
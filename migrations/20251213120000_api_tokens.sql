@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS api_tokens (
+    name VARCHAR(255) PRIMARY KEY,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    role VARCHAR(255) NOT NULL,
+    team_name VARCHAR(255) REFERENCES teams(name),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS api_tokens;
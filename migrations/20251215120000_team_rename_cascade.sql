@@ -0,0 +1,39 @@
+-- +goose Up
+-- renaming a team cascades to every row which references it,
+-- team's settings, rotation cursor and scoped api tokens are removed along with the team
+ALTER TABLE memberships DROP CONSTRAINT IF EXISTS memberships_team_name_fkey;
+ALTER TABLE memberships ADD CONSTRAINT memberships_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(name) ON UPDATE CASCADE;
+
+ALTER TABLE team_settings DROP CONSTRAINT IF EXISTS team_settings_team_name_fkey;
+ALTER TABLE team_settings ADD CONSTRAINT team_settings_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(name) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE review_rotation_cursors DROP CONSTRAINT IF EXISTS review_rotation_cursors_team_name_fkey;
+ALTER TABLE review_rotation_cursors ADD CONSTRAINT review_rotation_cursors_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(name) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE api_tokens DROP CONSTRAINT IF EXISTS api_tokens_team_name_fkey;
+ALTER TABLE api_tokens ADD CONSTRAINT api_tokens_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(name) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_original_team_name ON pull_requests(original_team_name);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_pull_requests_original_team_name;
+
+ALTER TABLE api_tokens DROP CONSTRAINT IF EXISTS api_tokens_team_name_fkey;
+ALTER TABLE api_tokens ADD CONSTRAINT api_tokens_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(name);
+
+ALTER TABLE review_rotation_cursors DROP CONSTRAINT IF EXISTS review_rotation_cursors_team_name_fkey;
+ALTER TABLE review_rotation_cursors ADD CONSTRAINT review_rotation_cursors_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(name);
+
+ALTER TABLE team_settings DROP CONSTRAINT IF EXISTS team_settings_team_name_fkey;
+ALTER TABLE team_settings ADD CONSTRAINT team_settings_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(name);
+
+ALTER TABLE memberships DROP CONSTRAINT IF EXISTS memberships_team_name_fkey;
+ALTER TABLE memberships ADD CONSTRAINT memberships_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(name);
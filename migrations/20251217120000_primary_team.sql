@@ -0,0 +1,10 @@
+-- +goose Up
+-- users may be members of several teams, primary one is used when the team is not specified explicitly
+ALTER TABLE memberships ADD COLUMN IF NOT EXISTS is_primary BOOLEAN NOT NULL DEFAULT false;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_memberships_primary_user_id ON memberships(user_id) WHERE is_primary;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_memberships_primary_user_id;
+
+ALTER TABLE memberships DROP COLUMN IF EXISTS is_primary;
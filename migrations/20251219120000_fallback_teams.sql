@@ -0,0 +1,6 @@
+-- +goose Up
+-- teams whose members are picked in the given order when the team itself lacks candidates
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS fallback_team_names VARCHAR(255)[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE team_settings DROP COLUMN IF EXISTS fallback_team_names;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS out_of_office_periods (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id),
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    -- open reviews of the user are reassigned once the period starts
+    reassign_reviews BOOLEAN NOT NULL DEFAULT false,
+    reviews_reassigned BOOLEAN NOT NULL DEFAULT false,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_out_of_office_periods_user_id ON out_of_office_periods(user_id, ends_at);
+CREATE INDEX IF NOT EXISTS idx_out_of_office_periods_pending_reassignment ON out_of_office_periods(starts_at)
+    WHERE reassign_reviews AND NOT reviews_reassigned;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_out_of_office_periods_pending_reassignment;
+DROP INDEX IF EXISTS idx_out_of_office_periods_user_id;
+
+DROP TABLE IF EXISTS out_of_office_periods;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- pending reviews older than the SLA are reassigned in background, 0 disables reassignment
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS review_sla_seconds INT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_reviewers_pending_state_updated_at ON reviewers(state_updated_at)
+    WHERE state = 'PENDING';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_reviewers_pending_state_updated_at;
+
+ALTER TABLE team_settings DROP COLUMN IF EXISTS review_sla_seconds;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS git_accounts (
+    host VARCHAR(255) NOT NULL,
+    login VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) REFERENCES users(id) NOT NULL,
+    PRIMARY KEY (host, login)
+);
+
+CREATE INDEX IF NOT EXISTS idx_git_accounts_user_id ON git_accounts(user_id);
+
+-- deliveries received from Git hosts, redelivered events are recognized by their ids
+CREATE TABLE IF NOT EXISTS integration_deliveries (
+    host VARCHAR(255) NOT NULL,
+    delivery_id VARCHAR(255) NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (host, delivery_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS integration_deliveries;
+
+DROP INDEX IF EXISTS idx_git_accounts_user_id;
+DROP TABLE IF EXISTS git_accounts;
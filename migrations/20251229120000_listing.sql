@@ -0,0 +1,27 @@
+-- +goose Up
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- pull requests created before the column existed are dated by their first assignment
+UPDATE pull_requests pr SET created_at = h.first_assigned_at
+FROM (
+    SELECT pull_request_id, MIN(created_at) AS first_assigned_at
+    FROM review_assignments_history
+    GROUP BY pull_request_id
+) h
+WHERE h.pull_request_id = pr.id;
+
+-- listings are ordered by (created_at, id) newest first
+CREATE INDEX IF NOT EXISTS idx_pull_requests_created_at ON pull_requests(created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_author_id ON pull_requests(author_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_team_name ON pull_requests(original_team_name, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_status ON pull_requests(status, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_merged_at ON pull_requests(merged_at) WHERE merged_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_pull_requests_merged_at;
+DROP INDEX IF EXISTS idx_pull_requests_status;
+DROP INDEX IF EXISTS idx_pull_requests_team_name;
+DROP INDEX IF EXISTS idx_pull_requests_author_id;
+DROP INDEX IF EXISTS idx_pull_requests_created_at;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS created_at;
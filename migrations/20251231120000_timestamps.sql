@@ -0,0 +1,28 @@
+-- +goose Up
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- reviewers are updated along with their state, so state_updated_at is the update time of the assignment
+ALTER TABLE reviewers ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE users ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+UPDATE reviewers r SET created_at = COALESCE((
+    SELECT MAX(h.created_at) FROM review_assignments_history h
+    WHERE h.pull_request_id = r.pull_request_id AND h.user_id = r.user_id AND h.action = 'ASSIGNED'
+), r.state_updated_at);
+
+UPDATE pull_requests pr SET updated_at = GREATEST(
+    pr.created_at,
+    pr.merged_at,
+    (SELECT MAX(h.created_at) FROM review_assignments_history h WHERE h.pull_request_id = pr.id),
+    (SELECT MAX(r.state_updated_at) FROM reviewers r WHERE r.pull_request_id = pr.id)
+);
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE users DROP COLUMN IF EXISTS created_at;
+
+ALTER TABLE reviewers DROP COLUMN IF EXISTS created_at;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS updated_at;
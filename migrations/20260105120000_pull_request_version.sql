@@ -0,0 +1,6 @@
+-- +goose Up
+-- version is incremented on every save of the pull request and guards against lost updates
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS version;
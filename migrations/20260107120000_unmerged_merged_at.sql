@@ -0,0 +1,6 @@
+-- +goose Up
+-- unmerged pull requests saved in bulk used to get zero time instead of NULL
+UPDATE pull_requests SET merged_at = NULL WHERE merged_at = '0001-01-01 00:00:00+00'::timestamptz;
+
+-- +goose Down
+-- zero times are not restored, NULL is their only valid representation
@@ -0,0 +1,32 @@
+// Package migrations embeds goose migrations, so the service knows which schema version it expects.
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var FS embed.FS
+
+// LatestVersion returns version of the newest migration, which is the numeric prefix of its file name.
+func LatestVersion() int64 {
+	entries, err := fs.ReadDir(FS, ".")
+	if err != nil {
+		panic(err)
+	}
+
+	var latest int64
+	for _, e := range entries {
+		prefix, _, _ := strings.Cut(e.Name(), "_")
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			continue
+		}
+		latest = max(latest, version)
+	}
+
+	return latest
+}
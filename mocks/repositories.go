@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/lezzercringe/avito-test-assignment/internal/usecases (interfaces: teamRepository,prRepository,userRepository)
+// Source: github.com/lezzercringe/avito-test-assignment/internal/usecases (interfaces: teamRepository,prRepository,userRepository,teamSettingsRepository,rotationRepository,historyRepository,statsRepository,tokenRepository,outOfOfficeRepository,webhookRepository,outboxRepository,gitAccountRepository,integrationDeliveryRepository,eventListener)
 //
 // Generated by this command:
 //
-//	mockgen -typed -destination ../../mocks/repositories.go -package mocks . teamRepository,prRepository,userRepository
+//	mockgen -typed -destination ../../mocks/repositories.go -package mocks . teamRepository,prRepository,userRepository,teamSettingsRepository,rotationRepository,historyRepository,statsRepository,tokenRepository,outOfOfficeRepository,webhookRepository,outboxRepository,gitAccountRepository,integrationDeliveryRepository,eventListener
 //
 
 // Package mocks is a generated GoMock package.
@@ -12,11 +12,15 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	auth "github.com/lezzercringe/avito-test-assignment/internal/auth"
+	integrations "github.com/lezzercringe/avito-test-assignment/internal/integrations"
 	prs "github.com/lezzercringe/avito-test-assignment/internal/prs"
 	teams "github.com/lezzercringe/avito-test-assignment/internal/teams"
 	usecases "github.com/lezzercringe/avito-test-assignment/internal/usecases"
 	users "github.com/lezzercringe/avito-test-assignment/internal/users"
+	webhooks "github.com/lezzercringe/avito-test-assignment/internal/webhooks"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -44,41 +48,117 @@ func (m *MockteamRepository) EXPECT() *MockteamRepositoryMockRecorder {
 	return m.recorder
 }
 
-// GetByMemberID mocks base method.
-func (m *MockteamRepository) GetByMemberID(ctx context.Context, memberID string) (*teams.Team, error) {
+// Create mocks base method.
+func (m *MockteamRepository) Create(ctx context.Context, t *teams.Team) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByMemberID", ctx, memberID)
-	ret0, _ := ret[0].(*teams.Team)
+	ret := m.ctrl.Call(m, "Create", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockteamRepositoryMockRecorder) Create(ctx, t any) *MockteamRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockteamRepository)(nil).Create), ctx, t)
+	return &MockteamRepositoryCreateCall{Call: call}
+}
+
+// MockteamRepositoryCreateCall wrap *gomock.Call
+type MockteamRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockteamRepositoryCreateCall) Return(arg0 error) *MockteamRepositoryCreateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockteamRepositoryCreateCall) Do(f func(context.Context, *teams.Team) error) *MockteamRepositoryCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockteamRepositoryCreateCall) DoAndReturn(f func(context.Context, *teams.Team) error) *MockteamRepositoryCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockteamRepository) Delete(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockteamRepositoryMockRecorder) Delete(ctx, name any) *MockteamRepositoryDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockteamRepository)(nil).Delete), ctx, name)
+	return &MockteamRepositoryDeleteCall{Call: call}
+}
+
+// MockteamRepositoryDeleteCall wrap *gomock.Call
+type MockteamRepositoryDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockteamRepositoryDeleteCall) Return(arg0 error) *MockteamRepositoryDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockteamRepositoryDeleteCall) Do(f func(context.Context, string) error) *MockteamRepositoryDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockteamRepositoryDeleteCall) DoAndReturn(f func(context.Context, string) error) *MockteamRepositoryDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAllMemberIDs mocks base method.
+func (m *MockteamRepository) GetAllMemberIDs(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllMemberIDs", ctx)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByMemberID indicates an expected call of GetByMemberID.
-func (mr *MockteamRepositoryMockRecorder) GetByMemberID(ctx, memberID any) *MockteamRepositoryGetByMemberIDCall {
+// GetAllMemberIDs indicates an expected call of GetAllMemberIDs.
+func (mr *MockteamRepositoryMockRecorder) GetAllMemberIDs(ctx any) *MockteamRepositoryGetAllMemberIDsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMemberID", reflect.TypeOf((*MockteamRepository)(nil).GetByMemberID), ctx, memberID)
-	return &MockteamRepositoryGetByMemberIDCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMemberIDs", reflect.TypeOf((*MockteamRepository)(nil).GetAllMemberIDs), ctx)
+	return &MockteamRepositoryGetAllMemberIDsCall{Call: call}
 }
 
-// MockteamRepositoryGetByMemberIDCall wrap *gomock.Call
-type MockteamRepositoryGetByMemberIDCall struct {
+// MockteamRepositoryGetAllMemberIDsCall wrap *gomock.Call
+type MockteamRepositoryGetAllMemberIDsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockteamRepositoryGetByMemberIDCall) Return(arg0 *teams.Team, arg1 error) *MockteamRepositoryGetByMemberIDCall {
+func (c *MockteamRepositoryGetAllMemberIDsCall) Return(arg0 []string, arg1 error) *MockteamRepositoryGetAllMemberIDsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockteamRepositoryGetByMemberIDCall) Do(f func(context.Context, string) (*teams.Team, error)) *MockteamRepositoryGetByMemberIDCall {
+func (c *MockteamRepositoryGetAllMemberIDsCall) Do(f func(context.Context) ([]string, error)) *MockteamRepositoryGetAllMemberIDsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockteamRepositoryGetByMemberIDCall) DoAndReturn(f func(context.Context, string) (*teams.Team, error)) *MockteamRepositoryGetByMemberIDCall {
+func (c *MockteamRepositoryGetAllMemberIDsCall) DoAndReturn(f func(context.Context) ([]string, error)) *MockteamRepositoryGetAllMemberIDsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -122,6 +202,45 @@ func (c *MockteamRepositoryGetByNameCall) DoAndReturn(f func(context.Context, st
 	return c
 }
 
+// GetManyByMemberID mocks base method.
+func (m *MockteamRepository) GetManyByMemberID(ctx context.Context, memberID string) ([]*teams.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyByMemberID", ctx, memberID)
+	ret0, _ := ret[0].([]*teams.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManyByMemberID indicates an expected call of GetManyByMemberID.
+func (mr *MockteamRepositoryMockRecorder) GetManyByMemberID(ctx, memberID any) *MockteamRepositoryGetManyByMemberIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyByMemberID", reflect.TypeOf((*MockteamRepository)(nil).GetManyByMemberID), ctx, memberID)
+	return &MockteamRepositoryGetManyByMemberIDCall{Call: call}
+}
+
+// MockteamRepositoryGetManyByMemberIDCall wrap *gomock.Call
+type MockteamRepositoryGetManyByMemberIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockteamRepositoryGetManyByMemberIDCall) Return(arg0 []*teams.Team, arg1 error) *MockteamRepositoryGetManyByMemberIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockteamRepositoryGetManyByMemberIDCall) Do(f func(context.Context, string) ([]*teams.Team, error)) *MockteamRepositoryGetManyByMemberIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockteamRepositoryGetManyByMemberIDCall) DoAndReturn(f func(context.Context, string) ([]*teams.Team, error)) *MockteamRepositoryGetManyByMemberIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetManyByNames mocks base method.
 func (m *MockteamRepository) GetManyByNames(ctx context.Context, names ...string) (map[string]teams.Team, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +285,122 @@ func (c *MockteamRepositoryGetManyByNamesCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// GetPrimaryNameByMemberID mocks base method.
+func (m *MockteamRepository) GetPrimaryNameByMemberID(ctx context.Context, memberID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrimaryNameByMemberID", ctx, memberID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrimaryNameByMemberID indicates an expected call of GetPrimaryNameByMemberID.
+func (mr *MockteamRepositoryMockRecorder) GetPrimaryNameByMemberID(ctx, memberID any) *MockteamRepositoryGetPrimaryNameByMemberIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrimaryNameByMemberID", reflect.TypeOf((*MockteamRepository)(nil).GetPrimaryNameByMemberID), ctx, memberID)
+	return &MockteamRepositoryGetPrimaryNameByMemberIDCall{Call: call}
+}
+
+// MockteamRepositoryGetPrimaryNameByMemberIDCall wrap *gomock.Call
+type MockteamRepositoryGetPrimaryNameByMemberIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockteamRepositoryGetPrimaryNameByMemberIDCall) Return(arg0 string, arg1 error) *MockteamRepositoryGetPrimaryNameByMemberIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockteamRepositoryGetPrimaryNameByMemberIDCall) Do(f func(context.Context, string) (string, error)) *MockteamRepositoryGetPrimaryNameByMemberIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockteamRepositoryGetPrimaryNameByMemberIDCall) DoAndReturn(f func(context.Context, string) (string, error)) *MockteamRepositoryGetPrimaryNameByMemberIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockteamRepository) List(ctx context.Context, memberID, afterName string, limit int) ([]*teams.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, memberID, afterName, limit)
+	ret0, _ := ret[0].([]*teams.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockteamRepositoryMockRecorder) List(ctx, memberID, afterName, limit any) *MockteamRepositoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockteamRepository)(nil).List), ctx, memberID, afterName, limit)
+	return &MockteamRepositoryListCall{Call: call}
+}
+
+// MockteamRepositoryListCall wrap *gomock.Call
+type MockteamRepositoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockteamRepositoryListCall) Return(arg0 []*teams.Team, arg1 error) *MockteamRepositoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockteamRepositoryListCall) Do(f func(context.Context, string, string, int) ([]*teams.Team, error)) *MockteamRepositoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockteamRepositoryListCall) DoAndReturn(f func(context.Context, string, string, int) ([]*teams.Team, error)) *MockteamRepositoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Rename mocks base method.
+func (m *MockteamRepository) Rename(ctx context.Context, name, newName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", ctx, name, newName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockteamRepositoryMockRecorder) Rename(ctx, name, newName any) *MockteamRepositoryRenameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockteamRepository)(nil).Rename), ctx, name, newName)
+	return &MockteamRepositoryRenameCall{Call: call}
+}
+
+// MockteamRepositoryRenameCall wrap *gomock.Call
+type MockteamRepositoryRenameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockteamRepositoryRenameCall) Return(arg0 error) *MockteamRepositoryRenameCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockteamRepositoryRenameCall) Do(f func(context.Context, string, string) error) *MockteamRepositoryRenameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockteamRepositoryRenameCall) DoAndReturn(f func(context.Context, string, string) error) *MockteamRepositoryRenameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Save mocks base method.
 func (m *MockteamRepository) Save(ctx context.Context, t *teams.Team) error {
 	m.ctrl.T.Helper()
@@ -204,6 +439,44 @@ func (c *MockteamRepositorySaveCall) DoAndReturn(f func(context.Context, *teams.
 	return c
 }
 
+// SetPrimary mocks base method.
+func (m *MockteamRepository) SetPrimary(ctx context.Context, memberID, teamName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrimary", ctx, memberID, teamName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPrimary indicates an expected call of SetPrimary.
+func (mr *MockteamRepositoryMockRecorder) SetPrimary(ctx, memberID, teamName any) *MockteamRepositorySetPrimaryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrimary", reflect.TypeOf((*MockteamRepository)(nil).SetPrimary), ctx, memberID, teamName)
+	return &MockteamRepositorySetPrimaryCall{Call: call}
+}
+
+// MockteamRepositorySetPrimaryCall wrap *gomock.Call
+type MockteamRepositorySetPrimaryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockteamRepositorySetPrimaryCall) Return(arg0 error) *MockteamRepositorySetPrimaryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockteamRepositorySetPrimaryCall) Do(f func(context.Context, string, string) error) *MockteamRepositorySetPrimaryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockteamRepositorySetPrimaryCall) DoAndReturn(f func(context.Context, string, string) error) *MockteamRepositorySetPrimaryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockprRepository is a mock of prRepository interface.
 type MockprRepository struct {
 	ctrl     *gomock.Controller
@@ -228,6 +501,89 @@ func (m *MockprRepository) EXPECT() *MockprRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountOpenReviews mocks base method.
+func (m *MockprRepository) CountOpenReviews(ctx context.Context, reviewerIDs ...string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range reviewerIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountOpenReviews", varargs...)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpenReviews indicates an expected call of CountOpenReviews.
+func (mr *MockprRepositoryMockRecorder) CountOpenReviews(ctx any, reviewerIDs ...any) *MockprRepositoryCountOpenReviewsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, reviewerIDs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenReviews", reflect.TypeOf((*MockprRepository)(nil).CountOpenReviews), varargs...)
+	return &MockprRepositoryCountOpenReviewsCall{Call: call}
+}
+
+// MockprRepositoryCountOpenReviewsCall wrap *gomock.Call
+type MockprRepositoryCountOpenReviewsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockprRepositoryCountOpenReviewsCall) Return(arg0 map[string]int, arg1 error) *MockprRepositoryCountOpenReviewsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockprRepositoryCountOpenReviewsCall) Do(f func(context.Context, ...string) (map[string]int, error)) *MockprRepositoryCountOpenReviewsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockprRepositoryCountOpenReviewsCall) DoAndReturn(f func(context.Context, ...string) (map[string]int, error)) *MockprRepositoryCountOpenReviewsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CountUnmergedByTeam mocks base method.
+func (m *MockprRepository) CountUnmergedByTeam(ctx context.Context, teamName string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnmergedByTeam", ctx, teamName)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnmergedByTeam indicates an expected call of CountUnmergedByTeam.
+func (mr *MockprRepositoryMockRecorder) CountUnmergedByTeam(ctx, teamName any) *MockprRepositoryCountUnmergedByTeamCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnmergedByTeam", reflect.TypeOf((*MockprRepository)(nil).CountUnmergedByTeam), ctx, teamName)
+	return &MockprRepositoryCountUnmergedByTeamCall{Call: call}
+}
+
+// MockprRepositoryCountUnmergedByTeamCall wrap *gomock.Call
+type MockprRepositoryCountUnmergedByTeamCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockprRepositoryCountUnmergedByTeamCall) Return(arg0 int, arg1 error) *MockprRepositoryCountUnmergedByTeamCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockprRepositoryCountUnmergedByTeamCall) Do(f func(context.Context, string) (int, error)) *MockprRepositoryCountUnmergedByTeamCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockprRepositoryCountUnmergedByTeamCall) DoAndReturn(f func(context.Context, string) (int, error)) *MockprRepositoryCountUnmergedByTeamCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetAllUnmergedWithAnyOfReviewers mocks base method.
 func (m *MockprRepository) GetAllUnmergedWithAnyOfReviewers(ctx context.Context, reviewerIDs ...string) ([]*usecases.PRWithMatchedReviewers, error) {
 	m.ctrl.T.Helper()
@@ -350,29 +706,145 @@ func (c *MockprRepositoryGetManyByReviewerIDCall) DoAndReturn(f func(context.Con
 	return c
 }
 
-// Save mocks base method.
-func (m *MockprRepository) Save(ctx context.Context, pr *prs.PullRequest) error {
+// GetStaleAssignments mocks base method.
+func (m *MockprRepository) GetStaleAssignments(ctx context.Context) ([]usecases.StaleAssignment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", ctx, pr)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetStaleAssignments", ctx)
+	ret0, _ := ret[0].([]usecases.StaleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Save indicates an expected call of Save.
-func (mr *MockprRepositoryMockRecorder) Save(ctx, pr any) *MockprRepositorySaveCall {
+// GetStaleAssignments indicates an expected call of GetStaleAssignments.
+func (mr *MockprRepositoryMockRecorder) GetStaleAssignments(ctx any) *MockprRepositoryGetStaleAssignmentsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockprRepository)(nil).Save), ctx, pr)
-	return &MockprRepositorySaveCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaleAssignments", reflect.TypeOf((*MockprRepository)(nil).GetStaleAssignments), ctx)
+	return &MockprRepositoryGetStaleAssignmentsCall{Call: call}
 }
 
-// MockprRepositorySaveCall wrap *gomock.Call
-type MockprRepositorySaveCall struct {
+// MockprRepositoryGetStaleAssignmentsCall wrap *gomock.Call
+type MockprRepositoryGetStaleAssignmentsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockprRepositorySaveCall) Return(arg0 error) *MockprRepositorySaveCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockprRepositoryGetStaleAssignmentsCall) Return(arg0 []usecases.StaleAssignment, arg1 error) *MockprRepositoryGetStaleAssignmentsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockprRepositoryGetStaleAssignmentsCall) Do(f func(context.Context) ([]usecases.StaleAssignment, error)) *MockprRepositoryGetStaleAssignmentsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockprRepositoryGetStaleAssignmentsCall) DoAndReturn(f func(context.Context) ([]usecases.StaleAssignment, error)) *MockprRepositoryGetStaleAssignmentsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockprRepository) List(ctx context.Context, filter usecases.PullRequestFilter, after usecases.PullRequestCursor, limit int) ([]*prs.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter, after, limit)
+	ret0, _ := ret[0].([]*prs.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockprRepositoryMockRecorder) List(ctx, filter, after, limit any) *MockprRepositoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockprRepository)(nil).List), ctx, filter, after, limit)
+	return &MockprRepositoryListCall{Call: call}
+}
+
+// MockprRepositoryListCall wrap *gomock.Call
+type MockprRepositoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockprRepositoryListCall) Return(arg0 []*prs.PullRequest, arg1 error) *MockprRepositoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockprRepositoryListCall) Do(f func(context.Context, usecases.PullRequestFilter, usecases.PullRequestCursor, int) ([]*prs.PullRequest, error)) *MockprRepositoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockprRepositoryListCall) DoAndReturn(f func(context.Context, usecases.PullRequestFilter, usecases.PullRequestCursor, int) ([]*prs.PullRequest, error)) *MockprRepositoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MoveUnmergedToTeam mocks base method.
+func (m *MockprRepository) MoveUnmergedToTeam(ctx context.Context, fromTeamName, toTeamName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveUnmergedToTeam", ctx, fromTeamName, toTeamName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveUnmergedToTeam indicates an expected call of MoveUnmergedToTeam.
+func (mr *MockprRepositoryMockRecorder) MoveUnmergedToTeam(ctx, fromTeamName, toTeamName any) *MockprRepositoryMoveUnmergedToTeamCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveUnmergedToTeam", reflect.TypeOf((*MockprRepository)(nil).MoveUnmergedToTeam), ctx, fromTeamName, toTeamName)
+	return &MockprRepositoryMoveUnmergedToTeamCall{Call: call}
+}
+
+// MockprRepositoryMoveUnmergedToTeamCall wrap *gomock.Call
+type MockprRepositoryMoveUnmergedToTeamCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockprRepositoryMoveUnmergedToTeamCall) Return(arg0 error) *MockprRepositoryMoveUnmergedToTeamCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockprRepositoryMoveUnmergedToTeamCall) Do(f func(context.Context, string, string) error) *MockprRepositoryMoveUnmergedToTeamCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockprRepositoryMoveUnmergedToTeamCall) DoAndReturn(f func(context.Context, string, string) error) *MockprRepositoryMoveUnmergedToTeamCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m *MockprRepository) Save(ctx context.Context, pr *prs.PullRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, pr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockprRepositoryMockRecorder) Save(ctx, pr any) *MockprRepositorySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockprRepository)(nil).Save), ctx, pr)
+	return &MockprRepositorySaveCall{Call: call}
+}
+
+// MockprRepositorySaveCall wrap *gomock.Call
+type MockprRepositorySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockprRepositorySaveCall) Return(arg0 error) *MockprRepositorySaveCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
@@ -538,6 +1010,45 @@ func (c *MockuserRepositoryGetManyCall) DoAndReturn(f func(context.Context, ...s
 	return c
 }
 
+// List mocks base method.
+func (m *MockuserRepository) List(ctx context.Context, filter usecases.UserFilter, afterID string, limit int) ([]*users.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter, afterID, limit)
+	ret0, _ := ret[0].([]*users.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockuserRepositoryMockRecorder) List(ctx, filter, afterID, limit any) *MockuserRepositoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockuserRepository)(nil).List), ctx, filter, afterID, limit)
+	return &MockuserRepositoryListCall{Call: call}
+}
+
+// MockuserRepositoryListCall wrap *gomock.Call
+type MockuserRepositoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryListCall) Return(arg0 []*users.User, arg1 error) *MockuserRepositoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryListCall) Do(f func(context.Context, usecases.UserFilter, string, int) ([]*users.User, error)) *MockuserRepositoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryListCall) DoAndReturn(f func(context.Context, usecases.UserFilter, string, int) ([]*users.User, error)) *MockuserRepositoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Save mocks base method.
 func (m *MockuserRepository) Save(ctx context.Context, u *users.User) error {
 	m.ctrl.T.Helper()
@@ -618,3 +1129,1513 @@ func (c *MockuserRepositorySaveManyCall) DoAndReturn(f func(context.Context, ...
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockteamSettingsRepository is a mock of teamSettingsRepository interface.
+type MockteamSettingsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockteamSettingsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockteamSettingsRepositoryMockRecorder is the mock recorder for MockteamSettingsRepository.
+type MockteamSettingsRepositoryMockRecorder struct {
+	mock *MockteamSettingsRepository
+}
+
+// NewMockteamSettingsRepository creates a new mock instance.
+func NewMockteamSettingsRepository(ctrl *gomock.Controller) *MockteamSettingsRepository {
+	mock := &MockteamSettingsRepository{ctrl: ctrl}
+	mock.recorder = &MockteamSettingsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockteamSettingsRepository) EXPECT() *MockteamSettingsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockteamSettingsRepository) Get(ctx context.Context, teamName string) (*teams.Settings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, teamName)
+	ret0, _ := ret[0].(*teams.Settings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockteamSettingsRepositoryMockRecorder) Get(ctx, teamName any) *MockteamSettingsRepositoryGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockteamSettingsRepository)(nil).Get), ctx, teamName)
+	return &MockteamSettingsRepositoryGetCall{Call: call}
+}
+
+// MockteamSettingsRepositoryGetCall wrap *gomock.Call
+type MockteamSettingsRepositoryGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockteamSettingsRepositoryGetCall) Return(arg0 *teams.Settings, arg1 error) *MockteamSettingsRepositoryGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockteamSettingsRepositoryGetCall) Do(f func(context.Context, string) (*teams.Settings, error)) *MockteamSettingsRepositoryGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockteamSettingsRepositoryGetCall) DoAndReturn(f func(context.Context, string) (*teams.Settings, error)) *MockteamSettingsRepositoryGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveFallbackTeam mocks base method.
+func (m *MockteamSettingsRepository) RemoveFallbackTeam(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFallbackTeam", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFallbackTeam indicates an expected call of RemoveFallbackTeam.
+func (mr *MockteamSettingsRepositoryMockRecorder) RemoveFallbackTeam(ctx, name any) *MockteamSettingsRepositoryRemoveFallbackTeamCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFallbackTeam", reflect.TypeOf((*MockteamSettingsRepository)(nil).RemoveFallbackTeam), ctx, name)
+	return &MockteamSettingsRepositoryRemoveFallbackTeamCall{Call: call}
+}
+
+// MockteamSettingsRepositoryRemoveFallbackTeamCall wrap *gomock.Call
+type MockteamSettingsRepositoryRemoveFallbackTeamCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockteamSettingsRepositoryRemoveFallbackTeamCall) Return(arg0 error) *MockteamSettingsRepositoryRemoveFallbackTeamCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockteamSettingsRepositoryRemoveFallbackTeamCall) Do(f func(context.Context, string) error) *MockteamSettingsRepositoryRemoveFallbackTeamCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockteamSettingsRepositoryRemoveFallbackTeamCall) DoAndReturn(f func(context.Context, string) error) *MockteamSettingsRepositoryRemoveFallbackTeamCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RenameFallbackTeam mocks base method.
+func (m *MockteamSettingsRepository) RenameFallbackTeam(ctx context.Context, name, newName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameFallbackTeam", ctx, name, newName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameFallbackTeam indicates an expected call of RenameFallbackTeam.
+func (mr *MockteamSettingsRepositoryMockRecorder) RenameFallbackTeam(ctx, name, newName any) *MockteamSettingsRepositoryRenameFallbackTeamCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameFallbackTeam", reflect.TypeOf((*MockteamSettingsRepository)(nil).RenameFallbackTeam), ctx, name, newName)
+	return &MockteamSettingsRepositoryRenameFallbackTeamCall{Call: call}
+}
+
+// MockteamSettingsRepositoryRenameFallbackTeamCall wrap *gomock.Call
+type MockteamSettingsRepositoryRenameFallbackTeamCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockteamSettingsRepositoryRenameFallbackTeamCall) Return(arg0 error) *MockteamSettingsRepositoryRenameFallbackTeamCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockteamSettingsRepositoryRenameFallbackTeamCall) Do(f func(context.Context, string, string) error) *MockteamSettingsRepositoryRenameFallbackTeamCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockteamSettingsRepositoryRenameFallbackTeamCall) DoAndReturn(f func(context.Context, string, string) error) *MockteamSettingsRepositoryRenameFallbackTeamCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m *MockteamSettingsRepository) Save(ctx context.Context, s *teams.Settings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockteamSettingsRepositoryMockRecorder) Save(ctx, s any) *MockteamSettingsRepositorySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockteamSettingsRepository)(nil).Save), ctx, s)
+	return &MockteamSettingsRepositorySaveCall{Call: call}
+}
+
+// MockteamSettingsRepositorySaveCall wrap *gomock.Call
+type MockteamSettingsRepositorySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockteamSettingsRepositorySaveCall) Return(arg0 error) *MockteamSettingsRepositorySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockteamSettingsRepositorySaveCall) Do(f func(context.Context, *teams.Settings) error) *MockteamSettingsRepositorySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockteamSettingsRepositorySaveCall) DoAndReturn(f func(context.Context, *teams.Settings) error) *MockteamSettingsRepositorySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockrotationRepository is a mock of rotationRepository interface.
+type MockrotationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockrotationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockrotationRepositoryMockRecorder is the mock recorder for MockrotationRepository.
+type MockrotationRepositoryMockRecorder struct {
+	mock *MockrotationRepository
+}
+
+// NewMockrotationRepository creates a new mock instance.
+func NewMockrotationRepository(ctrl *gomock.Controller) *MockrotationRepository {
+	mock := &MockrotationRepository{ctrl: ctrl}
+	mock.recorder = &MockrotationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrotationRepository) EXPECT() *MockrotationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// LockCursor mocks base method.
+func (m *MockrotationRepository) LockCursor(ctx context.Context, teamName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCursor", ctx, teamName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockCursor indicates an expected call of LockCursor.
+func (mr *MockrotationRepositoryMockRecorder) LockCursor(ctx, teamName any) *MockrotationRepositoryLockCursorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCursor", reflect.TypeOf((*MockrotationRepository)(nil).LockCursor), ctx, teamName)
+	return &MockrotationRepositoryLockCursorCall{Call: call}
+}
+
+// MockrotationRepositoryLockCursorCall wrap *gomock.Call
+type MockrotationRepositoryLockCursorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrotationRepositoryLockCursorCall) Return(arg0 string, arg1 error) *MockrotationRepositoryLockCursorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrotationRepositoryLockCursorCall) Do(f func(context.Context, string) (string, error)) *MockrotationRepositoryLockCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrotationRepositoryLockCursorCall) DoAndReturn(f func(context.Context, string) (string, error)) *MockrotationRepositoryLockCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveCursor mocks base method.
+func (m *MockrotationRepository) SaveCursor(ctx context.Context, teamName, lastUserID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCursor", ctx, teamName, lastUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCursor indicates an expected call of SaveCursor.
+func (mr *MockrotationRepositoryMockRecorder) SaveCursor(ctx, teamName, lastUserID any) *MockrotationRepositorySaveCursorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCursor", reflect.TypeOf((*MockrotationRepository)(nil).SaveCursor), ctx, teamName, lastUserID)
+	return &MockrotationRepositorySaveCursorCall{Call: call}
+}
+
+// MockrotationRepositorySaveCursorCall wrap *gomock.Call
+type MockrotationRepositorySaveCursorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrotationRepositorySaveCursorCall) Return(arg0 error) *MockrotationRepositorySaveCursorCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrotationRepositorySaveCursorCall) Do(f func(context.Context, string, string) error) *MockrotationRepositorySaveCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrotationRepositorySaveCursorCall) DoAndReturn(f func(context.Context, string, string) error) *MockrotationRepositorySaveCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockhistoryRepository is a mock of historyRepository interface.
+type MockhistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockhistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockhistoryRepositoryMockRecorder is the mock recorder for MockhistoryRepository.
+type MockhistoryRepositoryMockRecorder struct {
+	mock *MockhistoryRepository
+}
+
+// NewMockhistoryRepository creates a new mock instance.
+func NewMockhistoryRepository(ctrl *gomock.Controller) *MockhistoryRepository {
+	mock := &MockhistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockhistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhistoryRepository) EXPECT() *MockhistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockhistoryRepository) Append(ctx context.Context, events ...prs.AssignmentEvent) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Append", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockhistoryRepositoryMockRecorder) Append(ctx any, events ...any) *MockhistoryRepositoryAppendCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, events...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockhistoryRepository)(nil).Append), varargs...)
+	return &MockhistoryRepositoryAppendCall{Call: call}
+}
+
+// MockhistoryRepositoryAppendCall wrap *gomock.Call
+type MockhistoryRepositoryAppendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockhistoryRepositoryAppendCall) Return(arg0 error) *MockhistoryRepositoryAppendCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockhistoryRepositoryAppendCall) Do(f func(context.Context, ...prs.AssignmentEvent) error) *MockhistoryRepositoryAppendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockhistoryRepositoryAppendCall) DoAndReturn(f func(context.Context, ...prs.AssignmentEvent) error) *MockhistoryRepositoryAppendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByPullRequestID mocks base method.
+func (m *MockhistoryRepository) GetByPullRequestID(ctx context.Context, pullRequestID string) ([]prs.AssignmentEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPullRequestID", ctx, pullRequestID)
+	ret0, _ := ret[0].([]prs.AssignmentEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPullRequestID indicates an expected call of GetByPullRequestID.
+func (mr *MockhistoryRepositoryMockRecorder) GetByPullRequestID(ctx, pullRequestID any) *MockhistoryRepositoryGetByPullRequestIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPullRequestID", reflect.TypeOf((*MockhistoryRepository)(nil).GetByPullRequestID), ctx, pullRequestID)
+	return &MockhistoryRepositoryGetByPullRequestIDCall{Call: call}
+}
+
+// MockhistoryRepositoryGetByPullRequestIDCall wrap *gomock.Call
+type MockhistoryRepositoryGetByPullRequestIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockhistoryRepositoryGetByPullRequestIDCall) Return(arg0 []prs.AssignmentEvent, arg1 error) *MockhistoryRepositoryGetByPullRequestIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockhistoryRepositoryGetByPullRequestIDCall) Do(f func(context.Context, string) ([]prs.AssignmentEvent, error)) *MockhistoryRepositoryGetByPullRequestIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockhistoryRepositoryGetByPullRequestIDCall) DoAndReturn(f func(context.Context, string) ([]prs.AssignmentEvent, error)) *MockhistoryRepositoryGetByPullRequestIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockstatsRepository is a mock of statsRepository interface.
+type MockstatsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockstatsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockstatsRepositoryMockRecorder is the mock recorder for MockstatsRepository.
+type MockstatsRepositoryMockRecorder struct {
+	mock *MockstatsRepository
+}
+
+// NewMockstatsRepository creates a new mock instance.
+func NewMockstatsRepository(ctrl *gomock.Controller) *MockstatsRepository {
+	mock := &MockstatsRepository{ctrl: ctrl}
+	mock.recorder = &MockstatsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstatsRepository) EXPECT() *MockstatsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetReviewerLeadTimes mocks base method.
+func (m *MockstatsRepository) GetReviewerLeadTimes(ctx context.Context, period usecases.StatsPeriod) ([]usecases.ReviewerLeadTime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewerLeadTimes", ctx, period)
+	ret0, _ := ret[0].([]usecases.ReviewerLeadTime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewerLeadTimes indicates an expected call of GetReviewerLeadTimes.
+func (mr *MockstatsRepositoryMockRecorder) GetReviewerLeadTimes(ctx, period any) *MockstatsRepositoryGetReviewerLeadTimesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewerLeadTimes", reflect.TypeOf((*MockstatsRepository)(nil).GetReviewerLeadTimes), ctx, period)
+	return &MockstatsRepositoryGetReviewerLeadTimesCall{Call: call}
+}
+
+// MockstatsRepositoryGetReviewerLeadTimesCall wrap *gomock.Call
+type MockstatsRepositoryGetReviewerLeadTimesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstatsRepositoryGetReviewerLeadTimesCall) Return(arg0 []usecases.ReviewerLeadTime, arg1 error) *MockstatsRepositoryGetReviewerLeadTimesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstatsRepositoryGetReviewerLeadTimesCall) Do(f func(context.Context, usecases.StatsPeriod) ([]usecases.ReviewerLeadTime, error)) *MockstatsRepositoryGetReviewerLeadTimesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstatsRepositoryGetReviewerLeadTimesCall) DoAndReturn(f func(context.Context, usecases.StatsPeriod) ([]usecases.ReviewerLeadTime, error)) *MockstatsRepositoryGetReviewerLeadTimesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetReviewerStats mocks base method.
+func (m *MockstatsRepository) GetReviewerStats(ctx context.Context, period usecases.StatsPeriod) ([]usecases.ReviewerStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewerStats", ctx, period)
+	ret0, _ := ret[0].([]usecases.ReviewerStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewerStats indicates an expected call of GetReviewerStats.
+func (mr *MockstatsRepositoryMockRecorder) GetReviewerStats(ctx, period any) *MockstatsRepositoryGetReviewerStatsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewerStats", reflect.TypeOf((*MockstatsRepository)(nil).GetReviewerStats), ctx, period)
+	return &MockstatsRepositoryGetReviewerStatsCall{Call: call}
+}
+
+// MockstatsRepositoryGetReviewerStatsCall wrap *gomock.Call
+type MockstatsRepositoryGetReviewerStatsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstatsRepositoryGetReviewerStatsCall) Return(arg0 []usecases.ReviewerStats, arg1 error) *MockstatsRepositoryGetReviewerStatsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstatsRepositoryGetReviewerStatsCall) Do(f func(context.Context, usecases.StatsPeriod) ([]usecases.ReviewerStats, error)) *MockstatsRepositoryGetReviewerStatsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstatsRepositoryGetReviewerStatsCall) DoAndReturn(f func(context.Context, usecases.StatsPeriod) ([]usecases.ReviewerStats, error)) *MockstatsRepositoryGetReviewerStatsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetTeamLeadTimes mocks base method.
+func (m *MockstatsRepository) GetTeamLeadTimes(ctx context.Context, period usecases.StatsPeriod) ([]usecases.TeamLeadTime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamLeadTimes", ctx, period)
+	ret0, _ := ret[0].([]usecases.TeamLeadTime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamLeadTimes indicates an expected call of GetTeamLeadTimes.
+func (mr *MockstatsRepositoryMockRecorder) GetTeamLeadTimes(ctx, period any) *MockstatsRepositoryGetTeamLeadTimesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamLeadTimes", reflect.TypeOf((*MockstatsRepository)(nil).GetTeamLeadTimes), ctx, period)
+	return &MockstatsRepositoryGetTeamLeadTimesCall{Call: call}
+}
+
+// MockstatsRepositoryGetTeamLeadTimesCall wrap *gomock.Call
+type MockstatsRepositoryGetTeamLeadTimesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstatsRepositoryGetTeamLeadTimesCall) Return(arg0 []usecases.TeamLeadTime, arg1 error) *MockstatsRepositoryGetTeamLeadTimesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstatsRepositoryGetTeamLeadTimesCall) Do(f func(context.Context, usecases.StatsPeriod) ([]usecases.TeamLeadTime, error)) *MockstatsRepositoryGetTeamLeadTimesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstatsRepositoryGetTeamLeadTimesCall) DoAndReturn(f func(context.Context, usecases.StatsPeriod) ([]usecases.TeamLeadTime, error)) *MockstatsRepositoryGetTeamLeadTimesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetTeamStats mocks base method.
+func (m *MockstatsRepository) GetTeamStats(ctx context.Context, period usecases.StatsPeriod) ([]usecases.TeamStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamStats", ctx, period)
+	ret0, _ := ret[0].([]usecases.TeamStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamStats indicates an expected call of GetTeamStats.
+func (mr *MockstatsRepositoryMockRecorder) GetTeamStats(ctx, period any) *MockstatsRepositoryGetTeamStatsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamStats", reflect.TypeOf((*MockstatsRepository)(nil).GetTeamStats), ctx, period)
+	return &MockstatsRepositoryGetTeamStatsCall{Call: call}
+}
+
+// MockstatsRepositoryGetTeamStatsCall wrap *gomock.Call
+type MockstatsRepositoryGetTeamStatsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstatsRepositoryGetTeamStatsCall) Return(arg0 []usecases.TeamStats, arg1 error) *MockstatsRepositoryGetTeamStatsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstatsRepositoryGetTeamStatsCall) Do(f func(context.Context, usecases.StatsPeriod) ([]usecases.TeamStats, error)) *MockstatsRepositoryGetTeamStatsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstatsRepositoryGetTeamStatsCall) DoAndReturn(f func(context.Context, usecases.StatsPeriod) ([]usecases.TeamStats, error)) *MockstatsRepositoryGetTeamStatsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktokenRepository is a mock of tokenRepository interface.
+type MocktokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MocktokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MocktokenRepositoryMockRecorder is the mock recorder for MocktokenRepository.
+type MocktokenRepositoryMockRecorder struct {
+	mock *MocktokenRepository
+}
+
+// NewMocktokenRepository creates a new mock instance.
+func NewMocktokenRepository(ctrl *gomock.Controller) *MocktokenRepository {
+	mock := &MocktokenRepository{ctrl: ctrl}
+	mock.recorder = &MocktokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktokenRepository) EXPECT() *MocktokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MocktokenRepository) Create(ctx context.Context, hash string, p *auth.Principal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, hash, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MocktokenRepositoryMockRecorder) Create(ctx, hash, p any) *MocktokenRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocktokenRepository)(nil).Create), ctx, hash, p)
+	return &MocktokenRepositoryCreateCall{Call: call}
+}
+
+// MocktokenRepositoryCreateCall wrap *gomock.Call
+type MocktokenRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenRepositoryCreateCall) Return(arg0 error) *MocktokenRepositoryCreateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenRepositoryCreateCall) Do(f func(context.Context, string, *auth.Principal) error) *MocktokenRepositoryCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenRepositoryCreateCall) DoAndReturn(f func(context.Context, string, *auth.Principal) error) *MocktokenRepositoryCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MocktokenRepository) Delete(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MocktokenRepositoryMockRecorder) Delete(ctx, name any) *MocktokenRepositoryDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocktokenRepository)(nil).Delete), ctx, name)
+	return &MocktokenRepositoryDeleteCall{Call: call}
+}
+
+// MocktokenRepositoryDeleteCall wrap *gomock.Call
+type MocktokenRepositoryDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenRepositoryDeleteCall) Return(arg0 error) *MocktokenRepositoryDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenRepositoryDeleteCall) Do(f func(context.Context, string) error) *MocktokenRepositoryDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenRepositoryDeleteCall) DoAndReturn(f func(context.Context, string) error) *MocktokenRepositoryDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByHash mocks base method.
+func (m *MocktokenRepository) GetByHash(ctx context.Context, hash string) (*auth.Principal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", ctx, hash)
+	ret0, _ := ret[0].(*auth.Principal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MocktokenRepositoryMockRecorder) GetByHash(ctx, hash any) *MocktokenRepositoryGetByHashCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MocktokenRepository)(nil).GetByHash), ctx, hash)
+	return &MocktokenRepositoryGetByHashCall{Call: call}
+}
+
+// MocktokenRepositoryGetByHashCall wrap *gomock.Call
+type MocktokenRepositoryGetByHashCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenRepositoryGetByHashCall) Return(arg0 *auth.Principal, arg1 error) *MocktokenRepositoryGetByHashCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenRepositoryGetByHashCall) Do(f func(context.Context, string) (*auth.Principal, error)) *MocktokenRepositoryGetByHashCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenRepositoryGetByHashCall) DoAndReturn(f func(context.Context, string) (*auth.Principal, error)) *MocktokenRepositoryGetByHashCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockoutOfOfficeRepository is a mock of outOfOfficeRepository interface.
+type MockoutOfOfficeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutOfOfficeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockoutOfOfficeRepositoryMockRecorder is the mock recorder for MockoutOfOfficeRepository.
+type MockoutOfOfficeRepositoryMockRecorder struct {
+	mock *MockoutOfOfficeRepository
+}
+
+// NewMockoutOfOfficeRepository creates a new mock instance.
+func NewMockoutOfOfficeRepository(ctrl *gomock.Controller) *MockoutOfOfficeRepository {
+	mock := &MockoutOfOfficeRepository{ctrl: ctrl}
+	mock.recorder = &MockoutOfOfficeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutOfOfficeRepository) EXPECT() *MockoutOfOfficeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockoutOfOfficeRepository) Create(ctx context.Context, o *users.OutOfOffice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, o)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockoutOfOfficeRepositoryMockRecorder) Create(ctx, o any) *MockoutOfOfficeRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockoutOfOfficeRepository)(nil).Create), ctx, o)
+	return &MockoutOfOfficeRepositoryCreateCall{Call: call}
+}
+
+// MockoutOfOfficeRepositoryCreateCall wrap *gomock.Call
+type MockoutOfOfficeRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutOfOfficeRepositoryCreateCall) Return(arg0 error) *MockoutOfOfficeRepositoryCreateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutOfOfficeRepositoryCreateCall) Do(f func(context.Context, *users.OutOfOffice) error) *MockoutOfOfficeRepositoryCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutOfOfficeRepositoryCreateCall) DoAndReturn(f func(context.Context, *users.OutOfOffice) error) *MockoutOfOfficeRepositoryCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockoutOfOfficeRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockoutOfOfficeRepositoryMockRecorder) Delete(ctx, id any) *MockoutOfOfficeRepositoryDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockoutOfOfficeRepository)(nil).Delete), ctx, id)
+	return &MockoutOfOfficeRepositoryDeleteCall{Call: call}
+}
+
+// MockoutOfOfficeRepositoryDeleteCall wrap *gomock.Call
+type MockoutOfOfficeRepositoryDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutOfOfficeRepositoryDeleteCall) Return(arg0 error) *MockoutOfOfficeRepositoryDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutOfOfficeRepositoryDeleteCall) Do(f func(context.Context, int64) error) *MockoutOfOfficeRepositoryDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutOfOfficeRepositoryDeleteCall) DoAndReturn(f func(context.Context, int64) error) *MockoutOfOfficeRepositoryDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Get mocks base method.
+func (m *MockoutOfOfficeRepository) Get(ctx context.Context, id int64) (*users.OutOfOffice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*users.OutOfOffice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockoutOfOfficeRepositoryMockRecorder) Get(ctx, id any) *MockoutOfOfficeRepositoryGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockoutOfOfficeRepository)(nil).Get), ctx, id)
+	return &MockoutOfOfficeRepositoryGetCall{Call: call}
+}
+
+// MockoutOfOfficeRepositoryGetCall wrap *gomock.Call
+type MockoutOfOfficeRepositoryGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutOfOfficeRepositoryGetCall) Return(arg0 *users.OutOfOffice, arg1 error) *MockoutOfOfficeRepositoryGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutOfOfficeRepositoryGetCall) Do(f func(context.Context, int64) (*users.OutOfOffice, error)) *MockoutOfOfficeRepositoryGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutOfOfficeRepositoryGetCall) DoAndReturn(f func(context.Context, int64) (*users.OutOfOffice, error)) *MockoutOfOfficeRepositoryGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetManyByUserID mocks base method.
+func (m *MockoutOfOfficeRepository) GetManyByUserID(ctx context.Context, userID string) ([]*users.OutOfOffice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*users.OutOfOffice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManyByUserID indicates an expected call of GetManyByUserID.
+func (mr *MockoutOfOfficeRepositoryMockRecorder) GetManyByUserID(ctx, userID any) *MockoutOfOfficeRepositoryGetManyByUserIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyByUserID", reflect.TypeOf((*MockoutOfOfficeRepository)(nil).GetManyByUserID), ctx, userID)
+	return &MockoutOfOfficeRepositoryGetManyByUserIDCall{Call: call}
+}
+
+// MockoutOfOfficeRepositoryGetManyByUserIDCall wrap *gomock.Call
+type MockoutOfOfficeRepositoryGetManyByUserIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutOfOfficeRepositoryGetManyByUserIDCall) Return(arg0 []*users.OutOfOffice, arg1 error) *MockoutOfOfficeRepositoryGetManyByUserIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutOfOfficeRepositoryGetManyByUserIDCall) Do(f func(context.Context, string) ([]*users.OutOfOffice, error)) *MockoutOfOfficeRepositoryGetManyByUserIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutOfOfficeRepositoryGetManyByUserIDCall) DoAndReturn(f func(context.Context, string) ([]*users.OutOfOffice, error)) *MockoutOfOfficeRepositoryGetManyByUserIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LockStartedPendingReassignment mocks base method.
+func (m *MockoutOfOfficeRepository) LockStartedPendingReassignment(ctx context.Context) ([]*users.OutOfOffice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockStartedPendingReassignment", ctx)
+	ret0, _ := ret[0].([]*users.OutOfOffice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockStartedPendingReassignment indicates an expected call of LockStartedPendingReassignment.
+func (mr *MockoutOfOfficeRepositoryMockRecorder) LockStartedPendingReassignment(ctx any) *MockoutOfOfficeRepositoryLockStartedPendingReassignmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockStartedPendingReassignment", reflect.TypeOf((*MockoutOfOfficeRepository)(nil).LockStartedPendingReassignment), ctx)
+	return &MockoutOfOfficeRepositoryLockStartedPendingReassignmentCall{Call: call}
+}
+
+// MockoutOfOfficeRepositoryLockStartedPendingReassignmentCall wrap *gomock.Call
+type MockoutOfOfficeRepositoryLockStartedPendingReassignmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutOfOfficeRepositoryLockStartedPendingReassignmentCall) Return(arg0 []*users.OutOfOffice, arg1 error) *MockoutOfOfficeRepositoryLockStartedPendingReassignmentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutOfOfficeRepositoryLockStartedPendingReassignmentCall) Do(f func(context.Context) ([]*users.OutOfOffice, error)) *MockoutOfOfficeRepositoryLockStartedPendingReassignmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutOfOfficeRepositoryLockStartedPendingReassignmentCall) DoAndReturn(f func(context.Context) ([]*users.OutOfOffice, error)) *MockoutOfOfficeRepositoryLockStartedPendingReassignmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkReassigned mocks base method.
+func (m *MockoutOfOfficeRepository) MarkReassigned(ctx context.Context, ids ...int64) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkReassigned", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReassigned indicates an expected call of MarkReassigned.
+func (mr *MockoutOfOfficeRepositoryMockRecorder) MarkReassigned(ctx any, ids ...any) *MockoutOfOfficeRepositoryMarkReassignedCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReassigned", reflect.TypeOf((*MockoutOfOfficeRepository)(nil).MarkReassigned), varargs...)
+	return &MockoutOfOfficeRepositoryMarkReassignedCall{Call: call}
+}
+
+// MockoutOfOfficeRepositoryMarkReassignedCall wrap *gomock.Call
+type MockoutOfOfficeRepositoryMarkReassignedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutOfOfficeRepositoryMarkReassignedCall) Return(arg0 error) *MockoutOfOfficeRepositoryMarkReassignedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutOfOfficeRepositoryMarkReassignedCall) Do(f func(context.Context, ...int64) error) *MockoutOfOfficeRepositoryMarkReassignedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutOfOfficeRepositoryMarkReassignedCall) DoAndReturn(f func(context.Context, ...int64) error) *MockoutOfOfficeRepositoryMarkReassignedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockwebhookRepository is a mock of webhookRepository interface.
+type MockwebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookRepositoryMockRecorder is the mock recorder for MockwebhookRepository.
+type MockwebhookRepositoryMockRecorder struct {
+	mock *MockwebhookRepository
+}
+
+// NewMockwebhookRepository creates a new mock instance.
+func NewMockwebhookRepository(ctrl *gomock.Controller) *MockwebhookRepository {
+	mock := &MockwebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockwebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookRepository) EXPECT() *MockwebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockwebhookRepository) Create(ctx context.Context, s *webhooks.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockwebhookRepositoryMockRecorder) Create(ctx, s any) *MockwebhookRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockwebhookRepository)(nil).Create), ctx, s)
+	return &MockwebhookRepositoryCreateCall{Call: call}
+}
+
+// MockwebhookRepositoryCreateCall wrap *gomock.Call
+type MockwebhookRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookRepositoryCreateCall) Return(arg0 error) *MockwebhookRepositoryCreateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookRepositoryCreateCall) Do(f func(context.Context, *webhooks.Subscription) error) *MockwebhookRepositoryCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookRepositoryCreateCall) DoAndReturn(f func(context.Context, *webhooks.Subscription) error) *MockwebhookRepositoryCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockwebhookRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockwebhookRepositoryMockRecorder) Delete(ctx, id any) *MockwebhookRepositoryDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockwebhookRepository)(nil).Delete), ctx, id)
+	return &MockwebhookRepositoryDeleteCall{Call: call}
+}
+
+// MockwebhookRepositoryDeleteCall wrap *gomock.Call
+type MockwebhookRepositoryDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookRepositoryDeleteCall) Return(arg0 error) *MockwebhookRepositoryDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookRepositoryDeleteCall) Do(f func(context.Context, int64) error) *MockwebhookRepositoryDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookRepositoryDeleteCall) DoAndReturn(f func(context.Context, int64) error) *MockwebhookRepositoryDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAll mocks base method.
+func (m *MockwebhookRepository) GetAll(ctx context.Context) ([]*webhooks.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*webhooks.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockwebhookRepositoryMockRecorder) GetAll(ctx any) *MockwebhookRepositoryGetAllCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockwebhookRepository)(nil).GetAll), ctx)
+	return &MockwebhookRepositoryGetAllCall{Call: call}
+}
+
+// MockwebhookRepositoryGetAllCall wrap *gomock.Call
+type MockwebhookRepositoryGetAllCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookRepositoryGetAllCall) Return(arg0 []*webhooks.Subscription, arg1 error) *MockwebhookRepositoryGetAllCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookRepositoryGetAllCall) Do(f func(context.Context) ([]*webhooks.Subscription, error)) *MockwebhookRepositoryGetAllCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookRepositoryGetAllCall) DoAndReturn(f func(context.Context) ([]*webhooks.Subscription, error)) *MockwebhookRepositoryGetAllCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockoutboxRepository is a mock of outboxRepository interface.
+type MockoutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockoutboxRepositoryMockRecorder is the mock recorder for MockoutboxRepository.
+type MockoutboxRepositoryMockRecorder struct {
+	mock *MockoutboxRepository
+}
+
+// NewMockoutboxRepository creates a new mock instance.
+func NewMockoutboxRepository(ctrl *gomock.Controller) *MockoutboxRepository {
+	mock := &MockoutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockoutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxRepository) EXPECT() *MockoutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockoutboxRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*webhooks.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, limit, lease)
+	ret0, _ := ret[0].([]*webhooks.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockoutboxRepositoryMockRecorder) ClaimDue(ctx, limit, lease any) *MockoutboxRepositoryClaimDueCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockoutboxRepository)(nil).ClaimDue), ctx, limit, lease)
+	return &MockoutboxRepositoryClaimDueCall{Call: call}
+}
+
+// MockoutboxRepositoryClaimDueCall wrap *gomock.Call
+type MockoutboxRepositoryClaimDueCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutboxRepositoryClaimDueCall) Return(arg0 []*webhooks.Delivery, arg1 error) *MockoutboxRepositoryClaimDueCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutboxRepositoryClaimDueCall) Do(f func(context.Context, int, time.Duration) ([]*webhooks.Delivery, error)) *MockoutboxRepositoryClaimDueCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutboxRepositoryClaimDueCall) DoAndReturn(f func(context.Context, int, time.Duration) ([]*webhooks.Delivery, error)) *MockoutboxRepositoryClaimDueCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Enqueue mocks base method.
+func (m *MockoutboxRepository) Enqueue(ctx context.Context, events ...webhooks.Event) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Enqueue", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockoutboxRepositoryMockRecorder) Enqueue(ctx any, events ...any) *MockoutboxRepositoryEnqueueCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, events...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockoutboxRepository)(nil).Enqueue), varargs...)
+	return &MockoutboxRepositoryEnqueueCall{Call: call}
+}
+
+// MockoutboxRepositoryEnqueueCall wrap *gomock.Call
+type MockoutboxRepositoryEnqueueCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutboxRepositoryEnqueueCall) Return(arg0 error) *MockoutboxRepositoryEnqueueCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutboxRepositoryEnqueueCall) Do(f func(context.Context, ...webhooks.Event) error) *MockoutboxRepositoryEnqueueCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutboxRepositoryEnqueueCall) DoAndReturn(f func(context.Context, ...webhooks.Event) error) *MockoutboxRepositoryEnqueueCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m *MockoutboxRepository) Save(ctx context.Context, d *webhooks.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockoutboxRepositoryMockRecorder) Save(ctx, d any) *MockoutboxRepositorySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockoutboxRepository)(nil).Save), ctx, d)
+	return &MockoutboxRepositorySaveCall{Call: call}
+}
+
+// MockoutboxRepositorySaveCall wrap *gomock.Call
+type MockoutboxRepositorySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutboxRepositorySaveCall) Return(arg0 error) *MockoutboxRepositorySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutboxRepositorySaveCall) Do(f func(context.Context, *webhooks.Delivery) error) *MockoutboxRepositorySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutboxRepositorySaveCall) DoAndReturn(f func(context.Context, *webhooks.Delivery) error) *MockoutboxRepositorySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockgitAccountRepository is a mock of gitAccountRepository interface.
+type MockgitAccountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockgitAccountRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockgitAccountRepositoryMockRecorder is the mock recorder for MockgitAccountRepository.
+type MockgitAccountRepositoryMockRecorder struct {
+	mock *MockgitAccountRepository
+}
+
+// NewMockgitAccountRepository creates a new mock instance.
+func NewMockgitAccountRepository(ctrl *gomock.Controller) *MockgitAccountRepository {
+	mock := &MockgitAccountRepository{ctrl: ctrl}
+	mock.recorder = &MockgitAccountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgitAccountRepository) EXPECT() *MockgitAccountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockgitAccountRepository) Delete(ctx context.Context, host integrations.Host, login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, host, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockgitAccountRepositoryMockRecorder) Delete(ctx, host, login any) *MockgitAccountRepositoryDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockgitAccountRepository)(nil).Delete), ctx, host, login)
+	return &MockgitAccountRepositoryDeleteCall{Call: call}
+}
+
+// MockgitAccountRepositoryDeleteCall wrap *gomock.Call
+type MockgitAccountRepositoryDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockgitAccountRepositoryDeleteCall) Return(arg0 error) *MockgitAccountRepositoryDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockgitAccountRepositoryDeleteCall) Do(f func(context.Context, integrations.Host, string) error) *MockgitAccountRepositoryDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockgitAccountRepositoryDeleteCall) DoAndReturn(f func(context.Context, integrations.Host, string) error) *MockgitAccountRepositoryDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Get mocks base method.
+func (m *MockgitAccountRepository) Get(ctx context.Context, host integrations.Host, login string) (*integrations.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, host, login)
+	ret0, _ := ret[0].(*integrations.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockgitAccountRepositoryMockRecorder) Get(ctx, host, login any) *MockgitAccountRepositoryGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockgitAccountRepository)(nil).Get), ctx, host, login)
+	return &MockgitAccountRepositoryGetCall{Call: call}
+}
+
+// MockgitAccountRepositoryGetCall wrap *gomock.Call
+type MockgitAccountRepositoryGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockgitAccountRepositoryGetCall) Return(arg0 *integrations.Account, arg1 error) *MockgitAccountRepositoryGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockgitAccountRepositoryGetCall) Do(f func(context.Context, integrations.Host, string) (*integrations.Account, error)) *MockgitAccountRepositoryGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockgitAccountRepositoryGetCall) DoAndReturn(f func(context.Context, integrations.Host, string) (*integrations.Account, error)) *MockgitAccountRepositoryGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m *MockgitAccountRepository) Save(ctx context.Context, a *integrations.Account) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockgitAccountRepositoryMockRecorder) Save(ctx, a any) *MockgitAccountRepositorySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockgitAccountRepository)(nil).Save), ctx, a)
+	return &MockgitAccountRepositorySaveCall{Call: call}
+}
+
+// MockgitAccountRepositorySaveCall wrap *gomock.Call
+type MockgitAccountRepositorySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockgitAccountRepositorySaveCall) Return(arg0 error) *MockgitAccountRepositorySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockgitAccountRepositorySaveCall) Do(f func(context.Context, *integrations.Account) error) *MockgitAccountRepositorySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockgitAccountRepositorySaveCall) DoAndReturn(f func(context.Context, *integrations.Account) error) *MockgitAccountRepositorySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockintegrationDeliveryRepository is a mock of integrationDeliveryRepository interface.
+type MockintegrationDeliveryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockintegrationDeliveryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockintegrationDeliveryRepositoryMockRecorder is the mock recorder for MockintegrationDeliveryRepository.
+type MockintegrationDeliveryRepositoryMockRecorder struct {
+	mock *MockintegrationDeliveryRepository
+}
+
+// NewMockintegrationDeliveryRepository creates a new mock instance.
+func NewMockintegrationDeliveryRepository(ctrl *gomock.Controller) *MockintegrationDeliveryRepository {
+	mock := &MockintegrationDeliveryRepository{ctrl: ctrl}
+	mock.recorder = &MockintegrationDeliveryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockintegrationDeliveryRepository) EXPECT() *MockintegrationDeliveryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockintegrationDeliveryRepository) Claim(ctx context.Context, host integrations.Host, deliveryID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, host, deliveryID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockintegrationDeliveryRepositoryMockRecorder) Claim(ctx, host, deliveryID any) *MockintegrationDeliveryRepositoryClaimCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockintegrationDeliveryRepository)(nil).Claim), ctx, host, deliveryID)
+	return &MockintegrationDeliveryRepositoryClaimCall{Call: call}
+}
+
+// MockintegrationDeliveryRepositoryClaimCall wrap *gomock.Call
+type MockintegrationDeliveryRepositoryClaimCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockintegrationDeliveryRepositoryClaimCall) Return(arg0 bool, arg1 error) *MockintegrationDeliveryRepositoryClaimCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockintegrationDeliveryRepositoryClaimCall) Do(f func(context.Context, integrations.Host, string) (bool, error)) *MockintegrationDeliveryRepositoryClaimCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockintegrationDeliveryRepositoryClaimCall) DoAndReturn(f func(context.Context, integrations.Host, string) (bool, error)) *MockintegrationDeliveryRepositoryClaimCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockeventListener is a mock of eventListener interface.
+type MockeventListener struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventListenerMockRecorder
+	isgomock struct{}
+}
+
+// MockeventListenerMockRecorder is the mock recorder for MockeventListener.
+type MockeventListenerMockRecorder struct {
+	mock *MockeventListener
+}
+
+// NewMockeventListener creates a new mock instance.
+func NewMockeventListener(ctrl *gomock.Controller) *MockeventListener {
+	mock := &MockeventListener{ctrl: ctrl}
+	mock.recorder = &MockeventListenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventListener) EXPECT() *MockeventListenerMockRecorder {
+	return m.recorder
+}
+
+// Listen mocks base method.
+func (m *MockeventListener) Listen(ctx context.Context, handle func(webhooks.Event)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Listen", ctx, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Listen indicates an expected call of Listen.
+func (mr *MockeventListenerMockRecorder) Listen(ctx, handle any) *MockeventListenerListenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Listen", reflect.TypeOf((*MockeventListener)(nil).Listen), ctx, handle)
+	return &MockeventListenerListenCall{Call: call}
+}
+
+// MockeventListenerListenCall wrap *gomock.Call
+type MockeventListenerListenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockeventListenerListenCall) Return(arg0 error) *MockeventListenerListenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockeventListenerListenCall) Do(f func(context.Context, func(webhooks.Event)) error) *MockeventListenerListenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockeventListenerListenCall) DoAndReturn(f func(context.Context, func(webhooks.Event)) error) *MockeventListenerListenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,472 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/lezzercringe/avito-test-assignment/internal/usecases (interfaces: PullRequestService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination ../../mocks/services.go -package mocks . PullRequestService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	prs "github.com/lezzercringe/avito-test-assignment/internal/prs"
+	usecases "github.com/lezzercringe/avito-test-assignment/internal/usecases"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPullRequestService is a mock of PullRequestService interface.
+type MockPullRequestService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPullRequestServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPullRequestServiceMockRecorder is the mock recorder for MockPullRequestService.
+type MockPullRequestServiceMockRecorder struct {
+	mock *MockPullRequestService
+}
+
+// NewMockPullRequestService creates a new mock instance.
+func NewMockPullRequestService(ctrl *gomock.Controller) *MockPullRequestService {
+	mock := &MockPullRequestService{ctrl: ctrl}
+	mock.recorder = &MockPullRequestServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPullRequestService) EXPECT() *MockPullRequestServiceMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockPullRequestService) Close(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx, id)
+	ret0, _ := ret[0].(*prs.PullRequestView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockPullRequestServiceMockRecorder) Close(ctx, id any) *MockPullRequestServiceCloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockPullRequestService)(nil).Close), ctx, id)
+	return &MockPullRequestServiceCloseCall{Call: call}
+}
+
+// MockPullRequestServiceCloseCall wrap *gomock.Call
+type MockPullRequestServiceCloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPullRequestServiceCloseCall) Return(arg0 *prs.PullRequestView, arg1 error) *MockPullRequestServiceCloseCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPullRequestServiceCloseCall) Do(f func(context.Context, string) (*prs.PullRequestView, error)) *MockPullRequestServiceCloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPullRequestServiceCloseCall) DoAndReturn(f func(context.Context, string) (*prs.PullRequestView, error)) *MockPullRequestServiceCloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Create mocks base method.
+func (m *MockPullRequestService) Create(ctx context.Context, req usecases.CreateRequest) (*prs.PullRequestView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, req)
+	ret0, _ := ret[0].(*prs.PullRequestView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPullRequestServiceMockRecorder) Create(ctx, req any) *MockPullRequestServiceCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPullRequestService)(nil).Create), ctx, req)
+	return &MockPullRequestServiceCreateCall{Call: call}
+}
+
+// MockPullRequestServiceCreateCall wrap *gomock.Call
+type MockPullRequestServiceCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPullRequestServiceCreateCall) Return(arg0 *prs.PullRequestView, arg1 error) *MockPullRequestServiceCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPullRequestServiceCreateCall) Do(f func(context.Context, usecases.CreateRequest) (*prs.PullRequestView, error)) *MockPullRequestServiceCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPullRequestServiceCreateCall) DoAndReturn(f func(context.Context, usecases.CreateRequest) (*prs.PullRequestView, error)) *MockPullRequestServiceCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Get mocks base method.
+func (m *MockPullRequestService) Get(ctx context.Context, id string) (*usecases.PullRequestDetailsView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*usecases.PullRequestDetailsView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPullRequestServiceMockRecorder) Get(ctx, id any) *MockPullRequestServiceGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPullRequestService)(nil).Get), ctx, id)
+	return &MockPullRequestServiceGetCall{Call: call}
+}
+
+// MockPullRequestServiceGetCall wrap *gomock.Call
+type MockPullRequestServiceGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPullRequestServiceGetCall) Return(arg0 *usecases.PullRequestDetailsView, arg1 error) *MockPullRequestServiceGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPullRequestServiceGetCall) Do(f func(context.Context, string) (*usecases.PullRequestDetailsView, error)) *MockPullRequestServiceGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPullRequestServiceGetCall) DoAndReturn(f func(context.Context, string) (*usecases.PullRequestDetailsView, error)) *MockPullRequestServiceGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetHistory mocks base method.
+func (m *MockPullRequestService) GetHistory(ctx context.Context, id string) ([]usecases.AssignmentEventView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, id)
+	ret0, _ := ret[0].([]usecases.AssignmentEventView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockPullRequestServiceMockRecorder) GetHistory(ctx, id any) *MockPullRequestServiceGetHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockPullRequestService)(nil).GetHistory), ctx, id)
+	return &MockPullRequestServiceGetHistoryCall{Call: call}
+}
+
+// MockPullRequestServiceGetHistoryCall wrap *gomock.Call
+type MockPullRequestServiceGetHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPullRequestServiceGetHistoryCall) Return(arg0 []usecases.AssignmentEventView, arg1 error) *MockPullRequestServiceGetHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPullRequestServiceGetHistoryCall) Do(f func(context.Context, string) ([]usecases.AssignmentEventView, error)) *MockPullRequestServiceGetHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPullRequestServiceGetHistoryCall) DoAndReturn(f func(context.Context, string) ([]usecases.AssignmentEventView, error)) *MockPullRequestServiceGetHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockPullRequestService) List(ctx context.Context, req usecases.ListPullRequestsRequest) (*usecases.Page[*prs.PullRequestView], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, req)
+	ret0, _ := ret[0].(*usecases.Page[*prs.PullRequestView])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPullRequestServiceMockRecorder) List(ctx, req any) *MockPullRequestServiceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPullRequestService)(nil).List), ctx, req)
+	return &MockPullRequestServiceListCall{Call: call}
+}
+
+// MockPullRequestServiceListCall wrap *gomock.Call
+type MockPullRequestServiceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPullRequestServiceListCall) Return(arg0 *usecases.Page[*prs.PullRequestView], arg1 error) *MockPullRequestServiceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPullRequestServiceListCall) Do(f func(context.Context, usecases.ListPullRequestsRequest) (*usecases.Page[*prs.PullRequestView], error)) *MockPullRequestServiceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPullRequestServiceListCall) DoAndReturn(f func(context.Context, usecases.ListPullRequestsRequest) (*usecases.Page[*prs.PullRequestView], error)) *MockPullRequestServiceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkReady mocks base method.
+func (m *MockPullRequestService) MarkReady(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReady", ctx, id)
+	ret0, _ := ret[0].(*prs.PullRequestView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkReady indicates an expected call of MarkReady.
+func (mr *MockPullRequestServiceMockRecorder) MarkReady(ctx, id any) *MockPullRequestServiceMarkReadyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReady", reflect.TypeOf((*MockPullRequestService)(nil).MarkReady), ctx, id)
+	return &MockPullRequestServiceMarkReadyCall{Call: call}
+}
+
+// MockPullRequestServiceMarkReadyCall wrap *gomock.Call
+type MockPullRequestServiceMarkReadyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPullRequestServiceMarkReadyCall) Return(arg0 *prs.PullRequestView, arg1 error) *MockPullRequestServiceMarkReadyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPullRequestServiceMarkReadyCall) Do(f func(context.Context, string) (*prs.PullRequestView, error)) *MockPullRequestServiceMarkReadyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPullRequestServiceMarkReadyCall) DoAndReturn(f func(context.Context, string) (*prs.PullRequestView, error)) *MockPullRequestServiceMarkReadyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Merge mocks base method.
+func (m *MockPullRequestService) Merge(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, id)
+	ret0, _ := ret[0].(*prs.PullRequestView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockPullRequestServiceMockRecorder) Merge(ctx, id any) *MockPullRequestServiceMergeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockPullRequestService)(nil).Merge), ctx, id)
+	return &MockPullRequestServiceMergeCall{Call: call}
+}
+
+// MockPullRequestServiceMergeCall wrap *gomock.Call
+type MockPullRequestServiceMergeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPullRequestServiceMergeCall) Return(arg0 *prs.PullRequestView, arg1 error) *MockPullRequestServiceMergeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPullRequestServiceMergeCall) Do(f func(context.Context, string) (*prs.PullRequestView, error)) *MockPullRequestServiceMergeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPullRequestServiceMergeCall) DoAndReturn(f func(context.Context, string) (*prs.PullRequestView, error)) *MockPullRequestServiceMergeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReassignReviewer mocks base method.
+func (m *MockPullRequestService) ReassignReviewer(ctx context.Context, req usecases.ReassignReviewerRequest) (*usecases.ReassignReviewerResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignReviewer", ctx, req)
+	ret0, _ := ret[0].(*usecases.ReassignReviewerResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignReviewer indicates an expected call of ReassignReviewer.
+func (mr *MockPullRequestServiceMockRecorder) ReassignReviewer(ctx, req any) *MockPullRequestServiceReassignReviewerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignReviewer", reflect.TypeOf((*MockPullRequestService)(nil).ReassignReviewer), ctx, req)
+	return &MockPullRequestServiceReassignReviewerCall{Call: call}
+}
+
+// MockPullRequestServiceReassignReviewerCall wrap *gomock.Call
+type MockPullRequestServiceReassignReviewerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPullRequestServiceReassignReviewerCall) Return(arg0 *usecases.ReassignReviewerResult, arg1 error) *MockPullRequestServiceReassignReviewerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPullRequestServiceReassignReviewerCall) Do(f func(context.Context, usecases.ReassignReviewerRequest) (*usecases.ReassignReviewerResult, error)) *MockPullRequestServiceReassignReviewerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPullRequestServiceReassignReviewerCall) DoAndReturn(f func(context.Context, usecases.ReassignReviewerRequest) (*usecases.ReassignReviewerResult, error)) *MockPullRequestServiceReassignReviewerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordMerged mocks base method.
+func (m *MockPullRequestService) RecordMerged(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordMerged", ctx, id)
+	ret0, _ := ret[0].(*prs.PullRequestView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordMerged indicates an expected call of RecordMerged.
+func (mr *MockPullRequestServiceMockRecorder) RecordMerged(ctx, id any) *MockPullRequestServiceRecordMergedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordMerged", reflect.TypeOf((*MockPullRequestService)(nil).RecordMerged), ctx, id)
+	return &MockPullRequestServiceRecordMergedCall{Call: call}
+}
+
+// MockPullRequestServiceRecordMergedCall wrap *gomock.Call
+type MockPullRequestServiceRecordMergedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPullRequestServiceRecordMergedCall) Return(arg0 *prs.PullRequestView, arg1 error) *MockPullRequestServiceRecordMergedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPullRequestServiceRecordMergedCall) Do(f func(context.Context, string) (*prs.PullRequestView, error)) *MockPullRequestServiceRecordMergedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPullRequestServiceRecordMergedCall) DoAndReturn(f func(context.Context, string) (*prs.PullRequestView, error)) *MockPullRequestServiceRecordMergedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Reopen mocks base method.
+func (m *MockPullRequestService) Reopen(ctx context.Context, id string) (*prs.PullRequestView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", ctx, id)
+	ret0, _ := ret[0].(*prs.PullRequestView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockPullRequestServiceMockRecorder) Reopen(ctx, id any) *MockPullRequestServiceReopenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockPullRequestService)(nil).Reopen), ctx, id)
+	return &MockPullRequestServiceReopenCall{Call: call}
+}
+
+// MockPullRequestServiceReopenCall wrap *gomock.Call
+type MockPullRequestServiceReopenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPullRequestServiceReopenCall) Return(arg0 *prs.PullRequestView, arg1 error) *MockPullRequestServiceReopenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPullRequestServiceReopenCall) Do(f func(context.Context, string) (*prs.PullRequestView, error)) *MockPullRequestServiceReopenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPullRequestServiceReopenCall) DoAndReturn(f func(context.Context, string) (*prs.PullRequestView, error)) *MockPullRequestServiceReopenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SubmitReview mocks base method.
+func (m *MockPullRequestService) SubmitReview(ctx context.Context, req usecases.SubmitReviewRequest) (*prs.PullRequestView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitReview", ctx, req)
+	ret0, _ := ret[0].(*prs.PullRequestView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitReview indicates an expected call of SubmitReview.
+func (mr *MockPullRequestServiceMockRecorder) SubmitReview(ctx, req any) *MockPullRequestServiceSubmitReviewCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitReview", reflect.TypeOf((*MockPullRequestService)(nil).SubmitReview), ctx, req)
+	return &MockPullRequestServiceSubmitReviewCall{Call: call}
+}
+
+// MockPullRequestServiceSubmitReviewCall wrap *gomock.Call
+type MockPullRequestServiceSubmitReviewCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPullRequestServiceSubmitReviewCall) Return(arg0 *prs.PullRequestView, arg1 error) *MockPullRequestServiceSubmitReviewCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPullRequestServiceSubmitReviewCall) Do(f func(context.Context, usecases.SubmitReviewRequest) (*prs.PullRequestView, error)) *MockPullRequestServiceSubmitReviewCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPullRequestServiceSubmitReviewCall) DoAndReturn(f func(context.Context, usecases.SubmitReviewRequest) (*prs.PullRequestView, error)) *MockPullRequestServiceSubmitReviewCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}